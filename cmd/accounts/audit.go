@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/handler"
+	pb "github.com/ChotongW/grit_demo_wallet/pb/accounts"
+	"github.com/ChotongW/grit_demo_wallet/pkg/audit"
+)
+
+// auditSnapshots read what the calls they are keyed by are about to
+// change, through the service's own read methods, for the audit log.
+func auditSnapshots(h *handler.GRPCHandler) map[string]audit.Snapshot {
+	balance := func(ctx context.Context, accountID string) (any, error) {
+		return h.GetBalance(ctx, &pb.GetBalanceRequest{AccountId: accountID})
+	}
+	account := func(ctx context.Context, accountID string) (any, error) {
+		return h.GetAccount(ctx, &pb.GetAccountRequest{AccountId: accountID})
+	}
+	scheduledTransfer := func(ctx context.Context, req any) (any, error) {
+		r := req.(*pb.ScheduledTransferActionRequest)
+		resp, err := h.ListScheduledTransfers(ctx, &pb.ListScheduledTransfersRequest{AccountId: r.AccountId})
+		if err != nil {
+			return nil, err
+		}
+		for _, st := range resp.ScheduledTransfers {
+			if st.Id == r.ScheduledTransferId {
+				return st, nil
+			}
+		}
+		return nil, accountErrors.ErrScheduledTransferNotFound
+	}
+	payoutBatch := func(ctx context.Context, req any) (any, error) {
+		return h.GetPayoutBatch(ctx, &pb.GetPayoutBatchRequest{PayoutId: req.(*pb.PayoutBatchActionRequest).PayoutId})
+	}
+
+	return map[string]audit.Snapshot{
+		"Deposit": func(ctx context.Context, req any) (any, error) {
+			return balance(ctx, req.(*pb.DepositRequest).AccountId)
+		},
+		"Withdraw": func(ctx context.Context, req any) (any, error) {
+			return balance(ctx, req.(*pb.WithdrawRequest).AccountId)
+		},
+		"Transfer": func(ctx context.Context, req any) (any, error) {
+			return balance(ctx, req.(*pb.TransferRequest).FromAccountId)
+		},
+		"SetHandle": func(ctx context.Context, req any) (any, error) {
+			return account(ctx, req.(*pb.SetHandleRequest).AccountId)
+		},
+		"SetDefaultWallet": func(ctx context.Context, req any) (any, error) {
+			return h.ListAccountsForUser(ctx, &pb.ListAccountsForUserRequest{UserId: req.(*pb.SetDefaultWalletRequest).UserId})
+		},
+		"UpdateProfile": func(ctx context.Context, req any) (any, error) {
+			return h.GetUser(ctx, &pb.GetUserRequest{UserId: req.(*pb.UpdateProfileRequest).UserId})
+		},
+		"SetNotificationPreferences": func(ctx context.Context, req any) (any, error) {
+			return h.GetNotificationPreferences(ctx, &pb.GetNotificationPreferencesRequest{UserId: req.(*pb.SetNotificationPreferencesRequest).UserId})
+		},
+		"SetSystemAccount": func(ctx context.Context, req any) (any, error) {
+			return h.ListSystemAccounts(ctx, &pb.ListSystemAccountsRequest{})
+		},
+		"PauseScheduledTransfer":  scheduledTransfer,
+		"ResumeScheduledTransfer": scheduledTransfer,
+		"CancelScheduledTransfer": scheduledTransfer,
+		"ApprovePayoutBatch":      payoutBatch,
+		"CancelPayoutBatch":       payoutBatch,
+	}
+}
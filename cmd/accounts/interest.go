@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/service"
+)
+
+// runInterestCommand handles
+//
+//	accrue-interest [YYYY-MM-DD]  accrue one day, yesterday by default
+//	pay-interest [YYYY-MM]        pay out one month, last month by default
+//
+// Both are safe to re-run, so they can be scheduled from cron and retried.
+func runInterestCommand(ctx context.Context, svc *service.Service, command string, args []string) error {
+	now := time.Now().UTC()
+
+	switch command {
+	case "accrue-interest":
+		date := now.AddDate(0, 0, -1)
+		if len(args) > 0 {
+			parsed, err := time.Parse(time.DateOnly, args[0])
+			if err != nil {
+				return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", args[0])
+			}
+			date = parsed
+		}
+
+		result, err := svc.AccrueInterest(ctx, date)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("accrual %s: %d accounts, accrued %s, posted %s, transaction %q, already run: %t\n",
+			result.Date.Format(time.DateOnly), result.AccountsCount, result.TotalAccrued.String(),
+			result.PostedAmount.String(), result.TransactionID, result.AlreadyRun)
+
+	case "pay-interest":
+		period := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0).Format("2006-01")
+		if len(args) > 0 {
+			period = args[0]
+		}
+
+		result, err := svc.PayOutInterest(ctx, period)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("payout %s: %d accounts, accrued %s, paid %s, transaction %q, already run: %t\n",
+			result.Period, result.AccountsCount, result.AccruedAmount.String(),
+			result.PaidAmount.String(), result.TransactionID, result.AlreadyRun)
+
+	default:
+		return fmt.Errorf("unknown command %q", command)
+	}
+
+	return nil
+}
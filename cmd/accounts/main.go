@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -8,10 +9,12 @@ import (
 
 	"github.com/ChotongW/grit_demo_wallet/config/accounts"
 	"github.com/ChotongW/grit_demo_wallet/internal/accounts/handler"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/migrations"
 	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
 	"github.com/ChotongW/grit_demo_wallet/internal/accounts/service"
 	pb "github.com/ChotongW/grit_demo_wallet/pb/accounts"
 	pbSub "github.com/ChotongW/grit_demo_wallet/pb/subledger"
+	"github.com/ChotongW/grit_demo_wallet/pkg/audit"
 	"github.com/ChotongW/grit_demo_wallet/pkg/database"
 	"github.com/ChotongW/grit_demo_wallet/pkg/logger"
 	"github.com/ChotongW/grit_demo_wallet/pkg/requestid"
@@ -46,6 +49,25 @@ func main() {
 	}
 	defer db.Close()
 
+	migrator, err := database.NewMigrator(db.Pool, migrations.Set, migrations.FS, logger)
+	if err != nil {
+		log.Fatalf("failed to load migrations: %v", err)
+	}
+	migrator.AdoptLegacyTables(migrations.LegacyTables...)
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := database.RunMigrateCommand(context.Background(), migrator, os.Args[2:]); err != nil {
+			log.Fatalf("migrate failed: %v", err)
+		}
+		return
+	}
+
+	if cfg.DbConfig.AutoMigrate {
+		if _, err := migrator.Up(context.Background()); err != nil {
+			log.Fatalf("failed to apply migrations: %v", err)
+		}
+	}
+
 	subledgerAddr := os.Getenv("SUBLEDGER_RPC_ADDR")
 	if subledgerAddr == "" {
 		subledgerAddr = "localhost:50051"
@@ -53,7 +75,7 @@ func main() {
 	conn, err := grpc.Dial(
 		subledgerAddr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(requestid.UnaryClientInterceptor()),
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), audit.UnaryClientInterceptor("accounts", []byte(cfg.AuditKey))),
 		grpc.WithStreamInterceptor(requestid.StreamClientInterceptor()),
 	)
 	if err != nil {
@@ -64,9 +86,72 @@ func main() {
 	subledgerClient := pbSub.NewSubledgerServiceClient(conn)
 	logger.Infof("connected to subledger service at %s", subledgerAddr)
 
+	channels, err := newNotificationChannels(cfg, logger)
+	if err != nil {
+		log.Fatalf("invalid notifier configuration: %v", err)
+	}
+
 	repo := repository.NewRepository(db.Pool, logger)
-	svc := service.NewService(repo, subledgerClient, logger)
-	grpcHandler := handler.NewGRPCHandler(svc, logger)
+	svc := service.NewService(repo, subledgerClient, channels, logger)
+	if err := svc.InitSystemAccounts(context.Background(), cfg.SystemAccounts); err != nil {
+		log.Fatalf("invalid system account configuration: %v", err)
+	}
+
+	if len(os.Args) > 1 && (os.Args[1] == "accrue-interest" || os.Args[1] == "pay-interest") {
+		if err := runInterestCommand(context.Background(), svc, os.Args[1], os.Args[2:]); err != nil {
+			log.Fatalf("%s failed: %v", os.Args[1], err)
+		}
+		return
+	}
+
+	go func() {
+		count, err := svc.RegisterWallets(context.Background())
+		if err != nil {
+			logger.Errorf("failed to register wallets in the subledger: %v", err)
+			return
+		}
+		if count > 0 {
+			logger.Infof("registered %d wallets missing from the subledger", count)
+		}
+	}()
+
+	if cfg.SchedulerInterval > 0 {
+		schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+		defer stopScheduler()
+		go svc.RunScheduler(schedulerCtx, cfg.SchedulerInterval)
+		logger.Infof("scheduled transfer worker running every %s", cfg.SchedulerInterval)
+	}
+
+	if cfg.NotificationInterval > 0 {
+		notifierCtx, stopNotifier := context.WithCancel(context.Background())
+		defer stopNotifier()
+		go svc.RunNotifier(notifierCtx, cfg.NotificationInterval)
+		logger.Infof("notification worker running every %s", cfg.NotificationInterval)
+	}
+
+	if cfg.WebhookInterval > 0 {
+		webhookCtx, stopWebhooks := context.WithCancel(context.Background())
+		defer stopWebhooks()
+		go svc.RunWebhooks(webhookCtx, cfg.WebhookInterval)
+		logger.Infof("webhook worker running every %s", cfg.WebhookInterval)
+	}
+
+	if cfg.PayoutInterval > 0 {
+		payoutCtx, stopPayouts := context.WithCancel(context.Background())
+		defer stopPayouts()
+		go svc.RunPayouts(payoutCtx, cfg.PayoutInterval)
+		logger.Infof("payout worker running every %s", cfg.PayoutInterval)
+	}
+
+	if cfg.AuditKey == "" {
+		logger.Warn("no AUDIT_KEY configured, every caller is audited as UNKNOWN")
+	}
+	if cfg.AdminKey == "" {
+		logger.Warn("no ADMIN_KEY configured, admin RPCs are disabled")
+	}
+	auditLog := audit.NewPostgresStore(db.Pool)
+	grpcHandler := handler.NewGRPCHandler(svc, auditLog, cfg.AdminKey, logger)
+	recorder := audit.NewRecorder("accounts", []byte(cfg.AuditKey), auditLog, auditSnapshots(grpcHandler), logger)
 	grpcPort := os.Getenv("GRPC_PORT")
 	if grpcPort == "" {
 		grpcPort = "50052"
@@ -77,8 +162,8 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(requestid.UnaryServerInterceptor()),
-		grpc.StreamInterceptor(requestid.StreamServerInterceptor()),
+		grpc.ChainUnaryInterceptor(requestid.UnaryServerInterceptor(), recorder.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(requestid.StreamServerInterceptor(), recorder.StreamServerInterceptor()),
 	)
 	pb.RegisterAccountsServiceServer(grpcServer, grpcHandler)
 
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"github.com/ChotongW/grit_demo_wallet/config/accounts"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/notifier"
+
+	"github.com/sirupsen/logrus"
+)
+
+// newNotificationChannels uses the configured provider of each channel and
+// the fallback notifier for channels without one.
+func newNotificationChannels(cfg *accounts.ServiceConfig, logger *logrus.Logger) (notifier.Channels, error) {
+	fallback, err := notifier.New(cfg.Notifier, cfg.NotifierFile, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := notifier.Channels{
+		notifier.ChannelEmail: fallback,
+		notifier.ChannelSMS:   fallback,
+		notifier.ChannelPush:  fallback,
+	}
+	if cfg.SMTP.Host != "" {
+		channels[notifier.ChannelEmail] = notifier.NewSMTPNotifier(notifier.SMTPConfig{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.SMTP.From,
+		})
+	}
+	if cfg.SMSGatewayURL != "" {
+		channels[notifier.ChannelSMS] = notifier.NewHTTPNotifier(cfg.SMSGatewayURL, cfg.SMSGatewayToken)
+	}
+	if cfg.PushGatewayURL != "" {
+		channels[notifier.ChannelPush] = notifier.NewHTTPNotifier(cfg.PushGatewayURL, cfg.PushGatewayToken)
+	}
+
+	return channels, nil
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/handler"
+	pb "github.com/ChotongW/grit_demo_wallet/pb/subledger"
+	"github.com/ChotongW/grit_demo_wallet/pkg/audit"
+)
+
+// auditSnapshots read the balances a transaction is about to move, for the
+// audit log.
+func auditSnapshots(h *handler.GRPCHandler) map[string]audit.Snapshot {
+	return map[string]audit.Snapshot{
+		"CreateTransaction": func(ctx context.Context, req any) (any, error) {
+			var balances []*pb.GetBalanceResponse
+			seen := make(map[string]bool)
+			for _, e := range req.(*pb.CreateTransactionRequest).Entries {
+				if seen[e.AccountId] {
+					continue
+				}
+				seen[e.AccountId] = true
+				balance, err := h.GetBalance(ctx, &pb.GetBalanceRequest{AccountId: e.AccountId})
+				if err != nil {
+					return nil, err
+				}
+				balances = append(balances, balance)
+			}
+			return map[string]any{"balances": balances}, nil
+		},
+	}
+}
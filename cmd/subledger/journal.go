@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/journal"
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/service"
+	"github.com/ChotongW/grit_demo_wallet/pkg/apperror"
+)
+
+// runJournalCommand handles
+//
+//	journal check FILE                                validate a journal without posting it
+//	journal import FILE                               post a journal, all of it or nothing
+//	journal export entries|balances YYYY-MM [FILE]    export a period, to stdout by default
+//
+// The format, csv or jsonl, follows the file extension; stdout gets csv.
+func runJournalCommand(ctx context.Context, svc *service.Service, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: journal check|import FILE, or journal export entries|balances YYYY-MM [FILE]")
+	}
+
+	switch args[0] {
+	case "check", "import":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: journal %s FILE", args[0])
+		}
+		format := journal.FormatOf(args[1])
+		if format == "" {
+			return fmt.Errorf("cannot tell the format of %s, expected a .csv or .jsonl file", args[1])
+		}
+
+		f, err := os.Open(args[1])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		report, err := svc.ImportJournal(ctx, f, format, args[0] == "check")
+		if err != nil {
+			return err
+		}
+		for _, issue := range report.Issues {
+			fmt.Printf("line %d: %s: %s: %v\n", issue.Line, issue.ReferenceID, apperror.ReasonOf(issue.Err), issue.Err)
+		}
+		fmt.Printf("journal %s: %d lines, %d transactions, %d issues, %d posted\n",
+			args[1], report.Lines, report.Transactions, len(report.Issues), len(report.Posted))
+		if len(report.Issues) > 0 {
+			return fmt.Errorf("%d issues found, nothing was posted", len(report.Issues))
+		}
+
+	case "export":
+		if len(args) < 3 || len(args) > 4 {
+			return fmt.Errorf("usage: journal export entries|balances YYYY-MM [FILE]")
+		}
+
+		var w io.Writer = os.Stdout
+		var f *os.File
+		format := journal.FormatCSV
+		if len(args) == 4 {
+			if format = journal.FormatOf(args[3]); format == "" {
+				return fmt.Errorf("cannot tell the format of %s, expected a .csv or .jsonl file", args[3])
+			}
+			var err error
+			if f, err = os.Create(args[3]); err != nil {
+				return err
+			}
+			w = f
+		}
+
+		count, err := svc.ExportJournal(ctx, w, args[1], args[2], format)
+		if f != nil {
+			// The last rows may only reach the disk on close.
+			if closeErr := f.Close(); closeErr != nil && err == nil {
+				err = fmt.Errorf("failed to write %s: %w", args[3], closeErr)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if len(args) == 4 {
+			fmt.Printf("exported %d %s rows of %s to %s\n", count, args[1], args[2], args[3])
+		}
+
+	default:
+		return fmt.Errorf("unknown journal command %q", args[0])
+	}
+
+	return nil
+}
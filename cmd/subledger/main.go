@@ -1,6 +1,9 @@
 package main
 
 import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"net"
@@ -8,9 +11,11 @@ import (
 
 	"github.com/ChotongW/grit_demo_wallet/config/subledger"
 	"github.com/ChotongW/grit_demo_wallet/internal/subledger/handler"
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/migrations"
 	"github.com/ChotongW/grit_demo_wallet/internal/subledger/repository"
 	"github.com/ChotongW/grit_demo_wallet/internal/subledger/service"
 	pb "github.com/ChotongW/grit_demo_wallet/pb/subledger"
+	"github.com/ChotongW/grit_demo_wallet/pkg/audit"
 	"github.com/ChotongW/grit_demo_wallet/pkg/database"
 	"github.com/ChotongW/grit_demo_wallet/pkg/logger"
 	"github.com/ChotongW/grit_demo_wallet/pkg/requestid"
@@ -44,9 +49,62 @@ func main() {
 	}
 	defer db.Close()
 
+	migrator, err := database.NewMigrator(db.Pool, migrations.Set, migrations.FS, logger)
+	if err != nil {
+		log.Fatalf("failed to load migrations: %v", err)
+	}
+	migrator.AdoptLegacyTables(migrations.LegacyTables...)
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := database.RunMigrateCommand(context.Background(), migrator, os.Args[2:]); err != nil {
+			log.Fatalf("migrate failed: %v", err)
+		}
+		return
+	}
+
+	if cfg.DbConfig.AutoMigrate {
+		if _, err := migrator.Up(context.Background()); err != nil {
+			log.Fatalf("failed to apply migrations: %v", err)
+		}
+	}
+
+	checkpointKey, err := parseCheckpointKey(cfg.CheckpointKey)
+	if err != nil {
+		log.Fatalf("invalid checkpoint key: %v", err)
+	}
+
 	repo := repository.NewRepository(db.Pool, logger)
-	svc := service.NewService(repo, logger)
-	grpcHandler := handler.NewGRPCHandler(svc, logger)
+	svc := service.NewService(repo, checkpointKey, cfg.CheckpointFile, logger)
+	if err := svc.InitLedgerChain(context.Background()); err != nil {
+		log.Fatalf("failed to start the ledger chain: %v", err)
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "journal" {
+		if err := runJournalCommand(context.Background(), svc, os.Args[2:]); err != nil {
+			log.Fatalf("journal failed: %v", err)
+		}
+		return
+	}
+
+	switch {
+	case checkpointKey == nil:
+		logger.Warn("no CHECKPOINT_KEY configured, ledger checkpoints are disabled")
+	case cfg.CheckpointInterval > 0:
+		checkpointCtx, stopCheckpoints := context.WithCancel(context.Background())
+		defer stopCheckpoints()
+		go svc.RunCheckpoints(checkpointCtx, cfg.CheckpointInterval)
+		logger.Infof("ledger checkpoints written to %s every %s", cfg.CheckpointFile, cfg.CheckpointInterval)
+	}
+
+	if cfg.AuditKey == "" {
+		logger.Warn("no AUDIT_KEY configured, every caller is audited as UNKNOWN")
+	}
+	if cfg.AdminKey == "" {
+		logger.Warn("no ADMIN_KEY configured, admin RPCs are disabled")
+	}
+	auditLog := audit.NewPostgresStore(db.Pool)
+	grpcHandler := handler.NewGRPCHandler(svc, auditLog, cfg.AdminKey, logger)
+	recorder := audit.NewRecorder("subledger", []byte(cfg.AuditKey), auditLog, auditSnapshots(grpcHandler), logger)
 	grpcPort := os.Getenv("GRPC_PORT")
 	if grpcPort == "" {
 		grpcPort = "50051"
@@ -57,8 +115,8 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(requestid.UnaryServerInterceptor()),
-		grpc.StreamInterceptor(requestid.StreamServerInterceptor()),
+		grpc.ChainUnaryInterceptor(requestid.UnaryServerInterceptor(), recorder.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(requestid.StreamServerInterceptor(), recorder.StreamServerInterceptor()),
 	)
 	pb.RegisterSubledgerServiceServer(grpcServer, grpcHandler)
 
@@ -68,3 +126,19 @@ func main() {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// parseCheckpointKey returns the ed25519 key with the given hex seed, or nil
+// for an empty seed.
+func parseCheckpointKey(hexSeed string) (ed25519.PrivateKey, error) {
+	if hexSeed == "" {
+		return nil, nil
+	}
+	seed, err := hex.DecodeString(hexSeed)
+	if err != nil {
+		return nil, err
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("want a %d byte seed, got %d bytes", ed25519.SeedSize, len(seed))
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
@@ -1,6 +1,8 @@
 package accounts
 
 import (
+	"time"
+
 	"github.com/ChotongW/grit_demo_wallet/config"
 
 	"github.com/ilyakaznacheev/cleanenv"
@@ -13,6 +15,50 @@ type ServiceConfig struct {
 	LogLineDetails bool            `yaml:"log_line_details" env:"LOG_LINE_DETAILS" env-default:"false"`
 	Port           int             `yaml:"grpc_port" env:"GRPC_PORT" env-default:"50051"`
 	DbConfig       config.DbConfig `yaml:"database"`
+	// SystemAccounts overrides the role -> account id assignments stored in
+	// the database, e.g. SYSTEM_ACCOUNTS=PSP:2004,FEE_INCOME:2005.
+	SystemAccounts map[string]string `yaml:"system_accounts" env:"SYSTEM_ACCOUNTS"`
+	// SchedulerInterval is how often due scheduled transfers are executed;
+	// zero disables the worker on this replica.
+	SchedulerInterval time.Duration `yaml:"scheduler_interval" env:"SCHEDULER_INTERVAL" env-default:"30s"`
+	// Notifier delivers verification tokens and other user messages: "log"
+	// writes them to the service log, "file" appends them to NotifierFile.
+	// It stands in for every channel without a provider configured below.
+	Notifier     string     `yaml:"notifier" env:"NOTIFIER" env-default:"log"`
+	NotifierFile string     `yaml:"notifier_file" env:"NOTIFIER_FILE" env-default:"notifications.log"`
+	SMTP         SMTPConfig `yaml:"smtp"`
+	// SMSGatewayURL and PushGatewayURL receive SMS and push notifications as
+	// JSON posts.
+	SMSGatewayURL    string `yaml:"sms_gateway_url" env:"SMS_GATEWAY_URL"`
+	SMSGatewayToken  string `yaml:"sms_gateway_token" env:"SMS_GATEWAY_TOKEN"`
+	PushGatewayURL   string `yaml:"push_gateway_url" env:"PUSH_GATEWAY_URL"`
+	PushGatewayToken string `yaml:"push_gateway_token" env:"PUSH_GATEWAY_TOKEN"`
+	// NotificationInterval is how often queued notifications are delivered;
+	// zero disables delivery on this replica.
+	NotificationInterval time.Duration `yaml:"notification_interval" env:"NOTIFICATION_INTERVAL" env-default:"10s"`
+	// WebhookInterval is how often queued partner webhooks are delivered;
+	// zero disables delivery on this replica.
+	WebhookInterval time.Duration `yaml:"webhook_interval" env:"WEBHOOK_INTERVAL" env-default:"10s"`
+	// PayoutInterval is how often approved payout batches are looked for;
+	// zero disables the payout worker on this replica.
+	PayoutInterval time.Duration `yaml:"payout_interval" env:"PAYOUT_INTERVAL" env-default:"30s"`
+	// AuditKey signs the caller passed on to the subledger and verifies the
+	// one the gateway passes on; callers not signed with it are logged as
+	// UNKNOWN.
+	AuditKey string `yaml:"audit_key" env:"AUDIT_KEY"`
+	// AdminKey must be sent as x-admin-key metadata on admin RPCs; empty
+	// disables them.
+	AdminKey string `yaml:"admin_key" env:"ADMIN_KEY"`
+}
+
+// SMTPConfig configures email delivery; an empty Host leaves email to the
+// fallback notifier.
+type SMTPConfig struct {
+	Host     string `yaml:"host" env:"SMTP_HOST"`
+	Port     int    `yaml:"port" env:"SMTP_PORT" env-default:"587"`
+	Username string `yaml:"username" env:"SMTP_USERNAME"`
+	Password string `yaml:"password" env:"SMTP_PASSWORD"`
+	From     string `yaml:"from" env:"SMTP_FROM" env-default:"no-reply@grit-demo-wallet.local"`
 }
 
 func LoadConfig(path string) (*ServiceConfig, error) {
@@ -18,6 +18,7 @@ type DbConfig struct {
 	MaxConnIdleTime   time.Duration `yaml:"database_max_conn_idle_time" env:"DATABASE_MAX_CONN_IDLE_TIME" env-default:"5m"`
 	MaxConnLifetime   time.Duration `yaml:"database_max_conn_lifetime" env:"DATABASE_MAX_CONN_LIFETIME" env-default:"30m"`
 	HealthCheckPeriod time.Duration `yaml:"database_health_check_period" env:"DATABASE_HEALTH_CHECK_PERIOD" env-default:"1m"`
+	AutoMigrate       bool          `yaml:"database_auto_migrate" env:"DATABASE_AUTO_MIGRATE" env-default:"true"`
 }
 
 // func LoadConfig(path string) (*DbConfig, error) {
@@ -20,6 +20,9 @@ type ServiceConfig struct {
 	AccountsService   string        `yaml:"accounts_service" env:"ACCOUNTS_SERVICE" env-default:"localhost:50052"`
 	GrpcTimeout       time.Duration `yaml:"grpc_timeout" env:"GRPC_TIMEOUT" env-default:"5s"`
 	ApiKey            string        `yaml:"api_key" env:"API_KEY" env-default:"secret"`
+	// AuditKey signs the caller passed on to the services for their audit
+	// logs; it must match theirs.
+	AuditKey string `yaml:"audit_key" env:"AUDIT_KEY"`
 }
 
 func LoadConfig(path string) (*ServiceConfig, error) {
@@ -1,6 +1,8 @@
 package subledger
 
 import (
+	"time"
+
 	"github.com/ChotongW/grit_demo_wallet/config"
 
 	"github.com/ilyakaznacheev/cleanenv"
@@ -13,6 +15,19 @@ type ServiceConfig struct {
 	LogLineDetails bool            `yaml:"log_line_details" env:"LOG_LINE_DETAILS" env-default:"false"`
 	Port           int             `yaml:"grpc_port" env:"GRPC_PORT" env-default:"50051"`
 	DbConfig       config.DbConfig `yaml:"database"`
+	// CheckpointKey is the hex ed25519 seed that signs checkpoints of the
+	// ledger hash chain; empty disables checkpoints.
+	CheckpointKey string `yaml:"checkpoint_key" env:"CHECKPOINT_KEY"`
+	// CheckpointInterval is how often the chain head is checkpointed to
+	// CheckpointFile; zero disables the worker on this replica.
+	CheckpointInterval time.Duration `yaml:"checkpoint_interval" env:"CHECKPOINT_INTERVAL" env-default:"1h"`
+	CheckpointFile     string        `yaml:"checkpoint_file" env:"CHECKPOINT_FILE" env-default:"ledger-checkpoints.jsonl"`
+	// AuditKey verifies the caller that other services pass on for the audit
+	// log; callers not signed with it are logged as UNKNOWN.
+	AuditKey string `yaml:"audit_key" env:"AUDIT_KEY"`
+	// AdminKey must be sent as x-admin-key metadata on admin RPCs; empty
+	// disables them.
+	AdminKey string `yaml:"admin_key" env:"ADMIN_KEY"`
 }
 
 func LoadConfig(path string) (*ServiceConfig, error) {
@@ -1,14 +1,139 @@
 package errors
 
-import "errors"
+import "github.com/ChotongW/grit_demo_wallet/pkg/apperror"
+
+// Domain is the google.rpc.ErrorInfo domain for errors raised by the accounts service.
+const Domain = "accounts.grit-demo-wallet"
+
+// Reason codes are part of the public API; clients match on them, so they
+// must never change once released.
+const (
+	ReasonAccountNotFound       = "ACCOUNT_NOT_FOUND"
+	ReasonEmailAlreadyExists    = "EMAIL_ALREADY_EXISTS"
+	ReasonInsufficientBalance   = "INSUFFICIENT_BALANCE"
+	ReasonInvalidReferrer       = "INVALID_REFERRER"
+	ReasonTransferToSameAccount = "TRANSFER_TO_SAME_ACCOUNT"
+	ReasonAmountMustBePositive  = "AMOUNT_MUST_BE_POSITIVE"
+	ReasonInvalidAmount         = "INVALID_AMOUNT"
+	ReasonInvalidInitialBalance = "INVALID_INITIAL_BALANCE"
+	ReasonInvalidCursor         = "INVALID_CURSOR"
+	ReasonInvalidHistoryFilter  = "INVALID_HISTORY_FILTER"
+	ReasonTransactionNotFound   = "TRANSACTION_NOT_FOUND"
+	ReasonAccountIDRequired     = "ACCOUNT_ID_REQUIRED"
+	ReasonInvalidPeriod         = "INVALID_STATEMENT_PERIOD"
+	ReasonUnsupportedFormat     = "UNSUPPORTED_STATEMENT_FORMAT"
+	ReasonUnknownSystemRole     = "UNKNOWN_SYSTEM_ROLE"
+	ReasonInvalidSystemAccount  = "INVALID_SYSTEM_ACCOUNT"
+	ReasonSystemAccountInUse    = "SYSTEM_ACCOUNT_IN_USE"
+	ReasonSystemAccountMissing  = "SYSTEM_ACCOUNT_NOT_CONFIGURED"
+	ReasonUnsupportedOperation  = "UNSUPPORTED_FEE_OPERATION"
+	ReasonInvalidAccrualDate    = "INVALID_ACCRUAL_DATE"
+	ReasonInvalidInterestPeriod = "INVALID_INTEREST_PERIOD"
+	ReasonInterestRunPending    = "INTEREST_RUN_PENDING"
+	ReasonScheduleNotFound      = "SCHEDULED_TRANSFER_NOT_FOUND"
+	ReasonInvalidSchedule       = "INVALID_SCHEDULE"
+	ReasonInvalidSchedulePolicy = "INVALID_SCHEDULE_POLICY"
+	ReasonInvalidScheduleState  = "INVALID_SCHEDULE_STATE"
+	ReasonRequestNotFound       = "PAYMENT_REQUEST_NOT_FOUND"
+	ReasonRequestNotPending     = "PAYMENT_REQUEST_NOT_PENDING"
+	ReasonRequestExpired        = "PAYMENT_REQUEST_EXPIRED"
+	ReasonRequestToSelf         = "PAYMENT_REQUEST_TO_SELF"
+	ReasonInvalidRequestExpiry  = "INVALID_PAYMENT_REQUEST_EXPIRY"
+	ReasonInvalidRequestFilter  = "INVALID_PAYMENT_REQUEST_FILTER"
+	ReasonRecipientNotFound     = "RECIPIENT_NOT_FOUND"
+	ReasonInvalidRecipient      = "INVALID_RECIPIENT"
+	ReasonAmbiguousRecipient    = "AMBIGUOUS_RECIPIENT"
+	ReasonInvalidHandle         = "INVALID_HANDLE"
+	ReasonHandleTaken           = "HANDLE_TAKEN"
+	ReasonUserNotFound          = "USER_NOT_FOUND"
+	ReasonInvalidWalletName     = "INVALID_WALLET_NAME"
+	ReasonWalletNameTaken       = "WALLET_NAME_TAKEN"
+	ReasonUnsupportedCurrency   = "UNSUPPORTED_CURRENCY"
+	ReasonInvalidProfile        = "INVALID_PROFILE"
+	ReasonInvalidEmail          = "INVALID_EMAIL"
+	ReasonInvalidEmailToken     = "INVALID_EMAIL_CHANGE_TOKEN"
+	ReasonInvalidNotifyPrefs    = "INVALID_NOTIFICATION_PREFERENCES"
+	ReasonWebhookNotFound       = "WEBHOOK_NOT_FOUND"
+	ReasonInvalidWebhook        = "INVALID_WEBHOOK"
+	ReasonDeliveryNotFound      = "WEBHOOK_DELIVERY_NOT_FOUND"
+	ReasonInvalidDeliveryFilter = "INVALID_DELIVERY_FILTER"
+	ReasonPayoutNotFound        = "PAYOUT_BATCH_NOT_FOUND"
+	ReasonInvalidPayoutFile     = "INVALID_PAYOUT_FILE"
+	ReasonInvalidPayoutState    = "INVALID_PAYOUT_STATE"
+	ReasonInvalidPayoutFilter   = "INVALID_PAYOUT_FILTER"
+	ReasonPayoutUserRequired    = "PAYOUT_USER_REQUIRED"
+	ReasonPayoutSelfApproval    = "PAYOUT_SELF_APPROVAL"
+	ReasonInternal              = apperror.ReasonInternal
+)
 
 var (
-	ErrAccountNotFound              = errors.New("account not found")
-	ErrEmailAlreadyExists           = errors.New("email already exists")
-	ErrInsufficientBalance          = errors.New("insufficient balance")
-	ErrInvalidReferrer              = errors.New("invalid referrer")
-	ErrTransferToSameAccount        = errors.New("cannot transfer to the same account")
-	ErrDepositAmountMustBePositive  = errors.New("deposit amount must be positive")
-	ErrWithdrawAmountMustBePositive = errors.New("withdrawal amount must be positive")
-	ErrTransferAmountMustBePositive = errors.New("transfer amount must be positive")
+	ErrAccountNotFound              = apperror.New(apperror.KindNotFound, ReasonAccountNotFound, "account not found")
+	ErrEmailAlreadyExists           = &apperror.Error{Kind: apperror.KindAlreadyExists, Reason: ReasonEmailAlreadyExists, Field: "email", Message: "email already exists"}
+	ErrInsufficientBalance          = apperror.New(apperror.KindFailedPrecondition, ReasonInsufficientBalance, "insufficient balance")
+	ErrInvalidReferrer              = apperror.Invalid(ReasonInvalidReferrer, "referrer_account_id", "invalid referrer")
+	ErrTransferToSameAccount        = apperror.Invalid(ReasonTransferToSameAccount, "to_account_id", "cannot transfer to the same account")
+	ErrDepositAmountMustBePositive  = apperror.Invalid(ReasonAmountMustBePositive, "amount", "deposit amount must be positive")
+	ErrWithdrawAmountMustBePositive = apperror.Invalid(ReasonAmountMustBePositive, "amount", "withdrawal amount must be positive")
+	ErrTransferAmountMustBePositive = apperror.Invalid(ReasonAmountMustBePositive, "amount", "transfer amount must be positive")
+	ErrFundingAmountMustBePositive  = apperror.Invalid(ReasonAmountMustBePositive, "amount", "funding amount must be positive")
+	ErrInvalidAmount                = apperror.Invalid(ReasonInvalidAmount, "amount", "invalid amount")
+	ErrInvalidInitialBalance        = apperror.Invalid(ReasonInvalidInitialBalance, "initial_balance", "invalid initial balance")
+	ErrInvalidCursor                = apperror.Invalid(ReasonInvalidCursor, "cursor", "invalid cursor")
+	ErrInvalidHistoryFilter         = apperror.Invalid(ReasonInvalidHistoryFilter, "", "invalid history filter")
+	ErrTransactionNotFound          = apperror.New(apperror.KindNotFound, ReasonTransactionNotFound, "transaction not found")
+	ErrAccountIDRequired            = apperror.Invalid(ReasonAccountIDRequired, "account_id", "account_id is required")
+	ErrInvalidStatementPeriod       = apperror.Invalid(ReasonInvalidPeriod, "period", "invalid statement period")
+	ErrUnsupportedStatementFormat   = apperror.Invalid(ReasonUnsupportedFormat, "format", "unsupported statement format")
+	ErrUnknownSystemRole            = apperror.Invalid(ReasonUnknownSystemRole, "role", "unknown system account role")
+	ErrInvalidSystemAccount         = apperror.Invalid(ReasonInvalidSystemAccount, "account_id", "account cannot be used as a system account")
+	ErrSystemAccountInUse           = &apperror.Error{Kind: apperror.KindAlreadyExists, Reason: ReasonSystemAccountInUse, Field: "account_id", Message: "account already holds another system role"}
+	ErrSystemAccountNotConfigured   = apperror.New(apperror.KindFailedPrecondition, ReasonSystemAccountMissing, "system account not configured")
+	ErrUnsupportedFeeOperation      = apperror.Invalid(ReasonUnsupportedOperation, "operation", "unsupported fee operation")
+	ErrInvalidAccrualDate           = apperror.Invalid(ReasonInvalidAccrualDate, "date", "invalid accrual date")
+	ErrInvalidInterestPeriod        = apperror.Invalid(ReasonInvalidInterestPeriod, "period", "invalid interest period")
+	ErrInterestRunPending           = apperror.New(apperror.KindFailedPrecondition, ReasonInterestRunPending, "interest accrual runs are still pending")
+	ErrScheduledTransferNotFound    = apperror.New(apperror.KindNotFound, ReasonScheduleNotFound, "scheduled transfer not found")
+	ErrInvalidSchedule              = apperror.Invalid(ReasonInvalidSchedule, "schedule", "invalid schedule")
+	ErrInvalidSchedulePolicy        = apperror.Invalid(ReasonInvalidSchedulePolicy, "on_insufficient_funds", "invalid insufficient funds policy")
+	ErrInvalidScheduleState         = apperror.New(apperror.KindFailedPrecondition, ReasonInvalidScheduleState, "scheduled transfer cannot change state")
+	ErrPaymentRequestNotFound       = apperror.New(apperror.KindNotFound, ReasonRequestNotFound, "payment request not found")
+	ErrPaymentRequestNotPending     = apperror.New(apperror.KindFailedPrecondition, ReasonRequestNotPending, "payment request is no longer pending")
+	ErrPaymentRequestExpired        = apperror.New(apperror.KindFailedPrecondition, ReasonRequestExpired, "payment request has expired")
+	ErrPaymentRequestToSelf         = apperror.Invalid(ReasonRequestToSelf, "payer_account_id", "cannot request money from the same account")
+	ErrInvalidPaymentRequestExpiry  = apperror.Invalid(ReasonInvalidRequestExpiry, "expires_at", "invalid payment request expiry")
+	ErrInvalidPaymentRequestFilter  = apperror.Invalid(ReasonInvalidRequestFilter, "direction", "direction must be INCOMING or OUTGOING")
+	ErrRecipientNotFound            = &apperror.Error{Kind: apperror.KindNotFound, Reason: ReasonRecipientNotFound, Field: "recipient", Message: "no account matches the recipient"}
+	ErrInvalidRecipient             = apperror.Invalid(ReasonInvalidRecipient, "recipient", "invalid recipient")
+	ErrAmbiguousRecipient           = apperror.Invalid(ReasonAmbiguousRecipient, "recipient", "recipient matches more than one user; use their handle or account id")
+	ErrInvalidHandle                = apperror.Invalid(ReasonInvalidHandle, "handle", "handles are 3 to 30 letters, digits, dots or underscores and start with a letter")
+	ErrHandleTaken                  = &apperror.Error{Kind: apperror.KindAlreadyExists, Reason: ReasonHandleTaken, Field: "handle", Message: "handle is already taken"}
+	ErrUserNotFound                 = apperror.New(apperror.KindNotFound, ReasonUserNotFound, "user not found")
+	ErrInvalidWalletName            = apperror.Invalid(ReasonInvalidWalletName, "name", "wallet names are 1 to 50 characters")
+	ErrWalletNameTaken              = &apperror.Error{Kind: apperror.KindAlreadyExists, Reason: ReasonWalletNameTaken, Field: "name", Message: "user already has a wallet with this name"}
+	ErrUnsupportedCurrency          = apperror.Invalid(ReasonUnsupportedCurrency, "currency", "unsupported currency")
+	ErrInvalidName                  = apperror.Invalid(ReasonInvalidProfile, "name", "names are at most 100 characters")
+	ErrInvalidPhone                 = apperror.Invalid(ReasonInvalidProfile, "phone", "phone numbers must be in E.164 format, e.g. +66812345678")
+	ErrInvalidAddress               = apperror.Invalid(ReasonInvalidProfile, "address", "addresses are at most 500 characters")
+	ErrInvalidLocale                = apperror.Invalid(ReasonInvalidProfile, "locale", "locales are language tags such as en or th-TH")
+	ErrInvalidEmail                 = apperror.Invalid(ReasonInvalidEmail, "email", "invalid email")
+	ErrInvalidEmailChangeToken      = apperror.Invalid(ReasonInvalidEmailToken, "token", "email change token is invalid or has expired")
+	ErrInvalidNotificationChannel   = apperror.Invalid(ReasonInvalidNotifyPrefs, "channels", "invalid notification channel")
+	ErrInvalidLowBalanceThreshold   = apperror.Invalid(ReasonInvalidNotifyPrefs, "low_balance_threshold", "low balance threshold must be positive")
+	ErrWebhookNotFound              = apperror.New(apperror.KindNotFound, ReasonWebhookNotFound, "webhook not found")
+	ErrInvalidWebhookURL            = apperror.Invalid(ReasonInvalidWebhook, "url", "webhook url must be an absolute http or https url")
+	ErrInvalidWebhookEvents         = apperror.Invalid(ReasonInvalidWebhook, "events", "invalid webhook events")
+	ErrWebhookDeliveryNotFound      = apperror.New(apperror.KindNotFound, ReasonDeliveryNotFound, "webhook delivery not found")
+	ErrInvalidDeliveryFilter        = apperror.Invalid(ReasonInvalidDeliveryFilter, "status", "status must be PENDING, DELIVERED or DEAD")
+	ErrPayoutBatchNotFound          = apperror.New(apperror.KindNotFound, ReasonPayoutNotFound, "payout batch not found")
+	ErrInvalidPayoutFile            = apperror.Invalid(ReasonInvalidPayoutFile, "file", "invalid payout file")
+	ErrInvalidPayoutState           = apperror.New(apperror.KindFailedPrecondition, ReasonInvalidPayoutState, "payout batch cannot change state")
+	ErrInvalidPayoutFilter          = apperror.Invalid(ReasonInvalidPayoutFilter, "status", "invalid payout status")
+	ErrPayoutUserRequired           = apperror.Invalid(ReasonPayoutUserRequired, "user_id", "the user acting on a payout batch is required")
+	ErrPayoutSelfApproval           = apperror.New(apperror.KindPermissionDenied, ReasonPayoutSelfApproval, "a payout batch must be approved by someone other than its uploader")
 )
+
+// Reason returns the stable machine-readable reason code for err, or
+// ReasonInternal if err does not wrap one of the sentinels above.
+func Reason(err error) string {
+	return apperror.ReasonOf(err)
+}
@@ -0,0 +1,149 @@
+package errors_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/pkg/apperror"
+	"github.com/ChotongW/grit_demo_wallet/pkg/rpcerror"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSentinelsMapToStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		reason string
+		field  string
+	}{
+		{"account not found", accountErrors.ErrAccountNotFound, codes.NotFound, accountErrors.ReasonAccountNotFound, ""},
+		{"email already exists", accountErrors.ErrEmailAlreadyExists, codes.AlreadyExists, accountErrors.ReasonEmailAlreadyExists, "email"},
+		{"insufficient balance", accountErrors.ErrInsufficientBalance, codes.FailedPrecondition, accountErrors.ReasonInsufficientBalance, ""},
+		{"invalid referrer", accountErrors.ErrInvalidReferrer, codes.InvalidArgument, accountErrors.ReasonInvalidReferrer, "referrer_account_id"},
+		{"transfer to same account", accountErrors.ErrTransferToSameAccount, codes.InvalidArgument, accountErrors.ReasonTransferToSameAccount, "to_account_id"},
+		{"deposit amount", accountErrors.ErrDepositAmountMustBePositive, codes.InvalidArgument, accountErrors.ReasonAmountMustBePositive, "amount"},
+		{"withdraw amount", accountErrors.ErrWithdrawAmountMustBePositive, codes.InvalidArgument, accountErrors.ReasonAmountMustBePositive, "amount"},
+		{"transfer amount", accountErrors.ErrTransferAmountMustBePositive, codes.InvalidArgument, accountErrors.ReasonAmountMustBePositive, "amount"},
+		{"funding amount", accountErrors.ErrFundingAmountMustBePositive, codes.InvalidArgument, accountErrors.ReasonAmountMustBePositive, "amount"},
+		{"invalid amount", accountErrors.ErrInvalidAmount, codes.InvalidArgument, accountErrors.ReasonInvalidAmount, "amount"},
+		{"invalid initial balance", accountErrors.ErrInvalidInitialBalance, codes.InvalidArgument, accountErrors.ReasonInvalidInitialBalance, "initial_balance"},
+		{"invalid cursor", accountErrors.ErrInvalidCursor, codes.InvalidArgument, accountErrors.ReasonInvalidCursor, "cursor"},
+		{"invalid history filter", accountErrors.ErrInvalidHistoryFilter, codes.InvalidArgument, accountErrors.ReasonInvalidHistoryFilter, ""},
+		{"transaction not found", accountErrors.ErrTransactionNotFound, codes.NotFound, accountErrors.ReasonTransactionNotFound, ""},
+		{"account id required", accountErrors.ErrAccountIDRequired, codes.InvalidArgument, accountErrors.ReasonAccountIDRequired, "account_id"},
+		{"invalid statement period", accountErrors.ErrInvalidStatementPeriod, codes.InvalidArgument, accountErrors.ReasonInvalidPeriod, "period"},
+		{"unsupported statement format", accountErrors.ErrUnsupportedStatementFormat, codes.InvalidArgument, accountErrors.ReasonUnsupportedFormat, "format"},
+		{"unknown system role", accountErrors.ErrUnknownSystemRole, codes.InvalidArgument, accountErrors.ReasonUnknownSystemRole, "role"},
+		{"invalid system account", accountErrors.ErrInvalidSystemAccount, codes.InvalidArgument, accountErrors.ReasonInvalidSystemAccount, "account_id"},
+		{"system account in use", accountErrors.ErrSystemAccountInUse, codes.AlreadyExists, accountErrors.ReasonSystemAccountInUse, "account_id"},
+		{"system account not configured", accountErrors.ErrSystemAccountNotConfigured, codes.FailedPrecondition, accountErrors.ReasonSystemAccountMissing, ""},
+		{"unsupported fee operation", accountErrors.ErrUnsupportedFeeOperation, codes.InvalidArgument, accountErrors.ReasonUnsupportedOperation, "operation"},
+		{"invalid accrual date", accountErrors.ErrInvalidAccrualDate, codes.InvalidArgument, accountErrors.ReasonInvalidAccrualDate, "date"},
+		{"invalid interest period", accountErrors.ErrInvalidInterestPeriod, codes.InvalidArgument, accountErrors.ReasonInvalidInterestPeriod, "period"},
+		{"interest run pending", accountErrors.ErrInterestRunPending, codes.FailedPrecondition, accountErrors.ReasonInterestRunPending, ""},
+		{"scheduled transfer not found", accountErrors.ErrScheduledTransferNotFound, codes.NotFound, accountErrors.ReasonScheduleNotFound, ""},
+		{"invalid schedule", accountErrors.ErrInvalidSchedule, codes.InvalidArgument, accountErrors.ReasonInvalidSchedule, "schedule"},
+		{"invalid schedule policy", accountErrors.ErrInvalidSchedulePolicy, codes.InvalidArgument, accountErrors.ReasonInvalidSchedulePolicy, "on_insufficient_funds"},
+		{"invalid schedule state", accountErrors.ErrInvalidScheduleState, codes.FailedPrecondition, accountErrors.ReasonInvalidScheduleState, ""},
+		{"payment request not found", accountErrors.ErrPaymentRequestNotFound, codes.NotFound, accountErrors.ReasonRequestNotFound, ""},
+		{"payment request not pending", accountErrors.ErrPaymentRequestNotPending, codes.FailedPrecondition, accountErrors.ReasonRequestNotPending, ""},
+		{"payment request expired", accountErrors.ErrPaymentRequestExpired, codes.FailedPrecondition, accountErrors.ReasonRequestExpired, ""},
+		{"payment request to self", accountErrors.ErrPaymentRequestToSelf, codes.InvalidArgument, accountErrors.ReasonRequestToSelf, "payer_account_id"},
+		{"invalid payment request expiry", accountErrors.ErrInvalidPaymentRequestExpiry, codes.InvalidArgument, accountErrors.ReasonInvalidRequestExpiry, "expires_at"},
+		{"invalid payment request filter", accountErrors.ErrInvalidPaymentRequestFilter, codes.InvalidArgument, accountErrors.ReasonInvalidRequestFilter, "direction"},
+		{"recipient not found", accountErrors.ErrRecipientNotFound, codes.NotFound, accountErrors.ReasonRecipientNotFound, "recipient"},
+		{"invalid recipient", accountErrors.ErrInvalidRecipient, codes.InvalidArgument, accountErrors.ReasonInvalidRecipient, "recipient"},
+		{"ambiguous recipient", accountErrors.ErrAmbiguousRecipient, codes.InvalidArgument, accountErrors.ReasonAmbiguousRecipient, "recipient"},
+		{"invalid handle", accountErrors.ErrInvalidHandle, codes.InvalidArgument, accountErrors.ReasonInvalidHandle, "handle"},
+		{"handle taken", accountErrors.ErrHandleTaken, codes.AlreadyExists, accountErrors.ReasonHandleTaken, "handle"},
+		{"user not found", accountErrors.ErrUserNotFound, codes.NotFound, accountErrors.ReasonUserNotFound, ""},
+		{"invalid wallet name", accountErrors.ErrInvalidWalletName, codes.InvalidArgument, accountErrors.ReasonInvalidWalletName, "name"},
+		{"wallet name taken", accountErrors.ErrWalletNameTaken, codes.AlreadyExists, accountErrors.ReasonWalletNameTaken, "name"},
+		{"unsupported currency", accountErrors.ErrUnsupportedCurrency, codes.InvalidArgument, accountErrors.ReasonUnsupportedCurrency, "currency"},
+		{"invalid name", accountErrors.ErrInvalidName, codes.InvalidArgument, accountErrors.ReasonInvalidProfile, "name"},
+		{"invalid phone", accountErrors.ErrInvalidPhone, codes.InvalidArgument, accountErrors.ReasonInvalidProfile, "phone"},
+		{"invalid address", accountErrors.ErrInvalidAddress, codes.InvalidArgument, accountErrors.ReasonInvalidProfile, "address"},
+		{"invalid locale", accountErrors.ErrInvalidLocale, codes.InvalidArgument, accountErrors.ReasonInvalidProfile, "locale"},
+		{"invalid email", accountErrors.ErrInvalidEmail, codes.InvalidArgument, accountErrors.ReasonInvalidEmail, "email"},
+		{"invalid email change token", accountErrors.ErrInvalidEmailChangeToken, codes.InvalidArgument, accountErrors.ReasonInvalidEmailToken, "token"},
+		{"invalid notification channel", accountErrors.ErrInvalidNotificationChannel, codes.InvalidArgument, accountErrors.ReasonInvalidNotifyPrefs, "channels"},
+		{"invalid low balance threshold", accountErrors.ErrInvalidLowBalanceThreshold, codes.InvalidArgument, accountErrors.ReasonInvalidNotifyPrefs, "low_balance_threshold"},
+		{"webhook not found", accountErrors.ErrWebhookNotFound, codes.NotFound, accountErrors.ReasonWebhookNotFound, ""},
+		{"invalid webhook url", accountErrors.ErrInvalidWebhookURL, codes.InvalidArgument, accountErrors.ReasonInvalidWebhook, "url"},
+		{"invalid webhook events", accountErrors.ErrInvalidWebhookEvents, codes.InvalidArgument, accountErrors.ReasonInvalidWebhook, "events"},
+		{"webhook delivery not found", accountErrors.ErrWebhookDeliveryNotFound, codes.NotFound, accountErrors.ReasonDeliveryNotFound, ""},
+		{"invalid delivery filter", accountErrors.ErrInvalidDeliveryFilter, codes.InvalidArgument, accountErrors.ReasonInvalidDeliveryFilter, "status"},
+		{"payout batch not found", accountErrors.ErrPayoutBatchNotFound, codes.NotFound, accountErrors.ReasonPayoutNotFound, ""},
+		{"invalid payout file", accountErrors.ErrInvalidPayoutFile, codes.InvalidArgument, accountErrors.ReasonInvalidPayoutFile, "file"},
+		{"invalid payout state", accountErrors.ErrInvalidPayoutState, codes.FailedPrecondition, accountErrors.ReasonInvalidPayoutState, ""},
+		{"invalid payout filter", accountErrors.ErrInvalidPayoutFilter, codes.InvalidArgument, accountErrors.ReasonInvalidPayoutFilter, "status"},
+		{"payout user required", accountErrors.ErrPayoutUserRequired, codes.InvalidArgument, accountErrors.ReasonPayoutUserRequired, "user_id"},
+		{"payout self approval", accountErrors.ErrPayoutSelfApproval, codes.PermissionDenied, accountErrors.ReasonPayoutSelfApproval, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapped := fmt.Errorf("%w: some context", tt.err)
+
+			if got := accountErrors.Reason(wrapped); got != tt.reason {
+				t.Errorf("Reason() = %q, want %q", got, tt.reason)
+			}
+
+			st, ok := status.FromError(apperror.ToStatus(accountErrors.Domain, wrapped))
+			if !ok {
+				t.Fatalf("ToStatus() did not return a gRPC status")
+			}
+			if st.Code() != tt.code {
+				t.Errorf("code = %v, want %v", st.Code(), tt.code)
+			}
+
+			info, violations := rpcerror.Details(st)
+			if info == nil {
+				t.Fatalf("missing ErrorInfo detail")
+			}
+			if info.GetReason() != tt.reason || info.GetDomain() != accountErrors.Domain {
+				t.Errorf("ErrorInfo = %s/%s, want %s/%s", info.GetDomain(), info.GetReason(), accountErrors.Domain, tt.reason)
+			}
+
+			if tt.field == "" {
+				if len(violations) != 0 {
+					t.Errorf("unexpected field violations: %+v", violations)
+				}
+				return
+			}
+			if len(violations) != 1 || violations[0].Field != tt.field {
+				t.Errorf("field violations = %+v, want field %q", violations, tt.field)
+			}
+		})
+	}
+}
+
+func TestUnclassifiedErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{"plain error", errors.New(`pq: relation "accounts" does not exist`), codes.Internal, "internal error"},
+		{"internal sentinel", fmt.Errorf("%w: key file /etc/wallet/key.pem", apperror.New(apperror.KindInternal, "KEY_UNREADABLE", "cannot read key")), codes.Internal, "internal error"},
+		{"deadline", fmt.Errorf("query: %w", context.DeadlineExceeded), codes.DeadlineExceeded, "query: context deadline exceeded"},
+		{"canceled", fmt.Errorf("query: %w", context.Canceled), codes.Canceled, "query: context canceled"},
+		{"downstream status", fmt.Errorf("failed to create deposit transaction: %w", status.Error(codes.Unavailable, "subledger down")), codes.Unavailable, "failed to create deposit transaction: rpc error: code = Unavailable desc = subledger down"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, _ := status.FromError(apperror.ToStatus(accountErrors.Domain, tt.err))
+			if st.Code() != tt.code || st.Message() != tt.message {
+				t.Errorf("status = %v %q, want %v %q", st.Code(), st.Message(), tt.code, tt.message)
+			}
+		})
+	}
+}
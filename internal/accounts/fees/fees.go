@@ -0,0 +1,148 @@
+// Package fees evaluates the fee schedule for wallet operations.
+package fees
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+const (
+	OperationWithdraw = "WITHDRAW"
+	OperationTransfer = "TRANSFER"
+)
+
+// Item codes of a fee breakdown.
+const (
+	ItemFlat       = "FLAT"
+	ItemPercentage = "PERCENTAGE"
+	ItemMinimum    = "MINIMUM_FEE"
+	ItemCap        = "FEE_CAP"
+)
+
+func IsSupportedOperation(operation string) bool {
+	return operation == OperationWithdraw || operation == OperationTransfer
+}
+
+// Band is one step of a tiered rule. It covers amounts up to and including
+// UpTo; a nil UpTo covers everything above the previous band.
+type Band struct {
+	UpTo       *decimal.Decimal `json:"up_to"`
+	Flat       decimal.Decimal  `json:"flat"`
+	Percentage decimal.Decimal  `json:"percentage"`
+}
+
+// Rule is the fee charged for one operation and account tier. Percentage is
+// in percent, so 0.5 charges half a percent of the amount. When Bands is
+// set, the band covering the amount supplies the flat and percentage parts
+// instead. MinFee and MaxFee are applied last.
+type Rule struct {
+	ID          string
+	Operation   string
+	Tier        string // empty applies to every tier
+	Flat        decimal.Decimal
+	Percentage  decimal.Decimal
+	Bands       []Band
+	MinFee      *decimal.Decimal
+	MaxFee      *decimal.Decimal
+	Description string
+}
+
+type Item struct {
+	Code        string
+	Description string
+	Amount      decimal.Decimal
+}
+
+// Quote is the fee for one operation. The items always sum to Fee, which
+// is in the paying account's Currency.
+type Quote struct {
+	Operation string
+	Tier      string
+	RuleID    string
+	Currency  string
+	Amount    decimal.Decimal
+	Fee       decimal.Decimal
+	Items     []Item
+}
+
+// TotalDebit is what the paying account is charged: the amount plus the fee.
+func (q *Quote) TotalDebit() decimal.Decimal {
+	return q.Amount.Add(q.Fee)
+}
+
+// Select returns the rule that applies to operation and tier, preferring a
+// tier-specific rule over a catch-all one, or nil if none applies.
+func Select(rules []Rule, operation, tier string) *Rule {
+	var fallback *Rule
+	for i := range rules {
+		r := &rules[i]
+		if r.Operation != operation {
+			continue
+		}
+		if r.Tier == tier {
+			return r
+		}
+		if r.Tier == "" && fallback == nil {
+			fallback = r
+		}
+	}
+	return fallback
+}
+
+// Calculate quotes the fee for amount. Without an applicable rule the fee
+// is zero.
+func Calculate(rules []Rule, operation, tier string, amount decimal.Decimal) *Quote {
+	quote := &Quote{
+		Operation: operation,
+		Tier:      tier,
+		Amount:    amount,
+		Fee:       decimal.Zero,
+		Items:     []Item{},
+	}
+
+	rule := Select(rules, operation, tier)
+	if rule == nil {
+		return quote
+	}
+	quote.RuleID = rule.ID
+
+	flat, percentage := rule.Flat, rule.Percentage
+	if len(rule.Bands) > 0 {
+		band := bandFor(rule.Bands, amount)
+		flat, percentage = band.Flat, band.Percentage
+	}
+
+	if flat.IsPositive() {
+		quote.add(ItemFlat, "Flat fee", flat.Round(2))
+	}
+	if percentage.IsPositive() {
+		pct := amount.Mul(percentage).Div(decimal.NewFromInt(100)).Round(2)
+		if pct.IsPositive() {
+			quote.add(ItemPercentage, percentage.String()+"% of amount", pct)
+		}
+	}
+
+	if rule.MinFee != nil && quote.Fee.LessThan(*rule.MinFee) {
+		quote.add(ItemMinimum, "Minimum fee adjustment", rule.MinFee.Sub(quote.Fee))
+	}
+	if rule.MaxFee != nil && quote.Fee.GreaterThan(*rule.MaxFee) {
+		quote.add(ItemCap, "Fee cap", rule.MaxFee.Sub(quote.Fee))
+	}
+
+	return quote
+}
+
+func (q *Quote) add(code, description string, amount decimal.Decimal) {
+	q.Items = append(q.Items, Item{Code: code, Description: description, Amount: amount})
+	q.Fee = q.Fee.Add(amount)
+}
+
+// bandFor returns the first band covering amount. Bands are expected in
+// ascending UpTo order; an amount above every bound uses the last band.
+func bandFor(bands []Band, amount decimal.Decimal) Band {
+	for _, b := range bands {
+		if b.UpTo == nil || amount.LessThanOrEqual(*b.UpTo) {
+			return b
+		}
+	}
+	return bands[len(bands)-1]
+}
@@ -0,0 +1,151 @@
+package fees_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/fees"
+
+	"github.com/shopspring/decimal"
+)
+
+func dec(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+func decPtr(s string) *decimal.Decimal {
+	d := dec(s)
+	return &d
+}
+
+var testRules = []fees.Rule{
+	{
+		ID:         "withdraw",
+		Operation:  fees.OperationWithdraw,
+		Flat:       dec("1"),
+		Percentage: dec("0.5"),
+		MinFee:     decPtr("2"),
+		MaxFee:     decPtr("10"),
+	},
+	{
+		ID:        "withdraw-gold",
+		Operation: fees.OperationWithdraw,
+		Tier:      "GOLD",
+	},
+	{
+		ID:        "transfer",
+		Operation: fees.OperationTransfer,
+		Bands: []fees.Band{
+			{UpTo: decPtr("100"), Flat: dec("0.5")},
+			{UpTo: decPtr("1000"), Percentage: dec("1")},
+			{Flat: dec("5"), Percentage: dec("0.25")},
+		},
+		MaxFee: decPtr("20"),
+	},
+	{
+		ID:         "transfer-basic",
+		Operation:  fees.OperationTransfer,
+		Tier:       "BASIC",
+		Percentage: dec("1.5"),
+	},
+	{
+		ID:        "transfer-premium",
+		Operation: fees.OperationTransfer,
+		Tier:      "PREMIUM",
+		Flat:      dec("0.333"),
+	},
+	{
+		ID:        "transfer-bounded",
+		Operation: fees.OperationTransfer,
+		Tier:      "BOUNDED",
+		Bands: []fees.Band{
+			{UpTo: decPtr("10"), Flat: dec("1")},
+			{UpTo: decPtr("100"), Flat: dec("2")},
+		},
+	},
+}
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation string
+		tier      string
+		amount    string
+		ruleID    string
+		fee       string
+		items     []string
+	}{
+		{"no applicable rule", "DEPOSIT", "", "100", "", "0", nil},
+		{"flat and percentage", fees.OperationWithdraw, "", "1000", "withdraw", "6", []string{fees.ItemFlat, fees.ItemPercentage}},
+		{"raised to minimum", fees.OperationWithdraw, "", "100", "withdraw", "2", []string{fees.ItemFlat, fees.ItemPercentage, fees.ItemMinimum}},
+		{"cut to maximum", fees.OperationWithdraw, "", "5000", "withdraw", "10", []string{fees.ItemFlat, fees.ItemPercentage, fees.ItemCap}},
+		{"tier rule preferred", fees.OperationWithdraw, "GOLD", "1000", "withdraw-gold", "0", nil},
+		{"catch-all for other tiers", fees.OperationWithdraw, "SILVER", "1000", "withdraw", "6", []string{fees.ItemFlat, fees.ItemPercentage}},
+		{"first band", fees.OperationTransfer, "", "50", "transfer", "0.5", []string{fees.ItemFlat}},
+		{"band bound is inclusive", fees.OperationTransfer, "", "100", "transfer", "0.5", []string{fees.ItemFlat}},
+		{"second band", fees.OperationTransfer, "", "100.01", "transfer", "1", []string{fees.ItemPercentage}},
+		{"open-ended band", fees.OperationTransfer, "", "2000", "transfer", "10", []string{fees.ItemFlat, fees.ItemPercentage}},
+		{"open-ended band capped", fees.OperationTransfer, "", "10000", "transfer", "20", []string{fees.ItemFlat, fees.ItemPercentage, fees.ItemCap}},
+		{"above every band", fees.OperationTransfer, "BOUNDED", "500", "transfer-bounded", "2", []string{fees.ItemFlat}},
+		{"percentage rounds half up", fees.OperationTransfer, "BASIC", "10.35", "transfer-basic", "0.16", []string{fees.ItemPercentage}},
+		{"percentage rounds down", fees.OperationTransfer, "BASIC", "10.30", "transfer-basic", "0.15", []string{fees.ItemPercentage}},
+		{"percentage rounding to zero", fees.OperationTransfer, "BASIC", "0.33", "transfer-basic", "0", nil},
+		{"flat rounds to the cent", fees.OperationTransfer, "PREMIUM", "100", "transfer-premium", "0.33", []string{fees.ItemFlat}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote := fees.Calculate(testRules, tt.operation, tt.tier, dec(tt.amount))
+
+			if quote.RuleID != tt.ruleID {
+				t.Errorf("RuleID = %q, want %q", quote.RuleID, tt.ruleID)
+			}
+			if !quote.Fee.Equal(dec(tt.fee)) {
+				t.Errorf("Fee = %s, want %s", quote.Fee, tt.fee)
+			}
+			if want := dec(tt.amount).Add(dec(tt.fee)); !quote.TotalDebit().Equal(want) {
+				t.Errorf("TotalDebit = %s, want %s", quote.TotalDebit(), want)
+			}
+
+			var codes []string
+			sum := decimal.Zero
+			for _, item := range quote.Items {
+				codes = append(codes, item.Code)
+				sum = sum.Add(item.Amount)
+			}
+			if !reflect.DeepEqual(codes, tt.items) {
+				t.Errorf("items = %v, want %v", codes, tt.items)
+			}
+			if !sum.Equal(quote.Fee) {
+				t.Errorf("items sum to %s, fee is %s", sum, quote.Fee)
+			}
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation string
+		tier      string
+		ruleID    string
+	}{
+		{"tier rule", fees.OperationWithdraw, "GOLD", "withdraw-gold"},
+		{"catch-all", fees.OperationWithdraw, "", "withdraw"},
+		{"unknown tier", fees.OperationTransfer, "UNKNOWN", "transfer"},
+		{"unknown operation", "DEPOSIT", "GOLD", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := fees.Select(testRules, tt.operation, tt.tier)
+			got := ""
+			if rule != nil {
+				got = rule.ID
+			}
+			if got != tt.ruleID {
+				t.Errorf("Select = %q, want %q", got, tt.ruleID)
+			}
+		})
+	}
+}
@@ -2,29 +2,40 @@ package handler
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/fees"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
 	"github.com/ChotongW/grit_demo_wallet/internal/accounts/service"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/statement"
 	pb "github.com/ChotongW/grit_demo_wallet/pb/accounts"
+	"github.com/ChotongW/grit_demo_wallet/pkg/admin"
+	"github.com/ChotongW/grit_demo_wallet/pkg/apperror"
+	"github.com/ChotongW/grit_demo_wallet/pkg/audit"
+	"github.com/ChotongW/grit_demo_wallet/pkg/mask"
 	"github.com/ChotongW/grit_demo_wallet/pkg/requestid"
 
 	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type GRPCHandler struct {
 	pb.UnimplementedAccountsServiceServer
-	service *service.Service
-	logger  *logrus.Entry
+	service  *service.Service
+	auditLog audit.Store
+	adminKey string
+	logger   *logrus.Entry
 }
 
-func NewGRPCHandler(svc *service.Service, logger *logrus.Logger) *GRPCHandler {
+func NewGRPCHandler(svc *service.Service, auditLog audit.Store, adminKey string, logger *logrus.Logger) *GRPCHandler {
 	return &GRPCHandler{
-		service: svc,
+		service:  svc,
+		auditLog: auditLog,
+		adminKey: adminKey,
 		logger: logger.WithFields(logrus.Fields{
 			"package": "accounts/handler",
 		}),
@@ -39,28 +50,10 @@ func (h *GRPCHandler) loggerWithRequestID(ctx context.Context) *logrus.Entry {
 	return h.logger
 }
 
+// mapError converts service errors into gRPC statuses; the code and reason
+// come from the typed sentinels in internal/accounts/errors.
 func (h *GRPCHandler) mapError(err error) error {
-	if err == nil {
-		return nil
-	}
-	if errors.Is(err, accountErrors.ErrAccountNotFound) {
-		return status.Errorf(codes.NotFound, "%v", err)
-	}
-	if errors.Is(err, accountErrors.ErrEmailAlreadyExists) {
-		return status.Errorf(codes.AlreadyExists, "%v", err)
-	}
-	if errors.Is(err, accountErrors.ErrInsufficientBalance) {
-		return status.Errorf(codes.FailedPrecondition, "%v", err)
-	}
-	if errors.Is(err, accountErrors.ErrInvalidReferrer) ||
-		errors.Is(err, accountErrors.ErrTransferToSameAccount) ||
-		errors.Is(err, accountErrors.ErrDepositAmountMustBePositive) ||
-		errors.Is(err, accountErrors.ErrWithdrawAmountMustBePositive) ||
-		errors.Is(err, accountErrors.ErrTransferAmountMustBePositive) {
-		return status.Errorf(codes.InvalidArgument, "%v", err)
-	}
-
-	return status.Errorf(codes.Internal, "%v", err)
+	return apperror.ToStatus(accountErrors.Domain, err)
 }
 
 func (h *GRPCHandler) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
@@ -71,10 +64,10 @@ func (h *GRPCHandler) CreateAccount(ctx context.Context, req *pb.CreateAccountRe
 		var err error
 		initialBalance, err = decimal.NewFromString(req.InitialBalance)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid initial balance: %v", err)
+			return nil, h.mapError(fmt.Errorf("%w: %v", accountErrors.ErrInvalidInitialBalance, err))
 		}
 		if initialBalance.LessThan(decimal.Zero) {
-			return nil, status.Errorf(codes.InvalidArgument, "initial balance cannot be negative")
+			return nil, h.mapError(fmt.Errorf("%w: cannot be negative", accountErrors.ErrInvalidInitialBalance))
 		}
 	}
 
@@ -95,15 +88,7 @@ func (h *GRPCHandler) CreateAccount(ctx context.Context, req *pb.CreateAccountRe
 		Success:   true,
 		AccountId: account.AccountID,
 		Message:   "Account created successfully",
-		Account: &pb.Account{
-			AccountId:         account.AccountID,
-			AccountType:       account.AccountType,
-			UserId:            account.UserID,
-			Email:             account.Email,
-			ReferrerAccountId: account.ReferrerAccountID,
-			Balance:           balance.String(),
-			CreatedAt:         account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		},
+		Account:   toProtoAccount(account, balance),
 	}, nil
 }
 
@@ -118,22 +103,14 @@ func (h *GRPCHandler) GetAccount(ctx context.Context, req *pb.GetAccountRequest)
 
 	logger.Infof("retrieved account: %s", req.AccountId)
 	return &pb.GetAccountResponse{
-		Account: &pb.Account{
-			AccountId:         account.AccountID,
-			AccountType:       account.AccountType,
-			UserId:            account.UserID,
-			Email:             account.Email,
-			ReferrerAccountId: account.ReferrerAccountID,
-			Balance:           balance.String(),
-			CreatedAt:         account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		},
+		Account: toProtoAccount(account, balance),
 	}, nil
 }
 
 func (h *GRPCHandler) GetBalance(ctx context.Context, req *pb.GetBalanceRequest) (*pb.GetBalanceResponse, error) {
 	logger := h.loggerWithRequestID(ctx)
 
-	balance, err := h.service.GetBalance(ctx, req.AccountId)
+	account, balance, err := h.service.GetAccount(ctx, req.AccountId)
 	if err != nil {
 		logger.Errorf("failed to get balance: %v", err)
 		return nil, h.mapError(err)
@@ -143,7 +120,7 @@ func (h *GRPCHandler) GetBalance(ctx context.Context, req *pb.GetBalanceRequest)
 	return &pb.GetBalanceResponse{
 		AccountId: req.AccountId,
 		Balance:   balance.String(),
-		Currency:  "USD",
+		Currency:  account.Currency,
 	}, nil
 }
 
@@ -152,7 +129,7 @@ func (h *GRPCHandler) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.
 
 	amount, err := decimal.NewFromString(req.Amount)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+		return nil, h.mapError(fmt.Errorf("%w: %v", accountErrors.ErrInvalidAmount, err))
 	}
 
 	txnID, newBalance, err := h.service.Deposit(ctx, req.AccountId, amount, req.Description)
@@ -175,10 +152,10 @@ func (h *GRPCHandler) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*p
 
 	amount, err := decimal.NewFromString(req.Amount)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+		return nil, h.mapError(fmt.Errorf("%w: %v", accountErrors.ErrInvalidAmount, err))
 	}
 
-	txnID, newBalance, err := h.service.Withdraw(ctx, req.AccountId, amount, req.Description)
+	txnID, newBalance, quote, err := h.service.Withdraw(ctx, req.AccountId, amount, req.Description)
 	if err != nil {
 		logger.Errorf("failed to withdraw: %v", err)
 		return nil, h.mapError(err)
@@ -190,6 +167,7 @@ func (h *GRPCHandler) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*p
 		TransactionId: txnID,
 		NewBalance:    newBalance.String(),
 		Message:       "Withdrawal successful",
+		Fee:           toProtoFee(quote),
 	}, nil
 }
 
@@ -198,10 +176,17 @@ func (h *GRPCHandler) Transfer(ctx context.Context, req *pb.TransferRequest) (*p
 
 	amount, err := decimal.NewFromString(req.Amount)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+		return nil, h.mapError(fmt.Errorf("%w: %v", accountErrors.ErrInvalidAmount, err))
+	}
+
+	if req.Recipient != "" {
+		if req.ToAccountId != "" {
+			return nil, h.mapError(fmt.Errorf("%w: set either to_account_id or recipient", accountErrors.ErrInvalidRecipient))
+		}
+		return h.transferToRecipient(ctx, req, amount)
 	}
 
-	txnID, newBalance, err := h.service.Transfer(ctx, req.FromAccountId, req.ToAccountId, amount, req.Description)
+	txnID, newBalance, quote, err := h.service.Transfer(ctx, req.FromAccountId, req.ToAccountId, amount, req.Description)
 	if err != nil {
 		logger.Errorf("failed to transfer: %v", err)
 		return nil, h.mapError(err)
@@ -213,44 +198,1373 @@ func (h *GRPCHandler) Transfer(ctx context.Context, req *pb.TransferRequest) (*p
 		TransactionId: txnID,
 		NewBalance:    newBalance.String(),
 		Message:       "Transfer successful",
+		Fee:           toProtoFee(quote),
+	}, nil
+}
+
+func (h *GRPCHandler) transferToRecipient(ctx context.Context, req *pb.TransferRequest, amount decimal.Decimal) (*pb.TransferResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	recipient, txnID, newBalance, quote, err := h.service.TransferToRecipient(ctx, req.FromAccountId, req.Recipient, amount, req.Description)
+	if err != nil {
+		logger.Errorf("failed to transfer to recipient: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("transfer successful: from=%s, to=%s (%s), amount=%s, txn=%s", req.FromAccountId, recipient.AccountID, recipient.Type, req.Amount, txnID)
+	return &pb.TransferResponse{
+		Success:       true,
+		TransactionId: txnID,
+		NewBalance:    newBalance.String(),
+		Message:       "Transfer successful",
+		Fee:           toProtoFee(quote),
+		Recipient:     toProtoRecipient(recipient),
+	}, nil
+}
+
+func (h *GRPCHandler) LookupRecipient(ctx context.Context, req *pb.LookupRecipientRequest) (*pb.LookupRecipientResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	recipient, err := h.service.ResolveRecipient(ctx, req.Recipient)
+	if err != nil {
+		logger.Errorf("failed to look up recipient: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("looked up recipient: account=%s, type=%s", recipient.AccountID, recipient.Type)
+	return &pb.LookupRecipientResponse{
+		Recipient: toProtoRecipient(recipient),
+	}, nil
+}
+
+func (h *GRPCHandler) SetHandle(ctx context.Context, req *pb.SetHandleRequest) (*pb.SetHandleResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	account, err := h.service.SetHandle(ctx, req.AccountId, req.Handle)
+	if err != nil {
+		logger.Errorf("failed to set handle: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	balance, err := h.service.GetBalance(ctx, account.AccountID)
+	if err != nil {
+		logger.Warnf("failed to get balance for account: %v", err)
+		balance = decimal.Zero
+	}
+
+	logger.Infof("set handle of account: %s", account.AccountID)
+	return &pb.SetHandleResponse{
+		Account: toProtoAccount(account, balance),
+	}, nil
+}
+
+func (h *GRPCHandler) OpenWallet(ctx context.Context, req *pb.OpenWalletRequest) (*pb.OpenWalletResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	account, err := h.service.OpenWallet(ctx, req.UserId, req.Name, req.Currency)
+	if err != nil {
+		logger.Errorf("failed to open wallet: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("opened wallet: user=%s, account=%s", req.UserId, account.AccountID)
+	return &pb.OpenWalletResponse{
+		Account: toProtoAccount(account, decimal.Zero),
+	}, nil
+}
+
+func (h *GRPCHandler) ListAccountsForUser(ctx context.Context, req *pb.ListAccountsForUserRequest) (*pb.ListAccountsForUserResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	wallets, err := h.service.ListAccountsForUser(ctx, req.UserId)
+	if err != nil {
+		logger.Errorf("failed to list accounts for user: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	accounts := make([]*pb.Account, len(wallets))
+	for i, wallet := range wallets {
+		accounts[i] = toProtoAccount(wallet.Account, wallet.Balance)
+	}
+
+	logger.Infof("listed %d accounts for user: %s", len(accounts), req.UserId)
+	return &pb.ListAccountsForUserResponse{
+		Accounts: accounts,
+	}, nil
+}
+
+func (h *GRPCHandler) SetDefaultWallet(ctx context.Context, req *pb.SetDefaultWalletRequest) (*pb.SetDefaultWalletResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	account, err := h.service.SetDefaultWallet(ctx, req.UserId, req.AccountId)
+	if err != nil {
+		logger.Errorf("failed to set default wallet: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	balance, err := h.service.GetBalance(ctx, account.AccountID)
+	if err != nil {
+		logger.Warnf("failed to get balance for account: %v", err)
+		balance = decimal.Zero
+	}
+
+	logger.Infof("set default wallet: user=%s, account=%s", req.UserId, req.AccountId)
+	return &pb.SetDefaultWalletResponse{
+		Account: toProtoAccount(account, balance),
+	}, nil
+}
+
+func (h *GRPCHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	user, err := h.service.GetUser(ctx, req.UserId)
+	if err != nil {
+		logger.Errorf("failed to get user: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("retrieved user: %s", req.UserId)
+	return &pb.GetUserResponse{
+		User: toProtoUser(user),
+	}, nil
+}
+
+func (h *GRPCHandler) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	user, err := h.service.UpdateProfile(ctx, req.UserId, repository.ProfileUpdate{
+		Name:    req.Name,
+		Phone:   req.Phone,
+		Address: req.Address,
+		Locale:  req.Locale,
+	})
+	if err != nil {
+		logger.Errorf("failed to update profile: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("updated profile of user: %s", req.UserId)
+	return &pb.UpdateProfileResponse{
+		User: toProtoUser(user),
+	}, nil
+}
+
+func (h *GRPCHandler) RequestEmailChange(ctx context.Context, req *pb.RequestEmailChangeRequest) (*pb.RequestEmailChangeResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	expiresAt, err := h.service.RequestEmailChange(ctx, req.UserId, req.NewEmail)
+	if err != nil {
+		logger.Errorf("failed to request email change: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("email change requested for user: %s", req.UserId)
+	return &pb.RequestEmailChangeResponse{
+		ExpiresAt: expiresAt.Format("2006-01-02T15:04:05Z07:00"),
+	}, nil
+}
+
+func (h *GRPCHandler) ConfirmEmailChange(ctx context.Context, req *pb.ConfirmEmailChangeRequest) (*pb.ConfirmEmailChangeResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	user, err := h.service.ConfirmEmailChange(ctx, req.Token)
+	if err != nil {
+		logger.Errorf("failed to confirm email change: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("email change confirmed for user: %s", user.UserID)
+	return &pb.ConfirmEmailChangeResponse{
+		User: toProtoUser(user),
+	}, nil
+}
+
+func toProtoUser(user *repository.User) *pb.User {
+	return &pb.User{
+		UserId:    user.UserID,
+		Email:     user.Email,
+		Name:      user.Name,
+		Phone:     user.Phone,
+		Address:   user.Address,
+		Locale:    user.Locale,
+		CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func toProtoAccount(account *repository.Account, balance decimal.Decimal) *pb.Account {
+	return &pb.Account{
+		AccountId:         account.AccountID,
+		AccountType:       account.AccountType,
+		UserId:            account.UserID,
+		Email:             account.Email,
+		ReferrerAccountId: account.ReferrerAccountID,
+		Balance:           balance.String(),
+		CreatedAt:         account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Handle:            account.Handle,
+		Name:              account.Name,
+		Currency:          account.Currency,
+		IsDefault:         account.IsDefault,
+	}
+}
+
+func toProtoRecipient(recipient *service.Recipient) *pb.Recipient {
+	return &pb.Recipient{
+		Type:        recipient.Type,
+		DisplayName: recipient.DisplayName,
+		MaskedEmail: recipient.MaskedEmail,
+		Handle:      recipient.Handle,
+	}
+}
+
+func (h *GRPCHandler) QuoteFee(ctx context.Context, req *pb.QuoteFeeRequest) (*pb.QuoteFeeResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return nil, h.mapError(fmt.Errorf("%w: %v", accountErrors.ErrInvalidAmount, err))
+	}
+
+	quote, err := h.service.QuoteFee(ctx, req.AccountId, strings.ToUpper(req.Operation), amount)
+	if err != nil {
+		logger.Errorf("failed to quote fee: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("quoted fee: account=%s, operation=%s, amount=%s, fee=%s", req.AccountId, quote.Operation, req.Amount, quote.Fee.String())
+	return &pb.QuoteFeeResponse{
+		Fee: toProtoFee(quote),
 	}, nil
 }
 
+func toProtoFee(quote *fees.Quote) *pb.FeeBreakdown {
+	items := make([]*pb.FeeItem, len(quote.Items))
+	for i, item := range quote.Items {
+		items[i] = &pb.FeeItem{
+			Code:        item.Code,
+			Description: item.Description,
+			Amount:      item.Amount.String(),
+		}
+	}
+
+	return &pb.FeeBreakdown{
+		Operation:  quote.Operation,
+		Amount:     quote.Amount.String(),
+		Fee:        quote.Fee.String(),
+		TotalDebit: quote.TotalDebit().String(),
+		Currency:   quote.Currency,
+		Items:      items,
+	}
+}
+
 func (h *GRPCHandler) GetTransactionHistory(ctx context.Context, req *pb.GetTransactionHistoryRequest) (*pb.GetTransactionHistoryResponse, error) {
 	logger := h.loggerWithRequestID(ctx)
 
+	filter, err := historyFilterFromRequest(req)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
 	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
 	pageSize := int(req.PageSize)
-
-	transactions, totalCount, err := h.service.GetTransactionHistory(ctx, req.AccountId, page, pageSize)
-	if err != nil {
-		logger.Errorf("failed to get transaction history: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to get transaction history: %v", err)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
 	}
 
-	// Convert to proto transactions
-	protoTransactions := make([]*pb.Transaction, len(transactions))
-	for i, txn := range transactions {
-		protoTransactions[i] = &pb.Transaction{
-			Id:            txn.ID,
-			TransactionId: txn.TransactionID,
-			AccountId:     txn.AccountID,
-			Amount:        txn.Amount.String(),
-			Direction:     txn.Direction,
-			ReferenceId:   txn.ReferenceID,
-			Description:   txn.Description,
-			CreatedAt:     txn.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	if req.UseCursor || req.Cursor != "" {
+		transactions, nextCursor, err := h.service.ListTransactionHistory(ctx, req.AccountId, filter, req.Cursor, pageSize)
+		if err != nil {
+			logger.Errorf("failed to get transaction history: %v", err)
+			return nil, h.mapError(err)
 		}
+
+		logger.Infof("retrieved transaction history: account=%s, count=%d", req.AccountId, len(transactions))
+		return &pb.GetTransactionHistoryResponse{
+			Transactions: toProtoTransactions(transactions),
+			PageSize:     int32(pageSize),
+			NextCursor:   nextCursor,
+			HasMore:      nextCursor != "",
+		}, nil
+	}
+
+	transactions, totalCount, err := h.service.GetTransactionHistory(ctx, req.AccountId, filter, page, pageSize)
+	if err != nil {
+		logger.Errorf("failed to get transaction history: %v", err)
+		return nil, h.mapError(fmt.Errorf("failed to get transaction history: %w", err))
 	}
 
 	totalPages := int(math.Ceil(float64(totalCount) / float64(pageSize)))
 
 	logger.Infof("retrieved transaction history: account=%s, count=%d", req.AccountId, len(transactions))
 	return &pb.GetTransactionHistoryResponse{
-		Transactions: protoTransactions,
+		Transactions: toProtoTransactions(transactions),
 		TotalCount:   int32(totalCount),
 		Page:         int32(page),
 		PageSize:     int32(pageSize),
 		TotalPages:   int32(totalPages),
+		HasMore:      page < totalPages,
+	}, nil
+}
+
+func toProtoTransactions(transactions []service.Transaction) []*pb.Transaction {
+	protoTransactions := make([]*pb.Transaction, len(transactions))
+	for i, txn := range transactions {
+		protoTransactions[i] = &pb.Transaction{
+			Id:             txn.ID,
+			TransactionId:  txn.TransactionID,
+			AccountId:      txn.AccountID,
+			Amount:         txn.Amount.String(),
+			Direction:      txn.Direction,
+			ReferenceId:    txn.ReferenceID,
+			Description:    txn.Description,
+			CreatedAt:      txn.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			Counterparties: toProtoCounterparties(txn.Counterparties),
+		}
+	}
+	return protoTransactions
+}
+
+func toProtoCounterparties(counterparties []service.Counterparty) []*pb.Counterparty {
+	protoCounterparties := make([]*pb.Counterparty, len(counterparties))
+	for i, cp := range counterparties {
+		protoCounterparties[i] = &pb.Counterparty{
+			AccountId:   cp.AccountID,
+			AccountType: cp.AccountType,
+		}
+		if cp.Email != nil {
+			protoCounterparties[i].MaskedEmail = mask.Email(*cp.Email)
+		}
+	}
+	return protoCounterparties
+}
+
+func (h *GRPCHandler) GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.GetTransactionResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	if req.AccountId == "" {
+		return nil, h.mapError(accountErrors.ErrAccountIDRequired)
+	}
+
+	detail, err := h.service.GetTransaction(ctx, req.TransactionId, req.AccountId)
+	if err != nil {
+		logger.Errorf("failed to get transaction: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	legs := make([]*pb.TransactionLeg, len(detail.Legs))
+	for i, leg := range detail.Legs {
+		legs[i] = &pb.TransactionLeg{
+			Id:        leg.ID,
+			AccountId: leg.AccountID,
+			Amount:    leg.Amount.String(),
+			Direction: leg.Direction,
+		}
+	}
+
+	logger.Infof("retrieved transaction: %s", req.TransactionId)
+	return &pb.GetTransactionResponse{
+		Transaction: &pb.TransactionDetail{
+			TransactionId:            detail.TransactionID,
+			ReferenceId:              detail.ReferenceID,
+			Description:              detail.Description,
+			CreatedAt:                detail.CreatedAt,
+			Legs:                     legs,
+			Counterparties:           toProtoCounterparties(detail.Counterparties),
+			ReversesTransactionId:    detail.ReversesTransactionID,
+			ReversedByTransactionIds: detail.ReversedByTransactionIDs,
+		},
 	}, nil
 }
+
+func historyFilterFromRequest(req *pb.GetTransactionHistoryRequest) (service.HistoryFilter, error) {
+	filter := service.HistoryFilter{
+		ReferencePrefix: req.ReferencePrefix,
+		Search:          strings.TrimSpace(req.Search),
+	}
+
+	if req.From != "" {
+		from, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return filter, fmt.Errorf("%w: from: %v", accountErrors.ErrInvalidHistoryFilter, err)
+		}
+		filter.From = &from
+	}
+	if req.To != "" {
+		to, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return filter, fmt.Errorf("%w: to: %v", accountErrors.ErrInvalidHistoryFilter, err)
+		}
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, fmt.Errorf("%w: from must be before to", accountErrors.ErrInvalidHistoryFilter)
+	}
+
+	if req.Direction != "" {
+		direction := strings.ToUpper(req.Direction)
+		if direction != "DEBIT" && direction != "CREDIT" {
+			return filter, fmt.Errorf("%w: direction must be DEBIT or CREDIT", accountErrors.ErrInvalidHistoryFilter)
+		}
+		filter.Direction = direction
+	}
+
+	if req.MinAmount != "" {
+		minAmount, err := decimal.NewFromString(req.MinAmount)
+		if err != nil {
+			return filter, fmt.Errorf("%w: min_amount: %v", accountErrors.ErrInvalidHistoryFilter, err)
+		}
+		filter.MinAmount = &minAmount
+	}
+	if req.MaxAmount != "" {
+		maxAmount, err := decimal.NewFromString(req.MaxAmount)
+		if err != nil {
+			return filter, fmt.Errorf("%w: max_amount: %v", accountErrors.ErrInvalidHistoryFilter, err)
+		}
+		filter.MaxAmount = &maxAmount
+	}
+	if filter.MinAmount != nil && filter.MaxAmount != nil && filter.MinAmount.GreaterThan(*filter.MaxAmount) {
+		return filter, fmt.Errorf("%w: min_amount must not exceed max_amount", accountErrors.ErrInvalidHistoryFilter)
+	}
+
+	return filter, nil
+}
+
+const statementChunkSize = 32 * 1024
+
+func (h *GRPCHandler) GenerateStatement(req *pb.GenerateStatementRequest, stream pb.AccountsService_GenerateStatementServer) error {
+	ctx := stream.Context()
+	logger := h.loggerWithRequestID(ctx)
+
+	format := strings.ToLower(req.Format)
+	if format == "" {
+		format = statement.FormatPDF
+	}
+	if !statement.IsSupportedFormat(format) {
+		return h.mapError(fmt.Errorf("%w: %s", accountErrors.ErrUnsupportedStatementFormat, req.Format))
+	}
+
+	stmt, err := h.service.GenerateStatement(ctx, req.AccountId, req.Period)
+	if err != nil {
+		logger.Errorf("failed to generate statement: %v", err)
+		return h.mapError(err)
+	}
+
+	data, err := statement.Render(stmt, format)
+	if err != nil {
+		logger.Errorf("failed to render statement: %v", err)
+		return h.mapError(err)
+	}
+
+	first := &pb.StatementChunk{
+		ContentType: statement.ContentType(format),
+		Filename:    statement.Filename(stmt, format),
+	}
+	for offset := 0; offset < len(data) || first != nil; offset += statementChunkSize {
+		chunk := first
+		if chunk == nil {
+			chunk = &pb.StatementChunk{}
+		}
+		first = nil
+
+		end := offset + statementChunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		chunk.Data = data[offset:end]
+
+		if err := stream.Send(chunk); err != nil {
+			logger.Errorf("failed to send statement chunk: %v", err)
+			return err
+		}
+	}
+
+	logger.Infof("generated %s statement: account=%s, period=%s, entries=%d", format, req.AccountId, req.Period, len(stmt.Lines))
+	return nil
+}
+
+func (h *GRPCHandler) ListSystemAccounts(ctx context.Context, req *pb.ListSystemAccountsRequest) (*pb.ListSystemAccountsResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	accounts, err := h.service.ListSystemAccounts(ctx)
+	if err != nil {
+		logger.Errorf("failed to list system accounts: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	resp := &pb.ListSystemAccountsResponse{
+		SystemAccounts: make([]*pb.SystemAccount, len(accounts)),
+	}
+	for i, account := range accounts {
+		resp.SystemAccounts[i] = toProtoSystemAccount(account)
+	}
+
+	return resp, nil
+}
+
+func (h *GRPCHandler) SetSystemAccount(ctx context.Context, req *pb.SetSystemAccountRequest) (*pb.SetSystemAccountResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	if err := admin.Require(ctx, h.adminKey); err != nil {
+		logger.Warnf("refused to set system account: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	account, err := h.service.SetSystemAccount(ctx, req.Role, req.AccountId, req.Description)
+	if err != nil {
+		logger.Errorf("failed to set system account: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("set system account: role=%s, account=%s", account.Role, account.AccountID)
+	return &pb.SetSystemAccountResponse{
+		SystemAccount: toProtoSystemAccount(*account),
+	}, nil
+}
+
+func toProtoSystemAccount(account repository.SystemAccount) *pb.SystemAccount {
+	return &pb.SystemAccount{
+		Role:        account.Role,
+		AccountId:   account.AccountID,
+		Description: account.Description,
+		UpdatedAt:   account.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func (h *GRPCHandler) RunInterestAccrual(ctx context.Context, req *pb.RunInterestAccrualRequest) (*pb.RunInterestAccrualResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	date, err := time.Parse(time.DateOnly, req.Date)
+	if err != nil {
+		return nil, h.mapError(fmt.Errorf("%w: %s", accountErrors.ErrInvalidAccrualDate, req.Date))
+	}
+
+	result, err := h.service.AccrueInterest(ctx, date)
+	if err != nil {
+		logger.Errorf("failed to accrue interest: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("accrued interest: date=%s, accounts=%d, posted=%s, already_run=%t", req.Date, result.AccountsCount, result.PostedAmount.String(), result.AlreadyRun)
+	return &pb.RunInterestAccrualResponse{
+		Date:          result.Date.Format(time.DateOnly),
+		AccountsCount: int32(result.AccountsCount),
+		TotalAccrued:  result.TotalAccrued.String(),
+		PostedAmount:  result.PostedAmount.String(),
+		TransactionId: result.TransactionID,
+		AlreadyRun:    result.AlreadyRun,
+	}, nil
+}
+
+func (h *GRPCHandler) RunInterestPayout(ctx context.Context, req *pb.RunInterestPayoutRequest) (*pb.RunInterestPayoutResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	result, err := h.service.PayOutInterest(ctx, req.Period)
+	if err != nil {
+		logger.Errorf("failed to pay out interest: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("paid out interest: period=%s, accounts=%d, paid=%s, already_run=%t", req.Period, result.AccountsCount, result.PaidAmount.String(), result.AlreadyRun)
+	return &pb.RunInterestPayoutResponse{
+		Period:        result.Period,
+		AccountsCount: int32(result.AccountsCount),
+		AccruedAmount: result.AccruedAmount.String(),
+		PaidAmount:    result.PaidAmount.String(),
+		TransactionId: result.TransactionID,
+		AlreadyRun:    result.AlreadyRun,
+	}, nil
+}
+
+func (h *GRPCHandler) CreateScheduledTransfer(ctx context.Context, req *pb.CreateScheduledTransferRequest) (*pb.CreateScheduledTransferResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return nil, h.mapError(fmt.Errorf("%w: %v", accountErrors.ErrInvalidAmount, err))
+	}
+
+	schedReq := service.ScheduledTransferRequest{
+		FromAccountID:           req.FromAccountId,
+		ToAccountID:             req.ToAccountId,
+		Amount:                  amount,
+		Description:             req.Description,
+		ScheduleKind:            req.ScheduleKind,
+		Schedule:                req.Schedule,
+		InsufficientFundsPolicy: req.OnInsufficientFunds,
+		RetryInterval:           time.Duration(req.RetryIntervalSeconds) * time.Second,
+	}
+	if req.StartAt != "" {
+		if schedReq.StartAt, err = time.Parse(time.RFC3339, req.StartAt); err != nil {
+			return nil, h.mapError(fmt.Errorf("%w: start_at must be RFC 3339", accountErrors.ErrInvalidSchedule))
+		}
+	}
+	if req.EndAt != "" {
+		endAt, err := time.Parse(time.RFC3339, req.EndAt)
+		if err != nil {
+			return nil, h.mapError(fmt.Errorf("%w: end_at must be RFC 3339", accountErrors.ErrInvalidSchedule))
+		}
+		endAt = endAt.UTC()
+		schedReq.EndAt = &endAt
+	}
+	if req.MaxOccurrences != 0 {
+		maxOccurrences := int(req.MaxOccurrences)
+		schedReq.MaxOccurrences = &maxOccurrences
+	}
+	if req.MaxRetries != nil {
+		maxRetries := int(*req.MaxRetries)
+		schedReq.MaxRetries = &maxRetries
+	}
+
+	st, err := h.service.CreateScheduledTransfer(ctx, schedReq)
+	if err != nil {
+		logger.Errorf("failed to create scheduled transfer: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("scheduled transfer created: id=%s, from=%s, to=%s, amount=%s", st.ID, st.FromAccountID, st.ToAccountID, req.Amount)
+	return &pb.CreateScheduledTransferResponse{
+		ScheduledTransfer: toProtoScheduledTransfer(st),
+	}, nil
+}
+
+func (h *GRPCHandler) ListScheduledTransfers(ctx context.Context, req *pb.ListScheduledTransfersRequest) (*pb.ListScheduledTransfersResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	transfers, err := h.service.ListScheduledTransfers(ctx, req.AccountId, req.Status)
+	if err != nil {
+		logger.Errorf("failed to list scheduled transfers: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	pbTransfers := make([]*pb.ScheduledTransfer, len(transfers))
+	for i := range transfers {
+		pbTransfers[i] = toProtoScheduledTransfer(&transfers[i])
+	}
+
+	return &pb.ListScheduledTransfersResponse{
+		ScheduledTransfers: pbTransfers,
+	}, nil
+}
+
+func (h *GRPCHandler) PauseScheduledTransfer(ctx context.Context, req *pb.ScheduledTransferActionRequest) (*pb.ScheduledTransferActionResponse, error) {
+	return h.scheduledTransferAction(ctx, "pause", req, h.service.PauseScheduledTransfer)
+}
+
+func (h *GRPCHandler) ResumeScheduledTransfer(ctx context.Context, req *pb.ScheduledTransferActionRequest) (*pb.ScheduledTransferActionResponse, error) {
+	return h.scheduledTransferAction(ctx, "resume", req, h.service.ResumeScheduledTransfer)
+}
+
+func (h *GRPCHandler) CancelScheduledTransfer(ctx context.Context, req *pb.ScheduledTransferActionRequest) (*pb.ScheduledTransferActionResponse, error) {
+	return h.scheduledTransferAction(ctx, "cancel", req, h.service.CancelScheduledTransfer)
+}
+
+func (h *GRPCHandler) scheduledTransferAction(
+	ctx context.Context,
+	action string,
+	req *pb.ScheduledTransferActionRequest,
+	fn func(ctx context.Context, accountID, id string) (*repository.ScheduledTransfer, error),
+) (*pb.ScheduledTransferActionResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	st, err := fn(ctx, req.AccountId, req.ScheduledTransferId)
+	if err != nil {
+		logger.Errorf("failed to %s scheduled transfer: %v", action, err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("scheduled transfer %s: id=%s, status=%s", action, st.ID, st.Status)
+	return &pb.ScheduledTransferActionResponse{
+		ScheduledTransfer: toProtoScheduledTransfer(st),
+	}, nil
+}
+
+func toProtoScheduledTransfer(st *repository.ScheduledTransfer) *pb.ScheduledTransfer {
+	pbTransfer := &pb.ScheduledTransfer{
+		Id:                   st.ID,
+		FromAccountId:        st.FromAccountID,
+		ToAccountId:          st.ToAccountID,
+		Amount:               st.Amount.String(),
+		Description:          st.Description,
+		ScheduleKind:         st.ScheduleKind,
+		Schedule:             st.ScheduleExpr,
+		StartAt:              st.StartAt.Format("2006-01-02T15:04:05Z07:00"),
+		OnInsufficientFunds:  st.InsufficientFundsPolicy,
+		MaxRetries:           int32(st.MaxRetries),
+		RetryIntervalSeconds: int32(st.RetryIntervalSeconds),
+		Status:               st.Status,
+		Occurrences:          int32(st.Occurrences),
+		Attempts:             int32(st.Attempts),
+		CreatedAt:            st.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if st.EndAt != nil {
+		pbTransfer.EndAt = st.EndAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if st.MaxOccurrences != nil {
+		pbTransfer.MaxOccurrences = int32(*st.MaxOccurrences)
+	}
+	if st.NextRunAt != nil {
+		pbTransfer.NextRunAt = st.NextRunAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if st.LastError != nil {
+		pbTransfer.LastError = *st.LastError
+	}
+	return pbTransfer
+}
+
+func (h *GRPCHandler) CreatePaymentRequest(ctx context.Context, req *pb.CreatePaymentRequestRequest) (*pb.CreatePaymentRequestResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return nil, h.mapError(fmt.Errorf("%w: %v", accountErrors.ErrInvalidAmount, err))
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != "" {
+		if expiresAt, err = time.Parse(time.RFC3339, req.ExpiresAt); err != nil {
+			return nil, h.mapError(fmt.Errorf("%w: expires_at must be RFC 3339", accountErrors.ErrInvalidPaymentRequestExpiry))
+		}
+	}
+
+	pr, err := h.service.CreatePaymentRequest(ctx, req.RequesterAccountId, req.PayerAccountId, amount, req.Memo, expiresAt)
+	if err != nil {
+		logger.Errorf("failed to create payment request: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("payment request created: id=%s, requester=%s, payer=%s, amount=%s", pr.ID, pr.RequesterAccountID, pr.PayerAccountID, req.Amount)
+	return &pb.CreatePaymentRequestResponse{
+		PaymentRequest: toProtoPaymentRequest(pr),
+	}, nil
+}
+
+func (h *GRPCHandler) AcceptPaymentRequest(ctx context.Context, req *pb.PaymentRequestActionRequest) (*pb.AcceptPaymentRequestResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	accepted, err := h.service.AcceptPaymentRequest(ctx, req.AccountId, req.PaymentRequestId)
+	if err != nil {
+		logger.Errorf("failed to accept payment request: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("payment request accepted: id=%s, payer=%s", req.PaymentRequestId, req.AccountId)
+	resp := &pb.AcceptPaymentRequestResponse{
+		PaymentRequest: toProtoPaymentRequest(accepted.Request),
+		NewBalance:     accepted.NewBalance.String(),
+	}
+	if accepted.Fee != nil {
+		resp.Fee = toProtoFee(accepted.Fee)
+	}
+	return resp, nil
+}
+
+func (h *GRPCHandler) DeclinePaymentRequest(ctx context.Context, req *pb.PaymentRequestActionRequest) (*pb.PaymentRequestActionResponse, error) {
+	return h.paymentRequestAction(ctx, "decline", req, h.service.DeclinePaymentRequest)
+}
+
+func (h *GRPCHandler) CancelPaymentRequest(ctx context.Context, req *pb.PaymentRequestActionRequest) (*pb.PaymentRequestActionResponse, error) {
+	return h.paymentRequestAction(ctx, "cancel", req, h.service.CancelPaymentRequest)
+}
+
+func (h *GRPCHandler) paymentRequestAction(
+	ctx context.Context,
+	action string,
+	req *pb.PaymentRequestActionRequest,
+	fn func(ctx context.Context, accountID, id string) (*repository.PaymentRequest, error),
+) (*pb.PaymentRequestActionResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	pr, err := fn(ctx, req.AccountId, req.PaymentRequestId)
+	if err != nil {
+		logger.Errorf("failed to %s payment request: %v", action, err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("payment request %s: id=%s, account=%s", action, pr.ID, req.AccountId)
+	return &pb.PaymentRequestActionResponse{
+		PaymentRequest: toProtoPaymentRequest(pr),
+	}, nil
+}
+
+func (h *GRPCHandler) ListPaymentRequests(ctx context.Context, req *pb.ListPaymentRequestsRequest) (*pb.ListPaymentRequestsResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	requests, total, err := h.service.ListPaymentRequests(ctx, req.AccountId, req.Direction, req.Status, page, pageSize)
+	if err != nil {
+		logger.Errorf("failed to list payment requests: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	pbRequests := make([]*pb.PaymentRequest, len(requests))
+	for i := range requests {
+		pbRequests[i] = toProtoPaymentRequest(&requests[i])
+	}
+
+	return &pb.ListPaymentRequestsResponse{
+		PaymentRequests: pbRequests,
+		TotalCount:      int32(total),
+		Page:            int32(page),
+		PageSize:        int32(pageSize),
+	}, nil
+}
+
+func toProtoPaymentRequest(pr *repository.PaymentRequest) *pb.PaymentRequest {
+	pbRequest := &pb.PaymentRequest{
+		Id:                 pr.ID,
+		RequesterAccountId: pr.RequesterAccountID,
+		PayerAccountId:     pr.PayerAccountID,
+		Amount:             pr.Amount.String(),
+		Memo:               pr.Memo,
+		Status:             pr.Status,
+		ExpiresAt:          pr.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt:          pr.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if pr.TransactionID != nil {
+		pbRequest.TransactionId = *pr.TransactionID
+	}
+	if pr.RespondedAt != nil {
+		pbRequest.RespondedAt = pr.RespondedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return pbRequest
+}
+
+func (h *GRPCHandler) GetNotificationPreferences(ctx context.Context, req *pb.GetNotificationPreferencesRequest) (*pb.NotificationPreferencesResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	prefs, err := h.service.GetNotificationPreferences(ctx, req.UserId)
+	if err != nil {
+		logger.Errorf("failed to get notification preferences: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	return &pb.NotificationPreferencesResponse{
+		Preferences: toProtoNotificationPreferences(prefs),
+	}, nil
+}
+
+func (h *GRPCHandler) SetNotificationPreferences(ctx context.Context, req *pb.SetNotificationPreferencesRequest) (*pb.NotificationPreferencesResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	prefs := &repository.NotificationPreferences{
+		UserID:    req.UserId,
+		Channels:  req.Channels,
+		PushToken: req.PushToken,
+	}
+	if req.LowBalanceThreshold != nil {
+		threshold, err := decimal.NewFromString(*req.LowBalanceThreshold)
+		if err != nil {
+			return nil, h.mapError(fmt.Errorf("%w: %v", accountErrors.ErrInvalidLowBalanceThreshold, err))
+		}
+		prefs.LowBalanceThreshold = &threshold
+	}
+
+	prefs, err := h.service.SetNotificationPreferences(ctx, prefs)
+	if err != nil {
+		logger.Errorf("failed to set notification preferences: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("set notification preferences of user: %s", req.UserId)
+	return &pb.NotificationPreferencesResponse{
+		Preferences: toProtoNotificationPreferences(prefs),
+	}, nil
+}
+
+func (h *GRPCHandler) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	notifications, total, err := h.service.ListNotifications(ctx, req.UserId, page, pageSize)
+	if err != nil {
+		logger.Errorf("failed to list notifications: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	pbNotifications := make([]*pb.Notification, len(notifications))
+	for i, n := range notifications {
+		pbNotifications[i] = &pb.Notification{
+			Id:            n.ID,
+			AccountId:     n.AccountID,
+			Event:         n.Event,
+			Channel:       n.Channel,
+			TransactionId: n.TransactionID,
+			Subject:       n.Subject,
+			Body:          n.Body,
+			Status:        n.Status,
+			Attempts:      int32(n.Attempts),
+			CreatedAt:     n.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if n.SentAt != nil {
+			sentAt := n.SentAt.Format("2006-01-02T15:04:05Z07:00")
+			pbNotifications[i].SentAt = &sentAt
+		}
+	}
+
+	return &pb.ListNotificationsResponse{
+		Notifications: pbNotifications,
+		TotalCount:    int32(total),
+		Page:          int32(page),
+		PageSize:      int32(pageSize),
+	}, nil
+}
+
+func toProtoNotificationPreferences(prefs *repository.NotificationPreferences) *pb.NotificationPreferences {
+	pbPrefs := &pb.NotificationPreferences{
+		UserId:    prefs.UserID,
+		Channels:  prefs.Channels,
+		PushToken: prefs.PushToken,
+	}
+	if prefs.LowBalanceThreshold != nil {
+		threshold := prefs.LowBalanceThreshold.String()
+		pbPrefs.LowBalanceThreshold = &threshold
+	}
+	if !prefs.UpdatedAt.IsZero() {
+		pbPrefs.UpdatedAt = prefs.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return pbPrefs
+}
+
+func (h *GRPCHandler) RegisterWebhook(ctx context.Context, req *pb.RegisterWebhookRequest) (*pb.RegisterWebhookResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	ep, err := h.service.RegisterWebhook(ctx, req.ApiKeyId, req.Url, req.Events, req.Description)
+	if err != nil {
+		logger.Errorf("failed to register webhook: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("registered webhook: %s", ep.ID)
+	return &pb.RegisterWebhookResponse{
+		Webhook: toProtoWebhook(ep),
+		Secret:  ep.Secret,
+	}, nil
+}
+
+func (h *GRPCHandler) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	endpoints, err := h.service.ListWebhooks(ctx, req.ApiKeyId)
+	if err != nil {
+		logger.Errorf("failed to list webhooks: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	pbWebhooks := make([]*pb.Webhook, len(endpoints))
+	for i := range endpoints {
+		pbWebhooks[i] = toProtoWebhook(&endpoints[i])
+	}
+
+	return &pb.ListWebhooksResponse{Webhooks: pbWebhooks}, nil
+}
+
+func (h *GRPCHandler) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	if err := h.service.DeleteWebhook(ctx, req.ApiKeyId, req.WebhookId); err != nil {
+		logger.Errorf("failed to delete webhook: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("deleted webhook: %s", req.WebhookId)
+	return &pb.DeleteWebhookResponse{}, nil
+}
+
+func (h *GRPCHandler) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	filter := repository.WebhookDeliveryFilter{
+		EndpointID: req.WebhookId,
+		Status:     req.Status,
+	}
+	deliveries, total, err := h.service.ListWebhookDeliveries(ctx, req.ApiKeyId, filter, page, pageSize)
+	if err != nil {
+		logger.Errorf("failed to list webhook deliveries: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	pbDeliveries := make([]*pb.WebhookDelivery, len(deliveries))
+	for i := range deliveries {
+		pbDeliveries[i] = toProtoWebhookDelivery(&deliveries[i])
+	}
+
+	return &pb.ListWebhookDeliveriesResponse{
+		Deliveries: pbDeliveries,
+		TotalCount: int32(total),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	}, nil
+}
+
+func (h *GRPCHandler) GetWebhookDelivery(ctx context.Context, req *pb.GetWebhookDeliveryRequest) (*pb.GetWebhookDeliveryResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	delivery, attempts, err := h.service.GetWebhookDelivery(ctx, req.ApiKeyId, req.DeliveryId)
+	if err != nil {
+		logger.Errorf("failed to get webhook delivery: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	pbAttempts := make([]*pb.WebhookAttempt, len(attempts))
+	for i, a := range attempts {
+		pbAttempts[i] = &pb.WebhookAttempt{
+			Attempt:     int32(a.Attempt),
+			Error:       a.Error,
+			DurationMs:  a.Duration.Milliseconds(),
+			AttemptedAt: a.AttemptedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if a.StatusCode != nil {
+			code := int32(*a.StatusCode)
+			pbAttempts[i].StatusCode = &code
+		}
+	}
+
+	return &pb.GetWebhookDeliveryResponse{
+		Delivery: toProtoWebhookDelivery(delivery),
+		Attempts: pbAttempts,
+	}, nil
+}
+
+func (h *GRPCHandler) ReplayWebhookDelivery(ctx context.Context, req *pb.ReplayWebhookDeliveryRequest) (*pb.ReplayWebhookDeliveryResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	delivery, err := h.service.ReplayWebhookDelivery(ctx, req.ApiKeyId, req.DeliveryId)
+	if err != nil {
+		logger.Errorf("failed to replay webhook delivery: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("replaying webhook delivery %s as %s", req.DeliveryId, delivery.ID)
+	return &pb.ReplayWebhookDeliveryResponse{
+		Delivery: toProtoWebhookDelivery(delivery),
+	}, nil
+}
+
+func toProtoWebhook(ep *repository.WebhookEndpoint) *pb.Webhook {
+	return &pb.Webhook{
+		Id:          ep.ID,
+		Url:         ep.URL,
+		Events:      ep.Events,
+		Description: ep.Description,
+		CreatedAt:   ep.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func toProtoWebhookDelivery(d *repository.WebhookDelivery) *pb.WebhookDelivery {
+	pbDelivery := &pb.WebhookDelivery{
+		Id:            d.ID,
+		WebhookId:     d.EndpointID,
+		EventId:       d.EventID,
+		Event:         d.Event,
+		Payload:       string(d.Payload),
+		Status:        d.Status,
+		Attempts:      int32(d.Attempts),
+		NextAttemptAt: d.NextAttemptAt.Format("2006-01-02T15:04:05Z07:00"),
+		LastError:     d.LastError,
+		ReplayOf:      d.ReplayOf,
+		CreatedAt:     d.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if d.LastStatusCode != nil {
+		code := int32(*d.LastStatusCode)
+		pbDelivery.LastStatusCode = &code
+	}
+	if d.DeliveredAt != nil {
+		deliveredAt := d.DeliveredAt.Format("2006-01-02T15:04:05Z07:00")
+		pbDelivery.DeliveredAt = &deliveredAt
+	}
+	return pbDelivery
+}
+
+func (h *GRPCHandler) ListAuditLog(ctx context.Context, req *pb.ListAuditLogRequest) (*pb.ListAuditLogResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	if err := admin.Require(ctx, h.adminKey); err != nil {
+		logger.Warnf("refused to list audit log: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	filter, err := audit.ParseFilter(req.Action, req.ActorId, req.Target, req.From, req.To)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	entries, total, err := h.auditLog.List(ctx, filter, page, pageSize)
+	if err != nil {
+		logger.Errorf("failed to list audit log: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	pbEntries := make([]*pb.AuditEntry, len(entries))
+	for i, e := range entries {
+		pbEntries[i] = &pb.AuditEntry{
+			Id:            e.ID,
+			OccurredAt:    e.OccurredAt.Format("2006-01-02T15:04:05Z07:00"),
+			Service:       e.Service,
+			Action:        e.Action,
+			ActorType:     e.ActorType,
+			ActorId:       e.ActorID,
+			ClaimedUserId: e.ClaimedUserID,
+			Target:        e.Target,
+			Request:       string(e.Request),
+			Before:        string(e.Before),
+			After:         string(e.After),
+			Status:        e.Status,
+			Error:         e.Error,
+			RequestId:     e.RequestID,
+			SourceIp:      e.SourceIP,
+			ClaimedIp:     e.ClaimedIP,
+		}
+	}
+
+	return &pb.ListAuditLogResponse{
+		Entries:    pbEntries,
+		TotalCount: int32(total),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	}, nil
+}
+
+func (h *GRPCHandler) FundDisbursementPool(ctx context.Context, req *pb.FundDisbursementPoolRequest) (*pb.FundDisbursementPoolResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	if err := admin.Require(ctx, h.adminKey); err != nil {
+		logger.Warnf("refused to fund disbursement pool: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return nil, h.mapError(fmt.Errorf("%w: %v", accountErrors.ErrInvalidAmount, err))
+	}
+
+	txnID, accountID, balance, err := h.service.FundDisbursementPool(ctx, amount, req.Description)
+	if err != nil {
+		logger.Errorf("failed to fund disbursement pool: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("disbursement pool funded: account=%s, amount=%s, txn=%s", accountID, req.Amount, txnID)
+	return &pb.FundDisbursementPoolResponse{
+		TransactionId: txnID,
+		AccountId:     accountID,
+		Balance:       balance.String(),
+	}, nil
+}
+
+func (h *GRPCHandler) CreatePayoutBatch(ctx context.Context, req *pb.CreatePayoutBatchRequest) (*pb.CreatePayoutBatchResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	batch, err := h.service.CreatePayoutBatch(ctx, req.Filename, req.Content, req.UserId)
+	if err != nil {
+		logger.Errorf("failed to create payout batch: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	return &pb.CreatePayoutBatchResponse{Batch: toProtoPayoutBatch(batch)}, nil
+}
+
+func (h *GRPCHandler) GetPayoutBatch(ctx context.Context, req *pb.GetPayoutBatchRequest) (*pb.GetPayoutBatchResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	batch, err := h.service.GetPayoutBatch(ctx, req.PayoutId)
+	if err != nil {
+		logger.Errorf("failed to get payout batch: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	return &pb.GetPayoutBatchResponse{Batch: toProtoPayoutBatch(batch)}, nil
+}
+
+func (h *GRPCHandler) ListPayoutBatches(ctx context.Context, req *pb.ListPayoutBatchesRequest) (*pb.ListPayoutBatchesResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	batches, total, err := h.service.ListPayoutBatches(ctx, req.Status, page, pageSize)
+	if err != nil {
+		logger.Errorf("failed to list payout batches: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	pbBatches := make([]*pb.PayoutBatch, len(batches))
+	for i := range batches {
+		pbBatches[i] = toProtoPayoutBatch(&batches[i])
+	}
+
+	return &pb.ListPayoutBatchesResponse{
+		Batches:    pbBatches,
+		TotalCount: int32(total),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	}, nil
+}
+
+func (h *GRPCHandler) ListPayoutRows(ctx context.Context, req *pb.ListPayoutRowsRequest) (*pb.ListPayoutRowsResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	rows, total, err := h.service.ListPayoutRows(ctx, req.PayoutId, req.Status, page, pageSize)
+	if err != nil {
+		logger.Errorf("failed to list payout rows: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	pbRows := make([]*pb.PayoutRow, len(rows))
+	for i := range rows {
+		pbRows[i] = toProtoPayoutRow(&rows[i])
+	}
+
+	return &pb.ListPayoutRowsResponse{
+		Rows:       pbRows,
+		TotalCount: int32(total),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	}, nil
+}
+
+func (h *GRPCHandler) ApprovePayoutBatch(ctx context.Context, req *pb.PayoutBatchActionRequest) (*pb.PayoutBatchActionResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	if err := admin.Require(ctx, h.adminKey); err != nil {
+		logger.Warnf("refused to approve payout batch: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	batch, err := h.service.ApprovePayoutBatch(ctx, req.PayoutId, req.UserId)
+	if err != nil {
+		logger.Errorf("failed to approve payout batch: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	return &pb.PayoutBatchActionResponse{Batch: toProtoPayoutBatch(batch)}, nil
+}
+
+func (h *GRPCHandler) CancelPayoutBatch(ctx context.Context, req *pb.PayoutBatchActionRequest) (*pb.PayoutBatchActionResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	batch, err := h.service.CancelPayoutBatch(ctx, req.PayoutId)
+	if err != nil {
+		logger.Errorf("failed to cancel payout batch: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	return &pb.PayoutBatchActionResponse{Batch: toProtoPayoutBatch(batch)}, nil
+}
+
+func (h *GRPCHandler) ExportPayoutResults(ctx context.Context, req *pb.ExportPayoutResultsRequest) (*pb.ExportPayoutResultsResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	data, filename, err := h.service.ExportPayoutResults(ctx, req.PayoutId)
+	if err != nil {
+		logger.Errorf("failed to export payout results: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	return &pb.ExportPayoutResultsResponse{
+		ContentType: "text/csv",
+		Filename:    filename,
+		Data:        data,
+	}, nil
+}
+
+func toProtoPayoutBatch(b *repository.PayoutBatch) *pb.PayoutBatch {
+	pbBatch := &pb.PayoutBatch{
+		Id:              b.ID,
+		Filename:        b.Filename,
+		Status:          b.Status,
+		SourceAccountId: b.SourceAccountID,
+		TotalRows:       int32(b.TotalRows),
+		ValidRows:       int32(b.ValidRows),
+		InvalidRows:     int32(b.TotalRows - b.ValidRows),
+		TotalAmount:     b.TotalAmount.StringFixed(2),
+		PaidRows:        int32(b.PaidRows),
+		FailedRows:      int32(b.FailedRows),
+		PaidAmount:      b.PaidAmount.StringFixed(2),
+		CreatedBy:       b.CreatedBy,
+		CreatedAt:       b.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if b.ApprovedBy != nil {
+		pbBatch.ApprovedBy = *b.ApprovedBy
+	}
+	if b.ApprovedAt != nil {
+		pbBatch.ApprovedAt = b.ApprovedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if b.CompletedAt != nil {
+		pbBatch.CompletedAt = b.CompletedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return pbBatch
+}
+
+func toProtoPayoutRow(r *repository.PayoutRow) *pb.PayoutRow {
+	pbRow := &pb.PayoutRow{
+		Line:        int32(r.Line),
+		Recipient:   r.Recipient,
+		Description: r.Description,
+		Status:      r.Status,
+	}
+	if r.AccountID != nil {
+		pbRow.AccountId = *r.AccountID
+	}
+	if r.Amount != nil {
+		pbRow.Amount = r.Amount.StringFixed(2)
+	}
+	if r.TransactionID != nil {
+		pbRow.TransactionId = *r.TransactionID
+	}
+	if r.Error != nil {
+		pbRow.Error = *r.Error
+	}
+	return pbRow
+}
@@ -0,0 +1,74 @@
+// Package interest computes daily interest accruals.
+package interest
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Day count conventions: the number of days the annual rate is spread over.
+const (
+	DayCountActual365 = "ACT/365"
+	DayCountActual360 = "ACT/360"
+	DayCountActualAct = "ACT/ACT"
+)
+
+const (
+	RoundHalfUp   = "HALF_UP"
+	RoundHalfEven = "HALF_EVEN"
+	RoundDown     = "DOWN"
+)
+
+// PayoutScale is the number of decimal places interest is paid out at.
+const PayoutScale = 2
+
+// Rate is the interest schedule of one account tier. AnnualRate is in
+// percent, so 2.5 means 2.5% a year.
+type Rate struct {
+	Tier         string
+	AnnualRate   decimal.Decimal
+	DayCount     string
+	RoundingMode string
+	AccrualScale int32
+}
+
+// Daily returns the interest earned by an end-of-day balance on date,
+// rounded to the rate's accrual scale. Zero and negative balances earn
+// nothing.
+func (r Rate) Daily(balance decimal.Decimal, date time.Time) decimal.Decimal {
+	if !balance.IsPositive() || !r.AnnualRate.IsPositive() {
+		return decimal.Zero
+	}
+
+	yearly := balance.Mul(r.AnnualRate).Div(decimal.NewFromInt(100))
+	daily := yearly.Div(decimal.NewFromInt(int64(DaysInYear(r.DayCount, date))))
+
+	return Round(daily, r.RoundingMode, r.AccrualScale)
+}
+
+// DaysInYear is the day-count denominator for date under convention.
+func DaysInYear(convention string, date time.Time) int {
+	switch convention {
+	case DayCountActual360:
+		return 360
+	case DayCountActualAct:
+		year := date.Year()
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 366
+		}
+		return 365
+	}
+	return 365
+}
+
+// Round rounds amount to places decimal places using mode.
+func Round(amount decimal.Decimal, mode string, places int32) decimal.Decimal {
+	switch mode {
+	case RoundHalfUp:
+		return amount.Round(places)
+	case RoundDown:
+		return amount.Truncate(places)
+	}
+	return amount.RoundBank(places)
+}
@@ -0,0 +1,108 @@
+package interest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/interest"
+
+	"github.com/shopspring/decimal"
+)
+
+func dec(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestRateDaily(t *testing.T) {
+	tests := []struct {
+		name     string
+		rate     string
+		dayCount string
+		rounding string
+		scale    int32
+		balance  string
+		date     time.Time
+		want     string
+	}{
+		{"act/365", "3.65", interest.DayCountActual365, interest.RoundHalfEven, 6, "1000", date(2026, 3, 1), "0.1"},
+		{"act/365 in a leap year", "3.65", interest.DayCountActual365, interest.RoundHalfEven, 6, "1000", date(2024, 3, 1), "0.1"},
+		{"act/360", "3.6", interest.DayCountActual360, interest.RoundHalfEven, 6, "1000", date(2026, 3, 1), "0.1"},
+		{"act/act in a leap year", "3.66", interest.DayCountActualAct, interest.RoundHalfEven, 6, "1000", date(2024, 3, 1), "0.1"},
+		{"act/act in a common year", "3.65", interest.DayCountActualAct, interest.RoundHalfEven, 6, "1000", date(2026, 3, 1), "0.1"},
+		{"unknown day count is act/365", "3.65", "30/360", interest.RoundHalfEven, 6, "1000", date(2026, 3, 1), "0.1"},
+		{"half up", "1", interest.DayCountActual365, interest.RoundHalfUp, 4, "1000", date(2026, 3, 1), "0.0274"},
+		{"half even", "1", interest.DayCountActual365, interest.RoundHalfEven, 4, "1000", date(2026, 3, 1), "0.0274"},
+		{"down", "1", interest.DayCountActual365, interest.RoundDown, 4, "1000", date(2026, 3, 1), "0.0273"},
+		{"rounds to zero", "1", interest.DayCountActual365, interest.RoundDown, 2, "1", date(2026, 3, 1), "0"},
+		{"zero balance", "3.65", interest.DayCountActual365, interest.RoundHalfEven, 6, "0", date(2026, 3, 1), "0"},
+		{"negative balance", "3.65", interest.DayCountActual365, interest.RoundHalfEven, 6, "-1000", date(2026, 3, 1), "0"},
+		{"zero rate", "0", interest.DayCountActual365, interest.RoundHalfEven, 6, "1000", date(2026, 3, 1), "0"},
+		{"negative rate", "-1", interest.DayCountActual365, interest.RoundHalfEven, 6, "1000", date(2026, 3, 1), "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate := interest.Rate{
+				AnnualRate:   dec(tt.rate),
+				DayCount:     tt.dayCount,
+				RoundingMode: tt.rounding,
+				AccrualScale: tt.scale,
+			}
+			if got := rate.Daily(dec(tt.balance), tt.date); !got.Equal(dec(tt.want)) {
+				t.Errorf("Daily = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaysInYear(t *testing.T) {
+	tests := []struct {
+		convention string
+		year       int
+		want       int
+	}{
+		{interest.DayCountActual365, 2024, 365},
+		{interest.DayCountActual360, 2024, 360},
+		{interest.DayCountActualAct, 2024, 366},
+		{interest.DayCountActualAct, 2026, 365},
+		{interest.DayCountActualAct, 1900, 365},
+		{interest.DayCountActualAct, 2000, 366},
+	}
+
+	for _, tt := range tests {
+		if got := interest.DaysInYear(tt.convention, date(tt.year, 6, 1)); got != tt.want {
+			t.Errorf("DaysInYear(%s, %d) = %d, want %d", tt.convention, tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		amount string
+		mode   string
+		places int32
+		want   string
+	}{
+		{"2.345", interest.RoundHalfUp, 2, "2.35"},
+		{"2.345", interest.RoundHalfEven, 2, "2.34"},
+		{"2.355", interest.RoundHalfEven, 2, "2.36"},
+		{"2.345", interest.RoundDown, 2, "2.34"},
+		{"2.3449", interest.RoundHalfUp, 2, "2.34"},
+		{"2.349", interest.RoundDown, 2, "2.34"},
+		{"-2.345", interest.RoundHalfUp, 2, "-2.35"},
+		{"-2.349", interest.RoundDown, 2, "-2.34"},
+		{"0.125", "", 2, "0.12"},
+		{"0.12345678", interest.RoundHalfUp, 6, "0.123457"},
+		{"7", interest.RoundHalfUp, 2, "7"},
+	}
+
+	for _, tt := range tests {
+		if got := interest.Round(dec(tt.amount), tt.mode, tt.places); !got.Equal(dec(tt.want)) {
+			t.Errorf("Round(%s, %q, %d) = %s, want %s", tt.amount, tt.mode, tt.places, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS accounts (
+    account_id VARCHAR(50) PRIMARY KEY,
+    account_type VARCHAR(20) NOT NULL CHECK (account_type IN ('SYSTEM', 'USER')),
+    user_id VARCHAR(36),
+    email VARCHAR(255) UNIQUE,
+    referrer_account_id VARCHAR(50),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_accounts_user_id ON accounts(user_id);
+CREATE INDEX IF NOT EXISTS idx_accounts_email ON accounts(email);
+CREATE INDEX IF NOT EXISTS idx_accounts_referrer ON accounts(referrer_account_id);
+
+INSERT INTO accounts (account_id, account_type, created_at)
+VALUES ('1001', 'SYSTEM', NOW()),
+       ('1002', 'SYSTEM', NOW()),
+       ('1003', 'SYSTEM', NOW()),
+       ('1004', 'SYSTEM', NOW())
+ON CONFLICT (account_id) DO NOTHING;
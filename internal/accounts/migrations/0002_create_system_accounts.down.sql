@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS system_accounts;
+
+DELETE FROM accounts WHERE account_id IN ('1005', '1006') AND account_type = 'SYSTEM';
@@ -0,0 +1,22 @@
+-- Which account plays each system role. The service looks roles up here at
+-- runtime instead of hard-coding account ids.
+CREATE TABLE IF NOT EXISTS system_accounts (
+    role VARCHAR(50) PRIMARY KEY,
+    account_id VARCHAR(50) NOT NULL UNIQUE REFERENCES accounts(account_id),
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO accounts (account_id, account_type, created_at)
+VALUES ('1005', 'SYSTEM', NOW()),
+       ('1006', 'SYSTEM', NOW())
+ON CONFLICT (account_id) DO NOTHING;
+
+INSERT INTO system_accounts (role, account_id, description)
+VALUES ('REFERRAL_POOL', '1001', 'Referral Funding Pool'),
+       ('INSTITUTION_MAIN', '1002', 'Institution Main Account'),
+       ('DISBURSEMENT', '1003', 'Institution Disbursement Account'),
+       ('PSP', '1004', 'PSP Account'),
+       ('FEE_INCOME', '1005', 'Fee Income'),
+       ('FX_CLEARING', '1006', 'FX Clearing')
+ON CONFLICT (role) DO NOTHING;
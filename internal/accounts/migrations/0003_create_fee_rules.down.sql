@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS fee_rules;
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS tier;
@@ -0,0 +1,34 @@
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS tier VARCHAR(20) NOT NULL DEFAULT 'STANDARD';
+
+-- Fee schedule. A rule with a NULL tier applies to every tier that has no
+-- rule of its own. percentage is in percent (0.5 = 0.5%). bands, when not
+-- empty, is a tiered schedule: [{"up_to": "100", "flat": "0", "percentage": "0"}, ...]
+-- in ascending up_to order, the last band having no up_to.
+CREATE TABLE IF NOT EXISTS fee_rules (
+    id VARCHAR(50) PRIMARY KEY,
+    operation VARCHAR(20) NOT NULL CHECK (operation IN ('WITHDRAW', 'TRANSFER')),
+    tier VARCHAR(20),
+    flat_amount NUMERIC(20, 2) NOT NULL DEFAULT 0 CHECK (flat_amount >= 0),
+    percentage NUMERIC(9, 4) NOT NULL DEFAULT 0 CHECK (percentage >= 0),
+    bands JSONB NOT NULL DEFAULT '[]',
+    min_fee NUMERIC(20, 2) CHECK (min_fee >= 0),
+    max_fee NUMERIC(20, 2) CHECK (max_fee >= 0),
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_fee_rules_operation_tier
+    ON fee_rules(operation, COALESCE(tier, '')) WHERE active;
+
+-- Example schedule, shipped disabled so that enabling fees is an explicit
+-- decision per environment.
+INSERT INTO fee_rules (id, operation, tier, flat_amount, percentage, bands, min_fee, max_fee, description, active)
+VALUES ('withdraw-standard', 'WITHDRAW', NULL, 1.00, 0.5, '[]', 1.00, 10.00, 'Withdrawal fee', FALSE),
+       ('transfer-standard', 'TRANSFER', NULL, 0, 0,
+        '[{"up_to": "100", "flat": "0", "percentage": "0"},
+          {"up_to": "1000", "flat": "0", "percentage": "0.25"},
+          {"flat": "0", "percentage": "0.1"}]',
+        NULL, 5.00, 'Transfer fee', FALSE),
+       ('transfer-premium', 'TRANSFER', 'PREMIUM', 0, 0, '[]', NULL, NULL, 'Free transfers for premium accounts', FALSE)
+ON CONFLICT (id) DO NOTHING;
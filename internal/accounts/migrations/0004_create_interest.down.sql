@@ -0,0 +1,8 @@
+DELETE FROM system_accounts WHERE role IN ('INTEREST_EXPENSE', 'INTEREST_PAYABLE');
+DELETE FROM accounts WHERE account_id IN ('1007', '1008') AND account_type = 'SYSTEM';
+
+DROP TABLE IF EXISTS interest_payout_lines;
+DROP TABLE IF EXISTS interest_payouts;
+DROP TABLE IF EXISTS interest_accruals;
+DROP TABLE IF EXISTS interest_accrual_runs;
+DROP TABLE IF EXISTS interest_rates;
@@ -0,0 +1,72 @@
+-- Interest rate schedule by account tier. annual_rate is in percent.
+-- day_count is ACT/365, ACT/360 or ACT/ACT; rounding_mode is HALF_UP,
+-- HALF_EVEN or DOWN and applies both to the daily accrual (at accrual_scale
+-- decimal places) and to the monthly payout (at 2 decimal places).
+CREATE TABLE IF NOT EXISTS interest_rates (
+    tier VARCHAR(20) PRIMARY KEY,
+    annual_rate NUMERIC(9, 6) NOT NULL CHECK (annual_rate >= 0),
+    day_count VARCHAR(10) NOT NULL DEFAULT 'ACT/365' CHECK (day_count IN ('ACT/365', 'ACT/360', 'ACT/ACT')),
+    rounding_mode VARCHAR(10) NOT NULL DEFAULT 'HALF_EVEN' CHECK (rounding_mode IN ('HALF_UP', 'HALF_EVEN', 'DOWN')),
+    accrual_scale INT NOT NULL DEFAULT 6 CHECK (accrual_scale BETWEEN 2 AND 10),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- One row per accrual date. status goes PENDING -> POSTED once the ledger
+-- posting (reference interest-accrual-<date>) exists.
+CREATE TABLE IF NOT EXISTS interest_accrual_runs (
+    accrual_date DATE PRIMARY KEY,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('PENDING', 'POSTED')),
+    accounts_count INT NOT NULL,
+    total_accrued NUMERIC(24, 10) NOT NULL,
+    posted_amount NUMERIC(20, 2) NOT NULL,
+    transaction_id VARCHAR(36),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    posted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS interest_accruals (
+    account_id VARCHAR(50) NOT NULL REFERENCES accounts(account_id),
+    accrual_date DATE NOT NULL REFERENCES interest_accrual_runs(accrual_date),
+    balance NUMERIC(20, 2) NOT NULL,
+    annual_rate NUMERIC(9, 6) NOT NULL,
+    day_count VARCHAR(10) NOT NULL,
+    amount NUMERIC(24, 10) NOT NULL,
+    payout_period VARCHAR(7),
+    PRIMARY KEY (account_id, accrual_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_interest_accruals_date ON interest_accruals(accrual_date);
+CREATE INDEX IF NOT EXISTS idx_interest_accruals_payout ON interest_accruals(payout_period);
+
+-- One row per payout month (YYYY-MM), with the amount paid to each account.
+CREATE TABLE IF NOT EXISTS interest_payouts (
+    period VARCHAR(7) PRIMARY KEY,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('PENDING', 'POSTED')),
+    accrued_amount NUMERIC(20, 2) NOT NULL,
+    paid_amount NUMERIC(20, 2) NOT NULL,
+    transaction_id VARCHAR(36),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    posted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS interest_payout_lines (
+    period VARCHAR(7) NOT NULL REFERENCES interest_payouts(period),
+    account_id VARCHAR(50) NOT NULL REFERENCES accounts(account_id),
+    amount NUMERIC(20, 2) NOT NULL,
+    PRIMARY KEY (period, account_id)
+);
+
+INSERT INTO interest_rates (tier, annual_rate, day_count, rounding_mode, accrual_scale)
+VALUES ('SAVINGS', 2.5, 'ACT/365', 'HALF_EVEN', 6)
+ON CONFLICT (tier) DO NOTHING;
+
+INSERT INTO accounts (account_id, account_type, created_at)
+VALUES ('1007', 'SYSTEM', NOW()),
+       ('1008', 'SYSTEM', NOW())
+ON CONFLICT (account_id) DO NOTHING;
+
+INSERT INTO system_accounts (role, account_id, description)
+VALUES ('INTEREST_EXPENSE', '1007', 'Interest Expense'),
+       ('INTEREST_PAYABLE', '1008', 'Interest Payable')
+ON CONFLICT (role) DO NOTHING;
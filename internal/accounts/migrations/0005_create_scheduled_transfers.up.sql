@@ -0,0 +1,46 @@
+-- Scheduled and recurring transfers. schedule_kind is ONCE (a single run at
+-- start_at), CRON (five-field expression) or RRULE (RFC 5545 subset), all
+-- evaluated in UTC. due_at is the occurrence being worked on; next_run_at
+-- is when the worker should next attempt it, which is later than due_at
+-- while an insufficient-funds retry is pending.
+CREATE TABLE IF NOT EXISTS scheduled_transfers (
+    id VARCHAR(36) PRIMARY KEY,
+    from_account_id VARCHAR(50) NOT NULL REFERENCES accounts(account_id),
+    to_account_id VARCHAR(50) NOT NULL REFERENCES accounts(account_id),
+    amount NUMERIC(20, 2) NOT NULL CHECK (amount > 0),
+    description TEXT NOT NULL DEFAULT '',
+    schedule_kind VARCHAR(10) NOT NULL CHECK (schedule_kind IN ('ONCE', 'CRON', 'RRULE')),
+    schedule_expr TEXT NOT NULL DEFAULT '',
+    start_at TIMESTAMP NOT NULL,
+    end_at TIMESTAMP,
+    max_occurrences INT CHECK (max_occurrences > 0),
+    insufficient_funds_policy VARCHAR(10) NOT NULL DEFAULT 'RETRY' CHECK (insufficient_funds_policy IN ('RETRY', 'SKIP')),
+    max_retries INT NOT NULL DEFAULT 3 CHECK (max_retries >= 0),
+    retry_interval_seconds INT NOT NULL DEFAULT 3600 CHECK (retry_interval_seconds > 0),
+    status VARCHAR(10) NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'PAUSED', 'CANCELLED', 'COMPLETED')),
+    occurrences INT NOT NULL DEFAULT 0,
+    due_at TIMESTAMP,
+    next_run_at TIMESTAMP,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    locked_until TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfers_from_account ON scheduled_transfers(from_account_id);
+CREATE INDEX IF NOT EXISTS idx_scheduled_transfers_due ON scheduled_transfers(next_run_at) WHERE status = 'ACTIVE';
+
+-- One row per settled occurrence. The ledger reference of occurrence n is
+-- scheduled-<id>-<n>, so a retried occurrence is never posted twice.
+CREATE TABLE IF NOT EXISTS scheduled_transfer_runs (
+    schedule_id VARCHAR(36) NOT NULL REFERENCES scheduled_transfers(id),
+    occurrence INT NOT NULL,
+    scheduled_for TIMESTAMP NOT NULL,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('SUCCEEDED', 'SKIPPED', 'FAILED')),
+    transaction_id VARCHAR(36),
+    attempts INT NOT NULL,
+    error TEXT,
+    executed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (schedule_id, occurrence)
+);
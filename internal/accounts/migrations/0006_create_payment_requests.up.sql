@@ -0,0 +1,23 @@
+-- Money requested by one account from another. A PENDING request past
+-- expires_at is reported as EXPIRED; that status is derived and never
+-- stored. Accepting posts a transfer with reference payment-request-<id>.
+-- Accepting claims a request until accepting_until instead of holding its
+-- row lock across the transfer; declines and cancels leave a claimed
+-- request alone until the claim runs out.
+CREATE TABLE IF NOT EXISTS payment_requests (
+    id VARCHAR(36) PRIMARY KEY,
+    requester_account_id VARCHAR(50) NOT NULL REFERENCES accounts(account_id),
+    payer_account_id VARCHAR(50) NOT NULL REFERENCES accounts(account_id),
+    amount NUMERIC(20, 2) NOT NULL CHECK (amount > 0),
+    memo TEXT NOT NULL DEFAULT '',
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'ACCEPTED', 'DECLINED', 'CANCELLED')),
+    expires_at TIMESTAMP NOT NULL,
+    transaction_id VARCHAR(36),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    responded_at TIMESTAMP,
+    accepting_until TIMESTAMP,
+    CHECK (requester_account_id <> payer_account_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_requests_requester ON payment_requests(requester_account_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_payment_requests_payer ON payment_requests(payer_account_id, created_at DESC);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_accounts_email_lower;
+DROP INDEX IF EXISTS idx_accounts_handle;
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS handle;
@@ -0,0 +1,6 @@
+-- A handle is a user-chosen alias, stored lowercase without the leading @,
+-- that others can send money to instead of the account id.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS handle VARCHAR(30);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_handle ON accounts(handle);
+CREATE INDEX IF NOT EXISTS idx_accounts_email_lower ON accounts(LOWER(email));
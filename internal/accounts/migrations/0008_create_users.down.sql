@@ -0,0 +1,21 @@
+-- Only default wallets get their email back; a user's other wallets keep
+-- their user_id but no longer share it with anything.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS email VARCHAR(255) UNIQUE;
+
+UPDATE accounts a
+SET email = u.email
+FROM users u
+WHERE a.user_id = u.user_id AND a.is_default;
+
+CREATE INDEX IF NOT EXISTS idx_accounts_email ON accounts(email);
+CREATE INDEX IF NOT EXISTS idx_accounts_email_lower ON accounts(LOWER(email));
+
+DROP INDEX IF EXISTS idx_accounts_user_name;
+DROP INDEX IF EXISTS idx_accounts_user_default;
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS fk_accounts_user;
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS is_default;
+ALTER TABLE accounts DROP COLUMN IF EXISTS currency;
+ALTER TABLE accounts DROP COLUMN IF EXISTS name;
+
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,35 @@
+-- A user owns the email and profile; each of their wallets is a USER
+-- account with user_id pointing here. Exactly one wallet per user is the
+-- default, which is where money sent to the user's email lands.
+CREATE TABLE IF NOT EXISTS users (
+    user_id VARCHAR(36) PRIMARY KEY,
+    email VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_users_email_lower ON users(LOWER(email));
+
+-- Until now every wallet was its own user, with user_id = account_id.
+INSERT INTO users (user_id, email, created_at)
+SELECT user_id, email, created_at
+FROM accounts
+WHERE account_type = 'USER' AND user_id IS NOT NULL AND email IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS name VARCHAR(50) NOT NULL DEFAULT 'Main';
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS is_default BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE accounts SET is_default = TRUE WHERE account_type = 'USER';
+
+-- NOT VALID: USER accounts created without an email have no users row.
+ALTER TABLE accounts ADD CONSTRAINT fk_accounts_user
+    FOREIGN KEY (user_id) REFERENCES users(user_id) NOT VALID;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_user_default ON accounts(user_id) WHERE is_default;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_user_name ON accounts(user_id, LOWER(name));
+
+DROP INDEX IF EXISTS idx_accounts_email_lower;
+DROP INDEX IF EXISTS idx_accounts_email;
+ALTER TABLE accounts DROP COLUMN IF EXISTS email;
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS user_profile_changes;
+DROP TABLE IF EXISTS email_change_requests;
+
+ALTER TABLE users DROP COLUMN IF EXISTS locale;
+ALTER TABLE users DROP COLUMN IF EXISTS address;
+ALTER TABLE users DROP COLUMN IF EXISTS phone;
+ALTER TABLE users DROP COLUMN IF EXISTS name;
@@ -0,0 +1,30 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS name VARCHAR(100);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS phone VARCHAR(16);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS address TEXT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS locale VARCHAR(16);
+
+-- A pending change of a user's email. Only the SHA-256 of the token sent to
+-- the new address is stored; confirming it moves the email.
+CREATE TABLE IF NOT EXISTS email_change_requests (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL REFERENCES users(user_id),
+    new_email VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    confirmed_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_change_requests_user ON email_change_requests(user_id, created_at DESC);
+
+-- Audit trail of profile and email changes, one row per changed field.
+CREATE TABLE IF NOT EXISTS user_profile_changes (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL REFERENCES users(user_id),
+    field VARCHAR(20) NOT NULL,
+    old_value TEXT,
+    new_value TEXT,
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_profile_changes_user ON user_profile_changes(user_id, changed_at DESC);
@@ -0,0 +1,35 @@
+-- Which channels a user is notified on, and below which balance they want
+-- a low balance alert. Users without a row get email only and no alert.
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id VARCHAR(36) PRIMARY KEY REFERENCES users(user_id),
+    channels TEXT[] NOT NULL DEFAULT '{EMAIL}',
+    push_token TEXT,
+    low_balance_threshold NUMERIC(20, 2),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Outbox of rendered notifications, delivered and retried by a background
+-- worker. A posting notifies each account and channel at most once per
+-- event.
+CREATE TABLE IF NOT EXISTS notifications (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL REFERENCES users(user_id),
+    account_id VARCHAR(50) NOT NULL REFERENCES accounts(account_id),
+    event VARCHAR(20) NOT NULL,
+    channel VARCHAR(10) NOT NULL CHECK (channel IN ('EMAIL', 'SMS', 'PUSH')),
+    recipient TEXT NOT NULL,
+    transaction_id VARCHAR(36) NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'SENT', 'FAILED')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    locked_until TIMESTAMP,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notifications_dedup ON notifications(transaction_id, account_id, event, channel);
+CREATE INDEX IF NOT EXISTS idx_notifications_due ON notifications(next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_notifications_user ON notifications(user_id, created_at DESC);
@@ -0,0 +1,54 @@
+-- Partner callback URLs. Each endpoint belongs to the API key that
+-- registered it, identified by api_key_id rather than the key itself, and
+-- receives the events it subscribed to signed with its secret.
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id VARCHAR(36) PRIMARY KEY,
+    api_key_id VARCHAR(64) NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    events TEXT[] NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_api_key ON webhook_endpoints(api_key_id) WHERE deleted_at IS NULL;
+
+-- One delivery of an event to an endpoint, retried by a background worker
+-- until it succeeds or is given up on as DEAD. A replay is a new delivery
+-- pointing at the one it replays.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id VARCHAR(36) PRIMARY KEY,
+    endpoint_id VARCHAR(36) NOT NULL REFERENCES webhook_endpoints(id),
+    event_id VARCHAR(36) NOT NULL,
+    event VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'DELIVERED', 'DEAD')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    locked_until TIMESTAMP,
+    last_status_code INT,
+    last_error TEXT,
+    replay_of VARCHAR(36) REFERENCES webhook_deliveries(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_deliveries_dedup ON webhook_deliveries(endpoint_id, event_id) WHERE replay_of IS NULL;
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_endpoint ON webhook_deliveries(endpoint_id, created_at DESC);
+
+-- Every attempt to deliver, with the status the endpoint answered. The
+-- response body is not kept: an endpoint could otherwise read back through
+-- the delivery log whatever it got the worker to fetch.
+CREATE TABLE IF NOT EXISTS webhook_delivery_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    delivery_id VARCHAR(36) NOT NULL REFERENCES webhook_deliveries(id),
+    attempt INT NOT NULL,
+    status_code INT,
+    error TEXT,
+    duration_ms INT NOT NULL,
+    attempted_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_attempts_delivery ON webhook_delivery_attempts(delivery_id, attempt);
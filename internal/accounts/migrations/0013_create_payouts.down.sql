@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS payout_rows;
+DROP TABLE IF EXISTS payout_batches;
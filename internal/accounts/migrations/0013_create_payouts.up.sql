@@ -0,0 +1,40 @@
+-- Bulk payouts from the DISBURSEMENT account, uploaded as a CSV. A batch
+-- waits for approval after validation, then a background worker pays its
+-- rows in chunks; locked_until is the worker's lease on it.
+CREATE TABLE IF NOT EXISTS payout_batches (
+    id VARCHAR(36) PRIMARY KEY,
+    filename TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING_APPROVAL' CHECK (status IN ('PENDING_APPROVAL', 'APPROVED', 'COMPLETED', 'CANCELLED')),
+    source_account_id VARCHAR(50) NOT NULL REFERENCES accounts(account_id),
+    total_rows INT NOT NULL,
+    valid_rows INT NOT NULL,
+    total_amount NUMERIC(20, 2) NOT NULL,
+    created_by VARCHAR(64) NOT NULL DEFAULT '',
+    approved_by VARCHAR(64),
+    locked_until TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    approved_at TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_payout_batches_approved ON payout_batches(approved_at) WHERE status = 'APPROVED';
+
+-- One row per CSV line. INVALID rows are never paid. A row is SUBMITTED
+-- while its chunk is being posted under reference payout-<batch>-<line>; a
+-- worker that finds it SUBMITTED after a crash looks the reference up
+-- before posting again.
+CREATE TABLE IF NOT EXISTS payout_rows (
+    batch_id VARCHAR(36) NOT NULL REFERENCES payout_batches(id),
+    line INT NOT NULL,
+    recipient TEXT NOT NULL,
+    account_id VARCHAR(50) REFERENCES accounts(account_id),
+    amount NUMERIC(20, 2),
+    description TEXT NOT NULL DEFAULT '',
+    status VARCHAR(10) NOT NULL CHECK (status IN ('VALID', 'INVALID', 'SUBMITTED', 'PAID', 'FAILED')),
+    transaction_id VARCHAR(36),
+    error TEXT,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (batch_id, line)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payout_rows_status ON payout_rows(batch_id, status, line);
@@ -0,0 +1,87 @@
+// Package notifications renders the messages users get about money moving
+// in and out of their wallets.
+package notifications
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+const (
+	EventDeposit        = "DEPOSIT"
+	EventWithdrawal     = "WITHDRAWAL"
+	EventTransferIn     = "TRANSFER_IN"
+	EventTransferOut    = "TRANSFER_OUT"
+	EventReferralReward = "REFERRAL_REWARD"
+	EventLowBalance     = "LOW_BALANCE"
+)
+
+// Data is what templates can refer to. Amounts are already formatted.
+type Data struct {
+	WalletName    string
+	Amount        string
+	Fee           string
+	Balance       string
+	Counterparty  string
+	Threshold     string
+	Currency      string
+	TransactionID string
+}
+
+type messageTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+func parse(event, subject, body string) messageTemplate {
+	return messageTemplate{
+		subject: template.Must(template.New(event + "/subject").Parse(subject)),
+		body:    template.Must(template.New(event + "/body").Parse(body)),
+	}
+}
+
+var templates = map[string]messageTemplate{
+	EventDeposit: parse(EventDeposit,
+		`{{.Amount}} {{.Currency}} deposited`,
+		`{{.Amount}} {{.Currency}} was deposited to your {{.WalletName}} wallet. Your balance is {{.Balance}} {{.Currency}}. Reference: {{.TransactionID}}.`),
+	EventWithdrawal: parse(EventWithdrawal,
+		`{{.Amount}} {{.Currency}} withdrawn`,
+		`{{.Amount}} {{.Currency}} was withdrawn from your {{.WalletName}} wallet{{if .Fee}} with a fee of {{.Fee}} {{.Currency}}{{end}}. Your balance is {{.Balance}} {{.Currency}}. Reference: {{.TransactionID}}.`),
+	EventTransferIn: parse(EventTransferIn,
+		`You received {{.Amount}} {{.Currency}}`,
+		`You received {{.Amount}} {{.Currency}} from {{.Counterparty}} in your {{.WalletName}} wallet. Your balance is {{.Balance}} {{.Currency}}. Reference: {{.TransactionID}}.`),
+	EventTransferOut: parse(EventTransferOut,
+		`You sent {{.Amount}} {{.Currency}}`,
+		`You sent {{.Amount}} {{.Currency}} to {{.Counterparty}} from your {{.WalletName}} wallet{{if .Fee}} with a fee of {{.Fee}} {{.Currency}}{{end}}. Your balance is {{.Balance}} {{.Currency}}. Reference: {{.TransactionID}}.`),
+	EventReferralReward: parse(EventReferralReward,
+		`You earned a {{.Amount}} {{.Currency}} referral reward`,
+		`Thanks for referring a friend! {{.Amount}} {{.Currency}} was added to your {{.WalletName}} wallet. Your balance is {{.Balance}} {{.Currency}}.`),
+	EventLowBalance: parse(EventLowBalance,
+		`Your {{.WalletName}} wallet is running low`,
+		`The balance of your {{.WalletName}} wallet fell to {{.Balance}} {{.Currency}}, below your alert of {{.Threshold}} {{.Currency}}.`),
+}
+
+// IsEvent reports whether event is one of the Event constants.
+func IsEvent(event string) bool {
+	_, ok := templates[event]
+	return ok
+}
+
+// Render returns the subject and body of the message for event.
+func Render(event string, data Data) (string, string, error) {
+	t, ok := templates[event]
+	if !ok {
+		return "", "", fmt.Errorf("no template for event %s", event)
+	}
+
+	var subject, body strings.Builder
+	if err := t.subject.Execute(&subject, data); err != nil {
+		return "", "", fmt.Errorf("failed to render %s subject: %w", event, err)
+	}
+	if err := t.body.Execute(&body, data); err != nil {
+		return "", "", fmt.Errorf("failed to render %s body: %w", event, err)
+	}
+
+	return subject.String(), body.String(), nil
+}
@@ -0,0 +1,61 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HTTPNotifier posts messages as JSON to a provider gateway, which is how
+// SMS and push providers are reached:
+//
+//	{"to": "...", "subject": "...", "body": "..."}
+//
+// Any 2xx response counts as delivered.
+type HTTPNotifier struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func NewHTTPNotifier(url, token string) *HTTPNotifier {
+	return &HTTPNotifier{
+		url:    url,
+		token:  token,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (n *HTTPNotifier) Notify(ctx context.Context, msg Message) error {
+	payload, err := json.Marshal(map[string]string{
+		"to":      msg.To,
+		"subject": msg.Subject,
+		"body":    msg.Body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.token != "" {
+		req.Header.Set("Authorization", "Bearer "+n.token)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach %s: %w", n.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s responded %s", n.url, resp.Status)
+	}
+	return nil
+}
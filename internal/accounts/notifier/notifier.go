@@ -0,0 +1,150 @@
+// Package notifier delivers messages such as verification tokens to users.
+// Each channel (email, SMS, push) is a Notifier; the log, file and memory
+// implementations here stand in for real providers in local runs and tests.
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	KindLog  = "log"
+	KindFile = "file"
+)
+
+const (
+	ChannelEmail = "EMAIL"
+	ChannelSMS   = "SMS"
+	ChannelPush  = "PUSH"
+)
+
+// IsChannel reports whether channel is one of the Channel constants.
+func IsChannel(channel string) bool {
+	return channel == ChannelEmail || channel == ChannelSMS || channel == ChannelPush
+}
+
+// Message is a notification to a single recipient.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
+
+// Channels maps each channel to the notifier that delivers it.
+type Channels map[string]Notifier
+
+// Notify sends msg on channel.
+func (c Channels) Notify(ctx context.Context, channel string, msg Message) error {
+	n, ok := c[channel]
+	if !ok {
+		return fmt.Errorf("no notifier for channel %s", channel)
+	}
+	return n.Notify(ctx, msg)
+}
+
+// New returns the notifier of the given kind. path is only used by the
+// file notifier.
+func New(kind, path string, logger *logrus.Logger) (Notifier, error) {
+	switch kind {
+	case KindLog, "":
+		return NewLogNotifier(logger), nil
+	case KindFile:
+		if path == "" {
+			return nil, fmt.Errorf("file notifier needs a path")
+		}
+		return NewFileNotifier(path), nil
+	}
+	return nil, fmt.Errorf("unknown notifier %q", kind)
+}
+
+// LogNotifier writes messages to the service log.
+type LogNotifier struct {
+	logger *logrus.Entry
+}
+
+func NewLogNotifier(logger *logrus.Logger) *LogNotifier {
+	return &LogNotifier{
+		logger: logger.WithFields(logrus.Fields{
+			"package": "accounts/notifier",
+		}),
+	}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, msg Message) error {
+	n.logger.WithFields(logrus.Fields{
+		"to":      msg.To,
+		"subject": msg.Subject,
+	}).Info(msg.Body)
+	return nil
+}
+
+// FileNotifier appends messages to a file as JSON lines.
+type FileNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+func (n *FileNotifier) Notify(ctx context.Context, msg Message) error {
+	line, err := json.Marshal(struct {
+		Time    time.Time `json:"time"`
+		To      string    `json:"to"`
+		Subject string    `json:"subject"`
+		Body    string    `json:"body"`
+	}{time.Now().UTC(), msg.To, msg.Subject, msg.Body})
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", n.path, err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write notification: %w", err)
+	}
+	return nil
+}
+
+// MemoryNotifier keeps messages in memory, for tests.
+type MemoryNotifier struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemoryNotifier() *MemoryNotifier {
+	return &MemoryNotifier{}
+}
+
+func (n *MemoryNotifier) Notify(ctx context.Context, msg Message) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.messages = append(n.messages, msg)
+	return nil
+}
+
+// Messages returns a copy of the messages sent so far.
+func (n *MemoryNotifier) Messages() []Message {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]Message(nil), n.messages...)
+}
@@ -0,0 +1,350 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/interest"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	RunStatusPending = "PENDING"
+	RunStatusPosted  = "POSTED"
+)
+
+type InterestAccrual struct {
+	AccountID  string
+	Date       time.Time
+	Balance    decimal.Decimal
+	AnnualRate decimal.Decimal
+	DayCount   string
+	Amount     decimal.Decimal
+}
+
+type InterestAccrualRun struct {
+	Date          time.Time
+	Status        string
+	AccountsCount int
+	TotalAccrued  decimal.Decimal
+	PostedAmount  decimal.Decimal
+	TransactionID *string
+}
+
+type InterestPayoutLine struct {
+	AccountID string
+	Amount    decimal.Decimal
+}
+
+type InterestPayout struct {
+	Period        string
+	Status        string
+	AccruedAmount decimal.Decimal
+	PaidAmount    decimal.Decimal
+	TransactionID *string
+	Lines         []InterestPayoutLine
+}
+
+func (r *Repository) ListInterestRates(ctx context.Context) ([]interest.Rate, error) {
+	query := `
+		SELECT tier, annual_rate, day_count, rounding_mode, accrual_scale
+		FROM interest_rates
+		WHERE active
+		ORDER BY tier
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list interest rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []interest.Rate
+	for rows.Next() {
+		var rate interest.Rate
+		if err := rows.Scan(&rate.Tier, &rate.AnnualRate, &rate.DayCount, &rate.RoundingMode, &rate.AccrualScale); err != nil {
+			return nil, fmt.Errorf("failed to scan interest rate: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read interest rates: %w", err)
+	}
+
+	return rates, nil
+}
+
+// ListUserAccountsByTier returns the ids of USER accounts in any of tiers
+// that existed before the given time.
+func (r *Repository) ListUserAccountsByTier(ctx context.Context, tiers []string, createdBefore time.Time) (map[string][]string, error) {
+	query := `
+		SELECT tier, account_id
+		FROM accounts
+		WHERE account_type = 'USER' AND tier = ANY($1) AND created_at < $2
+		ORDER BY account_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, tiers, createdBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts by tier: %w", err)
+	}
+	defer rows.Close()
+
+	byTier := make(map[string][]string)
+	for rows.Next() {
+		var tier, accountID string
+		if err := rows.Scan(&tier, &accountID); err != nil {
+			return nil, fmt.Errorf("failed to scan account: %w", err)
+		}
+		byTier[tier] = append(byTier[tier], accountID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read accounts: %w", err)
+	}
+
+	return byTier, nil
+}
+
+// GetInterestAccrualRun returns nil without an error if date has not been
+// accrued yet.
+func (r *Repository) GetInterestAccrualRun(ctx context.Context, date time.Time) (*InterestAccrualRun, error) {
+	query := `
+		SELECT accrual_date, status, accounts_count, total_accrued, posted_amount, transaction_id
+		FROM interest_accrual_runs
+		WHERE accrual_date = $1
+	`
+
+	var run InterestAccrualRun
+	err := r.pool.QueryRow(ctx, query, date).Scan(
+		&run.Date,
+		&run.Status,
+		&run.AccountsCount,
+		&run.TotalAccrued,
+		&run.PostedAmount,
+		&run.TransactionID,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get interest accrual run %s: %w", date.Format(time.DateOnly), err)
+	}
+
+	return &run, nil
+}
+
+// CreateInterestAccrualRun records a PENDING run and its accruals
+// atomically.
+func (r *Repository) CreateInterestAccrualRun(ctx context.Context, run *InterestAccrualRun, accruals []InterestAccrual) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO interest_accrual_runs (accrual_date, status, accounts_count, total_accrued, posted_amount, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+	`, run.Date, RunStatusPending, run.AccountsCount, run.TotalAccrued, run.PostedAmount)
+	if err != nil {
+		return fmt.Errorf("failed to create interest accrual run: %w", err)
+	}
+
+	rows := make([][]interface{}, len(accruals))
+	for i, a := range accruals {
+		rows[i] = []interface{}{a.AccountID, a.Date, a.Balance, a.AnnualRate, a.DayCount, a.Amount}
+	}
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"interest_accruals"},
+		[]string{"account_id", "accrual_date", "balance", "annual_rate", "day_count", "amount"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert interest accruals: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit interest accrual run: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) MarkInterestAccrualRunPosted(ctx context.Context, date time.Time, transactionID string) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE interest_accrual_runs
+		SET status = $2, transaction_id = NULLIF($3, ''), posted_at = NOW()
+		WHERE accrual_date = $1
+	`, date, RunStatusPosted, transactionID)
+	if err != nil {
+		return fmt.Errorf("failed to mark interest accrual run %s posted: %w", date.Format(time.DateOnly), err)
+	}
+	return nil
+}
+
+// CountPendingInterestAccrualRuns counts runs in [from, to) whose ledger
+// posting has not been confirmed.
+func (r *Repository) CountPendingInterestAccrualRuns(ctx context.Context, from, to time.Time) (int, error) {
+	var count int
+	err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM interest_accrual_runs
+		WHERE accrual_date >= $1 AND accrual_date < $2 AND status = $3
+	`, from, to, RunStatusPending).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending interest accrual runs: %w", err)
+	}
+	return count, nil
+}
+
+// SumUnpaidInterest returns, for accruals in [from, to) not yet assigned to
+// a payout, the unrounded total per account and the total posted to the
+// ledger by the corresponding runs.
+func (r *Repository) SumUnpaidInterest(ctx context.Context, from, to time.Time) (map[string]decimal.Decimal, decimal.Decimal, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT account_id, SUM(amount)
+		FROM interest_accruals
+		WHERE accrual_date >= $1 AND accrual_date < $2 AND payout_period IS NULL
+		GROUP BY account_id
+		ORDER BY account_id
+	`, from, to)
+	if err != nil {
+		return nil, decimal.Zero, fmt.Errorf("failed to sum unpaid interest: %w", err)
+	}
+	defer rows.Close()
+
+	byAccount := make(map[string]decimal.Decimal)
+	for rows.Next() {
+		var accountID string
+		var amount decimal.Decimal
+		if err := rows.Scan(&accountID, &amount); err != nil {
+			return nil, decimal.Zero, fmt.Errorf("failed to scan unpaid interest: %w", err)
+		}
+		byAccount[accountID] = amount
+	}
+	if err := rows.Err(); err != nil {
+		return nil, decimal.Zero, fmt.Errorf("failed to read unpaid interest: %w", err)
+	}
+
+	var posted decimal.Decimal
+	err = r.pool.QueryRow(ctx, `
+		SELECT COALESCE(SUM(posted_amount), 0)
+		FROM interest_accrual_runs
+		WHERE accrual_date >= $1 AND accrual_date < $2
+		  AND NOT EXISTS (
+			SELECT 1 FROM interest_accruals a
+			WHERE a.accrual_date = interest_accrual_runs.accrual_date AND a.payout_period IS NOT NULL
+		  )
+	`, from, to).Scan(&posted)
+	if err != nil {
+		return nil, decimal.Zero, fmt.Errorf("failed to sum posted interest: %w", err)
+	}
+
+	return byAccount, posted, nil
+}
+
+// GetInterestPayout returns nil without an error if period has not been
+// paid out yet.
+func (r *Repository) GetInterestPayout(ctx context.Context, period string) (*InterestPayout, error) {
+	var payout InterestPayout
+	err := r.pool.QueryRow(ctx, `
+		SELECT period, status, accrued_amount, paid_amount, transaction_id
+		FROM interest_payouts
+		WHERE period = $1
+	`, period).Scan(
+		&payout.Period,
+		&payout.Status,
+		&payout.AccruedAmount,
+		&payout.PaidAmount,
+		&payout.TransactionID,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get interest payout %s: %w", period, err)
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT account_id, amount FROM interest_payout_lines
+		WHERE period = $1
+		ORDER BY account_id
+	`, period)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest payout lines: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line InterestPayoutLine
+		if err := rows.Scan(&line.AccountID, &line.Amount); err != nil {
+			return nil, fmt.Errorf("failed to scan interest payout line: %w", err)
+		}
+		payout.Lines = append(payout.Lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read interest payout lines: %w", err)
+	}
+
+	return &payout, nil
+}
+
+// CreateInterestPayout records a PENDING payout with its lines and assigns
+// every unpaid accrual in [from, to) to it, atomically.
+func (r *Repository) CreateInterestPayout(ctx context.Context, payout *InterestPayout, from, to time.Time) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO interest_payouts (period, status, accrued_amount, paid_amount, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+	`, payout.Period, RunStatusPending, payout.AccruedAmount, payout.PaidAmount)
+	if err != nil {
+		return fmt.Errorf("failed to create interest payout: %w", err)
+	}
+
+	rows := make([][]interface{}, len(payout.Lines))
+	for i, line := range payout.Lines {
+		rows[i] = []interface{}{payout.Period, line.AccountID, line.Amount}
+	}
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"interest_payout_lines"},
+		[]string{"period", "account_id", "amount"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert interest payout lines: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE interest_accruals SET payout_period = $1
+		WHERE accrual_date >= $2 AND accrual_date < $3 AND payout_period IS NULL
+	`, payout.Period, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to assign accruals to payout: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit interest payout: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) MarkInterestPayoutPosted(ctx context.Context, period, transactionID string) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE interest_payouts
+		SET status = $2, transaction_id = NULLIF($3, ''), posted_at = NOW()
+		WHERE period = $1
+	`, period, RunStatusPosted, transactionID)
+	if err != nil {
+		return fmt.Errorf("failed to mark interest payout %s posted: %w", period, err)
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	NotificationPending = "PENDING"
+	NotificationSent    = "SENT"
+	NotificationFailed  = "FAILED"
+)
+
+type NotificationPreferences struct {
+	UserID              string
+	Channels            []string
+	PushToken           *string
+	LowBalanceThreshold *decimal.Decimal
+	UpdatedAt           time.Time
+}
+
+type Notification struct {
+	ID            string
+	UserID        string
+	AccountID     string
+	Event         string
+	Channel       string
+	Recipient     string
+	TransactionID string
+	Subject       string
+	Body          string
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     *string
+	CreatedAt     time.Time
+	SentAt        *time.Time
+}
+
+const notificationColumns = `
+	id, user_id, account_id, event, channel, recipient, transaction_id, subject, body,
+	status, attempts, next_attempt_at, last_error, created_at, sent_at
+`
+
+func scanNotification(row pgx.Row) (*Notification, error) {
+	var n Notification
+	err := row.Scan(
+		&n.ID,
+		&n.UserID,
+		&n.AccountID,
+		&n.Event,
+		&n.Channel,
+		&n.Recipient,
+		&n.TransactionID,
+		&n.Subject,
+		&n.Body,
+		&n.Status,
+		&n.Attempts,
+		&n.NextAttemptAt,
+		&n.LastError,
+		&n.CreatedAt,
+		&n.SentAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// GetNotificationPreferences returns the preferences of userID, or the
+// defaults (email only, no low balance alert) if they never set any.
+func (r *Repository) GetNotificationPreferences(ctx context.Context, userID string) (*NotificationPreferences, error) {
+	query := `
+		SELECT user_id, channels, push_token, low_balance_threshold, updated_at
+		FROM notification_preferences
+		WHERE user_id = $1
+	`
+
+	var prefs NotificationPreferences
+	err := r.pool.QueryRow(ctx, query, userID).Scan(
+		&prefs.UserID,
+		&prefs.Channels,
+		&prefs.PushToken,
+		&prefs.LowBalanceThreshold,
+		&prefs.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &NotificationPreferences{UserID: userID, Channels: []string{"EMAIL"}}, nil
+		}
+		return nil, fmt.Errorf("failed to get notification preferences of user %s: %w", userID, err)
+	}
+
+	return &prefs, nil
+}
+
+func (r *Repository) SetNotificationPreferences(ctx context.Context, prefs *NotificationPreferences) error {
+	query := `
+		INSERT INTO notification_preferences (user_id, channels, push_token, low_balance_threshold, updated_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (user_id)
+		DO UPDATE SET
+			channels = EXCLUDED.channels,
+			push_token = EXCLUDED.push_token,
+			low_balance_threshold = EXCLUDED.low_balance_threshold,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`
+
+	err := r.pool.QueryRow(ctx, query, prefs.UserID, prefs.Channels, prefs.PushToken, prefs.LowBalanceThreshold).Scan(&prefs.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set notification preferences of user %s: %w", prefs.UserID, err)
+	}
+
+	return nil
+}
+
+// EnqueueNotifications adds notifications to the outbox, skipping any whose
+// transaction, account, event and channel were already enqueued. It
+// returns how many were added.
+func (r *Repository) EnqueueNotifications(ctx context.Context, notifications []Notification) (int, error) {
+	query := `
+		INSERT INTO notifications (id, user_id, account_id, event, channel, recipient, transaction_id, subject, body, status, next_attempt_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, 'PENDING', NOW(), NOW())
+		ON CONFLICT (transaction_id, account_id, event, channel) DO NOTHING
+	`
+
+	batch := &pgx.Batch{}
+	for _, n := range notifications {
+		batch.Queue(query, n.ID, n.UserID, n.AccountID, n.Event, n.Channel, n.Recipient, n.TransactionID, n.Subject, n.Body)
+	}
+
+	results := r.pool.SendBatch(ctx, batch)
+	defer results.Close()
+
+	added := 0
+	for range notifications {
+		tag, err := results.Exec()
+		if err != nil {
+			return added, fmt.Errorf("failed to enqueue notification: %w", err)
+		}
+		added += int(tag.RowsAffected())
+	}
+
+	return added, nil
+}
+
+// ClaimDueNotifications leases up to limit pending notifications due at
+// now, so that concurrent workers do not send them twice.
+func (r *Repository) ClaimDueNotifications(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]Notification, error) {
+	query := `
+		UPDATE notifications
+		SET locked_until = $2
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE status = 'PENDING' AND next_attempt_at <= $1
+			  AND (locked_until IS NULL OR locked_until < $1)
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + notificationColumns
+
+	rows, err := r.pool.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		notifications = append(notifications, *n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+func (r *Repository) MarkNotificationSent(ctx context.Context, id string, attempts int) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE notifications
+		SET status = 'SENT', attempts = $2, sent_at = NOW(), locked_until = NULL, last_error = NULL
+		WHERE id = $1
+	`, id, attempts)
+	if err != nil {
+		return fmt.Errorf("failed to mark notification %s sent: %w", id, err)
+	}
+	return nil
+}
+
+// DeferNotification records a failed delivery attempt. The notification is
+// retried at nextAttemptAt, or given up on as FAILED when nextAttemptAt is
+// nil.
+func (r *Repository) DeferNotification(ctx context.Context, id string, attempts int, nextAttemptAt *time.Time, lastError string) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE notifications
+		SET status = CASE WHEN $3::TIMESTAMP IS NULL THEN 'FAILED' ELSE 'PENDING' END,
+		    attempts = $2,
+		    next_attempt_at = COALESCE($3, next_attempt_at),
+		    last_error = $4,
+		    locked_until = NULL
+		WHERE id = $1
+	`, id, attempts, nextAttemptAt, lastError)
+	if err != nil {
+		return fmt.Errorf("failed to defer notification %s: %w", id, err)
+	}
+	return nil
+}
+
+// ListNotifications returns a page of the notifications of userID, newest
+// first, and the total number.
+func (r *Repository) ListNotifications(ctx context.Context, userID string, page, pageSize int) ([]Notification, int, error) {
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM notifications WHERE user_id = $1`, userID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	query := `
+		SELECT ` + notificationColumns + `
+		FROM notifications
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := r.pool.Query(ctx, query, userID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		notifications = append(notifications, *n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read notifications: %w", err)
+	}
+
+	return notifications, total, nil
+}
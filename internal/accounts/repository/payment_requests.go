@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	PaymentRequestPending   = "PENDING"
+	PaymentRequestAccepted  = "ACCEPTED"
+	PaymentRequestDeclined  = "DECLINED"
+	PaymentRequestCancelled = "CANCELLED"
+	PaymentRequestExpired   = "EXPIRED"
+)
+
+type PaymentRequest struct {
+	ID                 string
+	RequesterAccountID string
+	PayerAccountID     string
+	Amount             decimal.Decimal
+	Memo               string
+	Status             string
+	ExpiresAt          time.Time
+	TransactionID      *string
+	CreatedAt          time.Time
+	RespondedAt        *time.Time
+}
+
+// paymentRequestColumns reports a PENDING request past its expiry as
+// EXPIRED.
+const paymentRequestColumns = `
+	id, requester_account_id, payer_account_id, amount, memo,
+	CASE WHEN status = 'PENDING' AND expires_at <= NOW() THEN 'EXPIRED' ELSE status END,
+	expires_at, transaction_id, created_at, responded_at
+`
+
+func scanPaymentRequest(row pgx.Row) (*PaymentRequest, error) {
+	var pr PaymentRequest
+	err := row.Scan(
+		&pr.ID,
+		&pr.RequesterAccountID,
+		&pr.PayerAccountID,
+		&pr.Amount,
+		&pr.Memo,
+		&pr.Status,
+		&pr.ExpiresAt,
+		&pr.TransactionID,
+		&pr.CreatedAt,
+		&pr.RespondedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+func (r *Repository) CreatePaymentRequest(ctx context.Context, pr *PaymentRequest) error {
+	query := `
+		INSERT INTO payment_requests (id, requester_account_id, payer_account_id, amount, memo, status, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		RETURNING created_at
+	`
+
+	err := r.pool.QueryRow(ctx, query,
+		pr.ID, pr.RequesterAccountID, pr.PayerAccountID, pr.Amount, pr.Memo, PaymentRequestPending, pr.ExpiresAt,
+	).Scan(&pr.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create payment request: %w", err)
+	}
+	pr.Status = PaymentRequestPending
+
+	return nil
+}
+
+func (r *Repository) GetPaymentRequest(ctx context.Context, id string) (*PaymentRequest, error) {
+	query := `SELECT ` + paymentRequestColumns + ` FROM payment_requests WHERE id = $1`
+
+	pr, err := scanPaymentRequest(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", accountErrors.ErrPaymentRequestNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get payment request %s: %w", id, err)
+	}
+
+	return pr, nil
+}
+
+// ListPaymentRequests returns a page of the requests accountID made
+// (outgoing) or was asked to pay (incoming), newest first, and the total
+// number matching.
+func (r *Repository) ListPaymentRequests(ctx context.Context, accountID string, incoming bool, status string, page, pageSize int) ([]PaymentRequest, int, error) {
+	column := "requester_account_id"
+	if incoming {
+		column = "payer_account_id"
+	}
+	where := fmt.Sprintf(`
+		WHERE %s = $1
+		  AND ($2 = '' OR (CASE WHEN status = 'PENDING' AND expires_at <= NOW() THEN 'EXPIRED' ELSE status END) = $2)
+	`, column)
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM payment_requests`+where, accountID, status).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count payment requests: %w", err)
+	}
+
+	query := `SELECT ` + paymentRequestColumns + ` FROM payment_requests` + where + `
+		ORDER BY created_at DESC, id
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := r.pool.Query(ctx, query, accountID, status, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list payment requests: %w", err)
+	}
+	defer rows.Close()
+
+	var requests []PaymentRequest
+	for rows.Next() {
+		pr, err := scanPaymentRequest(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan payment request: %w", err)
+		}
+		requests = append(requests, *pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read payment requests: %w", err)
+	}
+
+	return requests, total, nil
+}
+
+// ClaimPaymentRequest claims the PENDING request id of payerAccountID for
+// accepting until ttl from now and returns it, reported as EXPIRED if it
+// is past its expiry. It returns nil without an error if the request is
+// not the payer's, is no longer pending or is already claimed.
+func (r *Repository) ClaimPaymentRequest(ctx context.Context, id, payerAccountID string, ttl time.Duration) (*PaymentRequest, error) {
+	query := `
+		UPDATE payment_requests
+		SET accepting_until = NOW() + $3 * INTERVAL '1 second'
+		WHERE id = $1 AND payer_account_id = $2 AND status = 'PENDING'
+		  AND (accepting_until IS NULL OR accepting_until < NOW())
+		RETURNING ` + paymentRequestColumns
+
+	pr, err := scanPaymentRequest(r.pool.QueryRow(ctx, query, id, payerAccountID, ttl.Seconds()))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to claim payment request %s: %w", id, err)
+	}
+
+	return pr, nil
+}
+
+// ReleasePaymentRequest drops the claim on a request that was not paid.
+func (r *Repository) ReleasePaymentRequest(ctx context.Context, id string) error {
+	_, err := r.pool.Exec(ctx, `UPDATE payment_requests SET accepting_until = NULL WHERE id = $1 AND status = 'PENDING'`, id)
+	if err != nil {
+		return fmt.Errorf("failed to release payment request %s: %w", id, err)
+	}
+	return nil
+}
+
+// SettlePaymentRequest marks the request ACCEPTED with the transaction that
+// paid it. A posted transfer is what decides: a request that was declined
+// or cancelled while it was being paid is accepted all the same. A request
+// that is already ACCEPTED is returned as it is.
+func (r *Repository) SettlePaymentRequest(ctx context.Context, id, transactionID string) (*PaymentRequest, error) {
+	query := `
+		UPDATE payment_requests
+		SET status = $2, transaction_id = $3, responded_at = NOW(), accepting_until = NULL
+		WHERE id = $1 AND status <> $2
+		RETURNING ` + paymentRequestColumns
+
+	pr, err := scanPaymentRequest(r.pool.QueryRow(ctx, query, id, PaymentRequestAccepted, transactionID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return r.GetPaymentRequest(ctx, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept payment request %s: %w", id, err)
+	}
+
+	return pr, nil
+}
+
+// ClosePaymentRequest moves a PENDING, unexpired request that is not
+// being accepted to status. It returns nil without an error if the request
+// is no longer pending or is claimed.
+func (r *Repository) ClosePaymentRequest(ctx context.Context, id, status string) (*PaymentRequest, error) {
+	query := `
+		UPDATE payment_requests
+		SET status = $2, responded_at = NOW()
+		WHERE id = $1 AND status = 'PENDING' AND expires_at > NOW()
+		  AND (accepting_until IS NULL OR accepting_until < NOW())
+		RETURNING ` + paymentRequestColumns
+
+	pr, err := scanPaymentRequest(r.pool.QueryRow(ctx, query, id, status))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to update payment request %s: %w", id, err)
+	}
+
+	return pr, nil
+}
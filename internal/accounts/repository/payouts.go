@@ -0,0 +1,420 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	PayoutPendingApproval = "PENDING_APPROVAL"
+	PayoutApproved        = "APPROVED"
+	PayoutCompleted       = "COMPLETED"
+	PayoutCancelled       = "CANCELLED"
+)
+
+const (
+	PayoutRowValid     = "VALID"
+	PayoutRowInvalid   = "INVALID"
+	PayoutRowSubmitted = "SUBMITTED"
+	PayoutRowPaid      = "PAID"
+	PayoutRowFailed    = "FAILED"
+)
+
+// PayoutBatch is an uploaded payout file. TotalAmount is the sum of its
+// valid rows; the Paid and Failed fields are its progress so far.
+type PayoutBatch struct {
+	ID              string
+	Filename        string
+	Status          string
+	SourceAccountID string
+	TotalRows       int
+	ValidRows       int
+	TotalAmount     decimal.Decimal
+	CreatedBy       string
+	ApprovedBy      *string
+	CreatedAt       time.Time
+	ApprovedAt      *time.Time
+	CompletedAt     *time.Time
+	PaidRows        int
+	FailedRows      int
+	PaidAmount      decimal.Decimal
+}
+
+// PayoutRow is one line of a payout file. AccountID and Amount are nil
+// when the line could not be resolved or parsed.
+type PayoutRow struct {
+	BatchID       string
+	Line          int
+	Recipient     string
+	AccountID     *string
+	Amount        *decimal.Decimal
+	Description   string
+	Status        string
+	TransactionID *string
+	Error         *string
+	UpdatedAt     time.Time
+}
+
+// payoutBatchSelect selects batches with their progress, counted from
+// their rows.
+const payoutBatchSelect = `
+	SELECT b.id, b.filename, b.status, b.source_account_id, b.total_rows, b.valid_rows, b.total_amount,
+	       b.created_by, b.approved_by, b.created_at, b.approved_at, b.completed_at,
+	       p.paid_rows, p.failed_rows, p.paid_amount
+	FROM payout_batches b
+	CROSS JOIN LATERAL (
+		SELECT COUNT(*) FILTER (WHERE status = 'PAID') AS paid_rows,
+		       COUNT(*) FILTER (WHERE status = 'FAILED') AS failed_rows,
+		       COALESCE(SUM(amount) FILTER (WHERE status = 'PAID'), 0) AS paid_amount
+		FROM payout_rows
+		WHERE batch_id = b.id
+	) p
+`
+
+func scanPayoutBatch(row pgx.Row) (*PayoutBatch, error) {
+	var b PayoutBatch
+	err := row.Scan(
+		&b.ID,
+		&b.Filename,
+		&b.Status,
+		&b.SourceAccountID,
+		&b.TotalRows,
+		&b.ValidRows,
+		&b.TotalAmount,
+		&b.CreatedBy,
+		&b.ApprovedBy,
+		&b.CreatedAt,
+		&b.ApprovedAt,
+		&b.CompletedAt,
+		&b.PaidRows,
+		&b.FailedRows,
+		&b.PaidAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+const payoutRowColumns = `
+	batch_id, line, recipient, account_id, amount, description, status, transaction_id, error, updated_at
+`
+
+func payoutRowFields(r *PayoutRow) []any {
+	return []any{
+		&r.BatchID,
+		&r.Line,
+		&r.Recipient,
+		&r.AccountID,
+		&r.Amount,
+		&r.Description,
+		&r.Status,
+		&r.TransactionID,
+		&r.Error,
+		&r.UpdatedAt,
+	}
+}
+
+// CreatePayoutBatch records a validated batch and all of its rows
+// atomically.
+func (r *Repository) CreatePayoutBatch(ctx context.Context, batch *PayoutBatch, rows []PayoutRow) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO payout_batches (id, filename, status, source_account_id, total_rows, valid_rows, total_amount, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		RETURNING created_at
+	`, batch.ID, batch.Filename, batch.Status, batch.SourceAccountID, batch.TotalRows, batch.ValidRows, batch.TotalAmount, batch.CreatedBy).
+		Scan(&batch.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create payout batch: %w", err)
+	}
+
+	copyRows := make([][]interface{}, len(rows))
+	for i, row := range rows {
+		copyRows[i] = []interface{}{batch.ID, row.Line, row.Recipient, row.AccountID, row.Amount, row.Description, row.Status, row.Error, batch.CreatedAt}
+	}
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"payout_rows"},
+		[]string{"batch_id", "line", "recipient", "account_id", "amount", "description", "status", "error", "updated_at"},
+		pgx.CopyFromRows(copyRows),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert payout rows: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit payout batch: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetPayoutBatch(ctx context.Context, id string) (*PayoutBatch, error) {
+	batch, err := scanPayoutBatch(r.pool.QueryRow(ctx, payoutBatchSelect+` WHERE b.id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", accountErrors.ErrPayoutBatchNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get payout batch %s: %w", id, err)
+	}
+
+	return batch, nil
+}
+
+// ListPayoutBatches returns a page of batches, newest first, optionally
+// restricted to one status, and the total number.
+func (r *Repository) ListPayoutBatches(ctx context.Context, status string, page, pageSize int) ([]PayoutBatch, int, error) {
+	var total int
+	err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM payout_batches WHERE $1 = '' OR status = $1`, status).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count payout batches: %w", err)
+	}
+
+	query := payoutBatchSelect + `
+		WHERE $1 = '' OR b.status = $1
+		ORDER BY b.created_at DESC, b.id
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := r.pool.Query(ctx, query, status, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list payout batches: %w", err)
+	}
+	defer rows.Close()
+
+	var batches []PayoutBatch
+	for rows.Next() {
+		batch, err := scanPayoutBatch(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan payout batch: %w", err)
+		}
+		batches = append(batches, *batch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read payout batches: %w", err)
+	}
+
+	return batches, total, nil
+}
+
+// ListPayoutRows returns a page of the rows of a batch in file order,
+// optionally restricted to one status, and the total number. A pageSize of
+// zero returns every row.
+func (r *Repository) ListPayoutRows(ctx context.Context, batchID, status string, page, pageSize int) ([]PayoutRow, int, error) {
+	var total int
+	err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM payout_rows WHERE batch_id = $1 AND ($2 = '' OR status = $2)
+	`, batchID, status).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count payout rows: %w", err)
+	}
+
+	query := `
+		SELECT ` + payoutRowColumns + `
+		FROM payout_rows
+		WHERE batch_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY line
+		LIMIT NULLIF($3, 0) OFFSET $4
+	`
+	rows, err := r.pool.Query(ctx, query, batchID, status, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list payout rows: %w", err)
+	}
+
+	payoutRows, err := collectPayoutRows(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return payoutRows, total, nil
+}
+
+func collectPayoutRows(rows pgx.Rows) ([]PayoutRow, error) {
+	defer rows.Close()
+
+	var payoutRows []PayoutRow
+	for rows.Next() {
+		var row PayoutRow
+		if err := rows.Scan(payoutRowFields(&row)...); err != nil {
+			return nil, fmt.Errorf("failed to scan payout row: %w", err)
+		}
+		payoutRows = append(payoutRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read payout rows: %w", err)
+	}
+
+	return payoutRows, nil
+}
+
+// ApprovePayoutBatch queues a batch awaiting approval for payment. It
+// returns false if the batch was not awaiting approval.
+func (r *Repository) ApprovePayoutBatch(ctx context.Context, id, approvedBy string) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE payout_batches
+		SET status = 'APPROVED', approved_by = $2, approved_at = NOW()
+		WHERE id = $1 AND status = 'PENDING_APPROVAL'
+	`, id, approvedBy)
+	if err != nil {
+		return false, fmt.Errorf("failed to approve payout batch %s: %w", id, err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// CancelPayoutBatch cancels a batch awaiting approval. It returns false if
+// the batch was not awaiting approval.
+func (r *Repository) CancelPayoutBatch(ctx context.Context, id string) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE payout_batches SET status = 'CANCELLED'
+		WHERE id = $1 AND status = 'PENDING_APPROVAL'
+	`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel payout batch %s: %w", id, err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// payoutClaimLockKey is the advisory lock under which payout batches are
+// claimed.
+const payoutClaimLockKey = "payout_batches"
+
+// ClaimPayoutBatch leases the oldest approved batch and returns its id, or
+// an empty string if there is none or another worker holds a batch. Batches
+// are paid one at a time because they all draw on the same account. A lease
+// that is not renewed, because the worker died, expires after lease.
+func (r *Repository) ClaimPayoutBatch(ctx context.Context, now time.Time, lease time.Duration) (string, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock(hashtext($1))`, payoutClaimLockKey).Scan(&locked); err != nil {
+		return "", fmt.Errorf("failed to lock payout batches: %w", err)
+	}
+	if !locked {
+		return "", nil
+	}
+
+	var id string
+	err = tx.QueryRow(ctx, `
+		UPDATE payout_batches
+		SET locked_until = $2
+		WHERE id = (
+			SELECT id FROM payout_batches
+			WHERE status = 'APPROVED' AND (locked_until IS NULL OR locked_until < $1)
+			ORDER BY approved_at
+			LIMIT 1
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM payout_batches
+			WHERE status = 'APPROVED' AND locked_until >= $1
+		)
+		RETURNING id
+	`, now, now.Add(lease)).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to claim payout batch: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("failed to commit payout batch claim: %w", err)
+	}
+	return id, nil
+}
+
+func (r *Repository) RenewPayoutBatchLease(ctx context.Context, id string, until time.Time) error {
+	_, err := r.pool.Exec(ctx, `UPDATE payout_batches SET locked_until = $2 WHERE id = $1`, id, until)
+	if err != nil {
+		return fmt.Errorf("failed to renew lease on payout batch %s: %w", id, err)
+	}
+	return nil
+}
+
+// NextPayoutRows returns up to limit rows of a batch that are still to be
+// paid, in file order.
+func (r *Repository) NextPayoutRows(ctx context.Context, batchID string, limit int) ([]PayoutRow, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+payoutRowColumns+`
+		FROM payout_rows
+		WHERE batch_id = $1 AND status IN ('VALID', 'SUBMITTED')
+		ORDER BY line
+		LIMIT $2
+	`, batchID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unpaid payout rows: %w", err)
+	}
+	return collectPayoutRows(rows)
+}
+
+// MarkPayoutRowsSubmitted records that lines are about to be posted.
+func (r *Repository) MarkPayoutRowsSubmitted(ctx context.Context, batchID string, lines []int) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE payout_rows SET status = 'SUBMITTED', updated_at = NOW()
+		WHERE batch_id = $1 AND line = ANY($2)
+	`, batchID, lines)
+	if err != nil {
+		return fmt.Errorf("failed to mark payout rows submitted: %w", err)
+	}
+	return nil
+}
+
+// SettlePayoutRows records the outcome, PAID or FAILED, of each of rows.
+func (r *Repository) SettlePayoutRows(ctx context.Context, batchID string, rows []PayoutRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	lines := make([]int, len(rows))
+	statuses := make([]string, len(rows))
+	transactionIDs := make([]string, len(rows))
+	errs := make([]string, len(rows))
+	for i, row := range rows {
+		lines[i] = row.Line
+		statuses[i] = row.Status
+		if row.TransactionID != nil {
+			transactionIDs[i] = *row.TransactionID
+		}
+		if row.Error != nil {
+			errs[i] = *row.Error
+		}
+	}
+
+	_, err := r.pool.Exec(ctx, `
+		UPDATE payout_rows p
+		SET status = s.status, transaction_id = NULLIF(s.transaction_id, ''), error = NULLIF(s.error, ''), updated_at = NOW()
+		FROM unnest($2::int[], $3::text[], $4::text[], $5::text[]) AS s(line, status, transaction_id, error)
+		WHERE p.batch_id = $1 AND p.line = s.line
+	`, batchID, lines, statuses, transactionIDs, errs)
+	if err != nil {
+		return fmt.Errorf("failed to settle payout rows: %w", err)
+	}
+	return nil
+}
+
+// CompletePayoutBatch marks an approved batch whose rows are all settled
+// as completed and releases its lease.
+func (r *Repository) CompletePayoutBatch(ctx context.Context, id string) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE payout_batches
+		SET status = 'COMPLETED', completed_at = NOW(), locked_until = NULL
+		WHERE id = $1 AND status = 'APPROVED'
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to complete payout batch %s: %w", id, err)
+	}
+	return nil
+}
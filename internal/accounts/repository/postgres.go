@@ -4,17 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
-
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
 
 	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/fees"
 )
 
 type Account struct {
@@ -23,18 +22,43 @@ type Account struct {
 	UserID            *string
 	Email             *string
 	ReferrerAccountID *string
+	Tier              string
+	Handle            *string
+	Name              string
+	Currency          string
+	IsDefault         bool
 	CreatedAt         time.Time
 }
 
-type Transaction struct {
-	ID            string
-	TransactionID string
-	AccountID     string
-	Amount        decimal.Decimal
-	Direction     string
-	ReferenceID   string
-	Description   string
-	CreatedAt     time.Time
+// accountColumns and accountTables select an account with its owner's
+// email, which lives on users.
+const (
+	accountColumns = `
+		a.account_id, a.account_type, a.user_id, u.email, a.referrer_account_id,
+		a.tier, a.handle, a.name, a.currency, a.is_default, a.created_at
+	`
+	accountTables = `accounts a LEFT JOIN users u ON u.user_id = a.user_id`
+)
+
+func scanAccount(row pgx.Row) (*Account, error) {
+	var account Account
+	err := row.Scan(
+		&account.AccountID,
+		&account.AccountType,
+		&account.UserID,
+		&account.Email,
+		&account.ReferrerAccountID,
+		&account.Tier,
+		&account.Handle,
+		&account.Name,
+		&account.Currency,
+		&account.IsDefault,
+		&account.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
 }
 
 type Repository struct {
@@ -51,80 +75,152 @@ func NewRepository(pool *pgxpool.Pool, logger *logrus.Logger) *Repository {
 	}
 }
 
+// CreateAccount creates a user for email together with their default
+// wallet.
 func (r *Repository) CreateAccount(ctx context.Context, email, referrerAccountID string) (*Account, error) {
 	accountID := uuid.New().String()
 
-	query := `
-		INSERT INTO accounts (account_id, account_type, user_id, email, referrer_account_id, created_at)
-		VALUES ($1, $2, $3, $4, $5, NOW())
-		RETURNING account_id, account_type, user_id, email, referrer_account_id, created_at
-	`
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
 
-	var account Account
-	err := r.pool.QueryRow(ctx, query,
-		accountID,
-		"USER",
-		accountID,
-		email,
-		referrerAccountID,
-	).Scan(
-		&account.AccountID,
-		&account.AccountType,
-		&account.UserID,
-		&account.Email,
-		&account.ReferrerAccountID,
-		&account.CreatedAt,
-	)
+	if err := claimEmail(ctx, tx, email, ""); err != nil {
+		return nil, err
+	}
 
+	_, err = tx.Exec(ctx, `
+		INSERT INTO users (user_id, email, created_at, updated_at)
+		VALUES ($1, $2, NOW(), NOW())
+	`, accountID, email)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
 			return nil, fmt.Errorf("%w: %v", accountErrors.ErrEmailAlreadyExists, err)
 		}
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO accounts (account_id, account_type, user_id, referrer_account_id, is_default, created_at)
+		VALUES ($1, 'USER', $2, $3, TRUE, NOW())
+	`, accountID, accountID, referrerAccountID)
+	if err != nil {
 		return nil, fmt.Errorf("failed to create account: %w", err)
 	}
 
+	account, err := scanAccount(tx.QueryRow(ctx, `SELECT `+accountColumns+` FROM `+accountTables+` WHERE a.account_id = $1`, accountID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read created account: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit account: %w", err)
+	}
+
 	r.logger.Infof("Created account: %s for email: %s", accountID, email)
-	return &account, nil
+	return account, nil
 }
 
-func (r *Repository) GetAccount(ctx context.Context, accountID string) (*Account, error) {
+// CreateWallet opens another wallet for an existing user.
+func (r *Repository) CreateWallet(ctx context.Context, userID, name, currency string) (*Account, error) {
+	accountID := uuid.New().String()
+
 	query := `
-		SELECT account_id, account_type, user_id, email, referrer_account_id, created_at
-		FROM accounts
-		WHERE account_id = $1
+		WITH inserted AS (
+			INSERT INTO accounts (account_id, account_type, user_id, name, currency, is_default, created_at)
+			VALUES ($1, 'USER', $2, $3, $4, FALSE, NOW())
+			RETURNING *
+		)
+		SELECT ` + accountColumns + `
+		FROM inserted a LEFT JOIN users u ON u.user_id = a.user_id
 	`
 
-	var account Account
-	err := r.pool.QueryRow(ctx, query, accountID).Scan(
-		&account.AccountID,
-		&account.AccountType,
-		&account.UserID,
-		&account.Email,
-		&account.ReferrerAccountID,
-		&account.CreatedAt,
-	)
+	account, err := scanAccount(r.pool.QueryRow(ctx, query, accountID, userID, name, currency))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, fmt.Errorf("%w: %q", accountErrors.ErrWalletNameTaken, name)
+		}
+		return nil, fmt.Errorf("failed to create wallet: %w", err)
+	}
+
+	r.logger.Infof("Created wallet %s (%s) for user %s", accountID, name, userID)
+	return account, nil
+}
+
+// DeleteNewAccount removes an account that was just created and nothing
+// refers to yet, and its user too if that was the user's only account. It
+// undoes CreateAccount or CreateWallet when the rest of opening the account
+// fails.
+func (r *Repository) DeleteNewAccount(ctx context.Context, accountID string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
 
+	var userID *string
+	err = tx.QueryRow(ctx, `DELETE FROM accounts WHERE account_id = $1 RETURNING user_id`, accountID).Scan(&userID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("%w: account %s", accountErrors.ErrAccountNotFound, accountID)
+			return nil
 		}
-		return nil, fmt.Errorf("failed to get account %s: %w", accountID, err)
+		return fmt.Errorf("failed to delete account %s: %w", accountID, err)
 	}
 
-	return &account, nil
+	if userID != nil {
+		_, err = tx.Exec(ctx, `
+			DELETE FROM users
+			WHERE user_id = $1 AND NOT EXISTS (SELECT 1 FROM accounts WHERE user_id = $1)
+		`, *userID)
+		if err != nil {
+			return fmt.Errorf("failed to delete user %s: %w", *userID, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit account deletion: %w", err)
+	}
+	return nil
 }
 
-func (r *Repository) GetBalance(ctx context.Context, accountID string) (decimal.Decimal, error) {
-	query := `SELECT COALESCE(amount, 0) FROM balances WHERE account_id = $1`
+// ListUserAccountIDs returns the id of every USER account.
+func (r *Repository) ListUserAccountIDs(ctx context.Context) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `SELECT account_id FROM accounts WHERE account_type = 'USER' ORDER BY account_id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user accounts: %w", err)
+	}
+	defer rows.Close()
 
-	var balance decimal.Decimal
-	err := r.pool.QueryRow(ctx, query, accountID).Scan(&balance)
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan account id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read user accounts: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *Repository) GetAccount(ctx context.Context, accountID string) (*Account, error) {
+	query := `SELECT ` + accountColumns + ` FROM ` + accountTables + ` WHERE a.account_id = $1`
+
+	account, err := scanAccount(r.pool.QueryRow(ctx, query, accountID))
 	if err != nil {
-		return decimal.Zero, nil
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: account %s", accountErrors.ErrAccountNotFound, accountID)
+		}
+		return nil, fmt.Errorf("failed to get account %s: %w", accountID, err)
 	}
 
-	return balance, nil
+	return account, nil
 }
 
 func (r *Repository) AccountExists(ctx context.Context, accountID string) (bool, error) {
@@ -139,51 +235,313 @@ func (r *Repository) AccountExists(ctx context.Context, accountID string) (bool,
 	return exists, nil
 }
 
-func (r *Repository) GetTransactionHistory(ctx context.Context, accountID string, page, pageSize int) ([]Transaction, int, error) {
-	var totalCount int
-	countQuery := `SELECT COUNT(*) FROM ledger_entries WHERE account_id = $1`
-	err := r.pool.QueryRow(ctx, countQuery, accountID).Scan(&totalCount)
+// GetAccounts returns the accounts with the given ids keyed by id. Unknown
+// ids are omitted.
+func (r *Repository) GetAccounts(ctx context.Context, accountIDs []string) (map[string]*Account, error) {
+	query := `SELECT ` + accountColumns + ` FROM ` + accountTables + ` WHERE a.account_id = ANY($1)`
+
+	rows, err := r.pool.Query(ctx, query, accountIDs)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get transaction count: %w", err)
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
 	}
+	defer rows.Close()
 
-	offset := (page - 1) * pageSize
+	accounts := make(map[string]*Account, len(accountIDs))
+	for rows.Next() {
+		account, err := scanAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan account: %w", err)
+		}
+		accounts[account.AccountID] = account
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read accounts: %w", err)
+	}
 
+	return accounts, nil
+}
+
+// ListAccountsForUser returns the wallets of userID, the default first.
+func (r *Repository) ListAccountsForUser(ctx context.Context, userID string) ([]Account, error) {
 	query := `
-		SELECT id, transaction_id, account_id, amount, direction,
-		       COALESCE(reference_id, '') as reference_id,
-		       COALESCE(description, '') as description,
-		       created_at
-		FROM ledger_entries
-		WHERE account_id = $1
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3
+		SELECT ` + accountColumns + `
+		FROM ` + accountTables + `
+		WHERE a.user_id = $1 AND a.account_type = 'USER'
+		ORDER BY a.is_default DESC, a.created_at, a.account_id
 	`
 
-	rows, err := r.pool.Query(ctx, query, accountID, pageSize, offset)
+	rows, err := r.pool.Query(ctx, query, userID)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get transactions: %w", err)
+		return nil, fmt.Errorf("failed to list accounts of user %s: %w", userID, err)
 	}
 	defer rows.Close()
 
-	var transactions []Transaction
+	var accounts []Account
 	for rows.Next() {
-		var txn Transaction
-		err := rows.Scan(
-			&txn.ID,
-			&txn.TransactionID,
-			&txn.AccountID,
-			&txn.Amount,
-			&txn.Direction,
-			&txn.ReferenceID,
-			&txn.Description,
-			&txn.CreatedAt,
-		)
+		account, err := scanAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan account: %w", err)
+		}
+		accounts = append(accounts, *account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read accounts: %w", err)
+	}
+
+	return accounts, nil
+}
+
+// SetDefaultWallet makes accountID the default wallet of userID.
+func (r *Repository) SetDefaultWallet(ctx context.Context, userID, accountID string) (*Account, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS(SELECT 1 FROM accounts WHERE account_id = $1 AND user_id = $2 AND account_type = 'USER')
+	`, accountID, userID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check wallet %s: %w", accountID, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: account %s of user %s", accountErrors.ErrAccountNotFound, accountID, userID)
+	}
+
+	// The previous default is cleared first: at most one default per user
+	// is enforced by a unique index.
+	if _, err := tx.Exec(ctx, `UPDATE accounts SET is_default = FALSE WHERE user_id = $1 AND is_default`, userID); err != nil {
+		return nil, fmt.Errorf("failed to clear default wallet of user %s: %w", userID, err)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE accounts SET is_default = TRUE WHERE account_id = $1`, accountID); err != nil {
+		return nil, fmt.Errorf("failed to set default wallet %s: %w", accountID, err)
+	}
+
+	account, err := scanAccount(tx.QueryRow(ctx, `SELECT `+accountColumns+` FROM `+accountTables+` WHERE a.account_id = $1`, accountID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read account %s: %w", accountID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit default wallet: %w", err)
+	}
+
+	r.logger.Infof("User %s default wallet is now %s", userID, accountID)
+	return account, nil
+}
+
+// GetUserAccountByEmail finds the default wallet of the user with email,
+// ignoring case. Emails were only unique by exact case, so an address that
+// several users share ignoring case is rejected as ambiguous rather than
+// resolved to any one of them.
+func (r *Repository) GetUserAccountByEmail(ctx context.Context, email string) (*Account, error) {
+	query := `
+		SELECT ` + accountColumns + `
+		FROM ` + accountTables + `
+		WHERE LOWER(u.email) = LOWER($1) AND a.account_type = 'USER' AND a.is_default
+		LIMIT 2
+	`
+
+	rows, err := r.pool.Query(ctx, query, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up account %s: %w", email, err)
+	}
+	defer rows.Close()
+
+	var accounts []*Account
+	for rows.Next() {
+		account, err := scanAccount(rows)
 		if err != nil {
-			return nil, 0, fmt.Errorf("failed to scan transaction: %w", err)
+			return nil, fmt.Errorf("failed to scan account: %w", err)
+		}
+		accounts = append(accounts, account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to look up account %s: %w", email, err)
+	}
+
+	switch len(accounts) {
+	case 0:
+		return nil, fmt.Errorf("%w: %s", accountErrors.ErrRecipientNotFound, email)
+	case 1:
+		return accounts[0], nil
+	}
+	return nil, fmt.Errorf("%w: %s", accountErrors.ErrAmbiguousRecipient, email)
+}
+
+func (r *Repository) GetUserAccountByHandle(ctx context.Context, handle string) (*Account, error) {
+	query := `
+		SELECT ` + accountColumns + `
+		FROM ` + accountTables + `
+		WHERE a.handle = $1 AND a.account_type = 'USER'
+	`
+
+	return r.getUserAccountBy(ctx, query, handle)
+}
+
+func (r *Repository) getUserAccountBy(ctx context.Context, query, alias string) (*Account, error) {
+	account, err := scanAccount(r.pool.QueryRow(ctx, query, alias))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", accountErrors.ErrRecipientNotFound, alias)
+		}
+		return nil, fmt.Errorf("failed to look up account %s: %w", alias, err)
+	}
+
+	return account, nil
+}
+
+// SetHandle sets or, with an empty handle, clears the handle of accountID.
+func (r *Repository) SetHandle(ctx context.Context, accountID, handle string) (*Account, error) {
+	query := `
+		WITH updated AS (
+			UPDATE accounts
+			SET handle = NULLIF($2, '')
+			WHERE account_id = $1
+			RETURNING *
+		)
+		SELECT ` + accountColumns + `
+		FROM updated a LEFT JOIN users u ON u.user_id = a.user_id
+	`
+
+	account, err := scanAccount(r.pool.QueryRow(ctx, query, accountID, handle))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: account %s", accountErrors.ErrAccountNotFound, accountID)
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, fmt.Errorf("%w: @%s", accountErrors.ErrHandleTaken, handle)
 		}
-		transactions = append(transactions, txn)
+		return nil, fmt.Errorf("failed to set handle of account %s: %w", accountID, err)
+	}
+
+	return account, nil
+}
+
+type SystemAccount struct {
+	Role        string
+	AccountID   string
+	Description string
+	UpdatedAt   time.Time
+}
+
+func (r *Repository) ListSystemAccounts(ctx context.Context) ([]SystemAccount, error) {
+	query := `
+		SELECT role, account_id, description, updated_at
+		FROM system_accounts
+		ORDER BY role
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list system accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []SystemAccount
+	for rows.Next() {
+		var account SystemAccount
+		if err := rows.Scan(&account.Role, &account.AccountID, &account.Description, &account.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan system account: %w", err)
+		}
+		accounts = append(accounts, account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read system accounts: %w", err)
+	}
+
+	return accounts, nil
+}
+
+// SetSystemAccount assigns role to accountID, replacing any previous
+// assignment of the role. An empty description keeps the existing one.
+func (r *Repository) SetSystemAccount(ctx context.Context, role, accountID, description string) (*SystemAccount, error) {
+	query := `
+		INSERT INTO system_accounts (role, account_id, description, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (role)
+		DO UPDATE SET
+			account_id = EXCLUDED.account_id,
+			description = COALESCE(NULLIF(EXCLUDED.description, ''), system_accounts.description),
+			updated_at = EXCLUDED.updated_at
+		RETURNING role, account_id, description, updated_at
+	`
+
+	var account SystemAccount
+	err := r.pool.QueryRow(ctx, query, role, accountID, description).Scan(
+		&account.Role,
+		&account.AccountID,
+		&account.Description,
+		&account.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, fmt.Errorf("%w: %s", accountErrors.ErrSystemAccountInUse, accountID)
+		}
+		return nil, fmt.Errorf("failed to set system account %s: %w", role, err)
+	}
+
+	r.logger.Infof("System role %s now uses account %s", role, accountID)
+	return &account, nil
+}
+
+// CreateSystemAccount inserts a SYSTEM account row. It is a no-op if the
+// account already exists.
+func (r *Repository) CreateSystemAccount(ctx context.Context, accountID string) error {
+	query := `
+		INSERT INTO accounts (account_id, account_type, created_at)
+		VALUES ($1, 'SYSTEM', NOW())
+		ON CONFLICT (account_id) DO NOTHING
+	`
+
+	if _, err := r.pool.Exec(ctx, query, accountID); err != nil {
+		return fmt.Errorf("failed to create system account %s: %w", accountID, err)
+	}
+
+	return nil
+}
+
+// ListFeeRules returns the active fee rules for operation.
+func (r *Repository) ListFeeRules(ctx context.Context, operation string) ([]fees.Rule, error) {
+	query := `
+		SELECT id, operation, COALESCE(tier, ''), flat_amount, percentage, bands,
+		       min_fee, max_fee, description
+		FROM fee_rules
+		WHERE operation = $1 AND active
+		ORDER BY id
+	`
+
+	rows, err := r.pool.Query(ctx, query, operation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fee rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []fees.Rule
+	for rows.Next() {
+		var rule fees.Rule
+		if err := rows.Scan(
+			&rule.ID,
+			&rule.Operation,
+			&rule.Tier,
+			&rule.Flat,
+			&rule.Percentage,
+			&rule.Bands,
+			&rule.MinFee,
+			&rule.MaxFee,
+			&rule.Description,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan fee rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read fee rules: %w", err)
 	}
 
-	return transactions, totalCount, nil
+	return rules, nil
 }
@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	ScheduleStatusActive    = "ACTIVE"
+	ScheduleStatusPaused    = "PAUSED"
+	ScheduleStatusCancelled = "CANCELLED"
+	ScheduleStatusCompleted = "COMPLETED"
+)
+
+const (
+	ScheduledRunSucceeded = "SUCCEEDED"
+	ScheduledRunSkipped   = "SKIPPED"
+	ScheduledRunFailed    = "FAILED"
+)
+
+type ScheduledTransfer struct {
+	ID                      string
+	FromAccountID           string
+	ToAccountID             string
+	Amount                  decimal.Decimal
+	Description             string
+	ScheduleKind            string
+	ScheduleExpr            string
+	StartAt                 time.Time
+	EndAt                   *time.Time
+	MaxOccurrences          *int
+	InsufficientFundsPolicy string
+	MaxRetries              int
+	RetryIntervalSeconds    int
+	Status                  string
+	Occurrences             int
+	DueAt                   *time.Time
+	NextRunAt               *time.Time
+	Attempts                int
+	LastError               *string
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+}
+
+type ScheduledTransferRun struct {
+	ScheduleID    string
+	Occurrence    int
+	ScheduledFor  time.Time
+	Status        string
+	TransactionID string
+	Attempts      int
+	Error         string
+}
+
+const scheduledTransferColumns = `
+	id, from_account_id, to_account_id, amount, description, schedule_kind, schedule_expr,
+	start_at, end_at, max_occurrences, insufficient_funds_policy, max_retries, retry_interval_seconds,
+	status, occurrences, due_at, next_run_at, attempts, last_error, created_at, updated_at
+`
+
+func scanScheduledTransfer(row pgx.Row) (*ScheduledTransfer, error) {
+	var st ScheduledTransfer
+	err := row.Scan(
+		&st.ID,
+		&st.FromAccountID,
+		&st.ToAccountID,
+		&st.Amount,
+		&st.Description,
+		&st.ScheduleKind,
+		&st.ScheduleExpr,
+		&st.StartAt,
+		&st.EndAt,
+		&st.MaxOccurrences,
+		&st.InsufficientFundsPolicy,
+		&st.MaxRetries,
+		&st.RetryIntervalSeconds,
+		&st.Status,
+		&st.Occurrences,
+		&st.DueAt,
+		&st.NextRunAt,
+		&st.Attempts,
+		&st.LastError,
+		&st.CreatedAt,
+		&st.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+func (r *Repository) CreateScheduledTransfer(ctx context.Context, st *ScheduledTransfer) error {
+	query := `
+		INSERT INTO scheduled_transfers (
+			id, from_account_id, to_account_id, amount, description, schedule_kind, schedule_expr,
+			start_at, end_at, max_occurrences, insufficient_funds_policy, max_retries, retry_interval_seconds,
+			status, due_at, next_run_at, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $15, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	err := r.pool.QueryRow(ctx, query,
+		st.ID, st.FromAccountID, st.ToAccountID, st.Amount, st.Description, st.ScheduleKind, st.ScheduleExpr,
+		st.StartAt, st.EndAt, st.MaxOccurrences, st.InsufficientFundsPolicy, st.MaxRetries, st.RetryIntervalSeconds,
+		st.Status, st.DueAt,
+	).Scan(&st.CreatedAt, &st.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create scheduled transfer: %w", err)
+	}
+	st.NextRunAt = st.DueAt
+
+	return nil
+}
+
+func (r *Repository) GetScheduledTransfer(ctx context.Context, id string) (*ScheduledTransfer, error) {
+	query := `SELECT ` + scheduledTransferColumns + ` FROM scheduled_transfers WHERE id = $1`
+
+	st, err := scanScheduledTransfer(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", accountErrors.ErrScheduledTransferNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get scheduled transfer %s: %w", id, err)
+	}
+
+	return st, nil
+}
+
+// ListScheduledTransfers returns the schedules paid from accountID, newest
+// first, optionally restricted to one status.
+func (r *Repository) ListScheduledTransfers(ctx context.Context, accountID, status string) ([]ScheduledTransfer, error) {
+	query := `
+		SELECT ` + scheduledTransferColumns + `
+		FROM scheduled_transfers
+		WHERE from_account_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY created_at DESC, id
+	`
+
+	rows, err := r.pool.Query(ctx, query, accountID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled transfers: %w", err)
+	}
+	defer rows.Close()
+
+	var transfers []ScheduledTransfer
+	for rows.Next() {
+		st, err := scanScheduledTransfer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled transfer: %w", err)
+		}
+		transfers = append(transfers, *st)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read scheduled transfers: %w", err)
+	}
+
+	return transfers, nil
+}
+
+// SetScheduledTransferStatus moves a schedule to status if it is currently
+// in one of from, resetting the retry state and pointing it at dueAt. It
+// returns nil without an error if the schedule was not in any of from.
+func (r *Repository) SetScheduledTransferStatus(ctx context.Context, id string, from []string, status string, dueAt *time.Time) (*ScheduledTransfer, error) {
+	query := `
+		UPDATE scheduled_transfers
+		SET status = $3, due_at = $4, next_run_at = $4, attempts = 0, updated_at = NOW()
+		WHERE id = $1 AND status = ANY($2)
+		RETURNING ` + scheduledTransferColumns
+
+	st, err := scanScheduledTransfer(r.pool.QueryRow(ctx, query, id, from, status, dueAt))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to update scheduled transfer %s: %w", id, err)
+	}
+
+	return st, nil
+}
+
+// ClaimDueScheduledTransfers leases up to limit ACTIVE schedules whose next
+// run is due, so that concurrent workers never pick the same one. A lease
+// that is not released, because the worker died, expires after lease.
+func (r *Repository) ClaimDueScheduledTransfers(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]ScheduledTransfer, error) {
+	query := `
+		UPDATE scheduled_transfers
+		SET locked_until = $2
+		WHERE id IN (
+			SELECT id FROM scheduled_transfers
+			WHERE status = 'ACTIVE' AND next_run_at <= $1
+			  AND (locked_until IS NULL OR locked_until < $1)
+			ORDER BY next_run_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + scheduledTransferColumns
+
+	rows, err := r.pool.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim scheduled transfers: %w", err)
+	}
+	defer rows.Close()
+
+	var transfers []ScheduledTransfer
+	for rows.Next() {
+		st, err := scanScheduledTransfer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled transfer: %w", err)
+		}
+		transfers = append(transfers, *st)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read scheduled transfers: %w", err)
+	}
+
+	return transfers, nil
+}
+
+// DeferScheduledTransfer releases the lease on a schedule without settling
+// its current occurrence, to be attempted again at nextRunAt.
+func (r *Repository) DeferScheduledTransfer(ctx context.Context, id string, attempts int, nextRunAt time.Time, lastError string) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE scheduled_transfers
+		SET attempts = $2, next_run_at = $3, last_error = $4, locked_until = NULL, updated_at = NOW()
+		WHERE id = $1
+	`, id, attempts, nextRunAt, lastError)
+	if err != nil {
+		return fmt.Errorf("failed to defer scheduled transfer %s: %w", id, err)
+	}
+	return nil
+}
+
+// SettleScheduledTransferOccurrence records the outcome of an occurrence
+// and moves the schedule on to nextDue, completing it when nextDue is nil.
+// A schedule paused or cancelled while the occurrence ran keeps its status.
+func (r *Repository) SettleScheduledTransferOccurrence(ctx context.Context, run ScheduledTransferRun, nextDue *time.Time) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO scheduled_transfer_runs (schedule_id, occurrence, scheduled_for, status, transaction_id, attempts, error, executed_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, NULLIF($7, ''), NOW())
+		ON CONFLICT (schedule_id, occurrence) DO NOTHING
+	`, run.ScheduleID, run.Occurrence, run.ScheduledFor, run.Status, run.TransactionID, run.Attempts, run.Error)
+	if err != nil {
+		return fmt.Errorf("failed to record scheduled transfer run: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE scheduled_transfers
+		SET occurrences = $2,
+		    attempts = 0,
+		    last_error = NULLIF($3, ''),
+		    due_at = $4,
+		    next_run_at = CASE WHEN status IN ('ACTIVE', 'PAUSED') THEN $4 END,
+		    status = CASE WHEN status IN ('ACTIVE', 'PAUSED') AND $4::timestamp IS NULL THEN 'COMPLETED' ELSE status END,
+		    locked_until = NULL,
+		    updated_at = NOW()
+		WHERE id = $1
+	`, run.ScheduleID, run.Occurrence, run.Error, nextDue)
+	if err != nil {
+		return fmt.Errorf("failed to advance scheduled transfer %s: %w", run.ScheduleID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit scheduled transfer run: %w", err)
+	}
+
+	return nil
+}
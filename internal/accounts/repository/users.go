@@ -0,0 +1,270 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type User struct {
+	UserID    string
+	Email     string
+	Name      *string
+	Phone     *string
+	Address   *string
+	Locale    *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ProfileUpdate lists the profile fields to change. A nil field is left
+// alone and an empty one is cleared.
+type ProfileUpdate struct {
+	Name    *string
+	Phone   *string
+	Address *string
+	Locale  *string
+}
+
+type EmailChangeRequest struct {
+	ID        string
+	UserID    string
+	NewEmail  string
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+const userColumns = `user_id, email, name, phone, address, locale, created_at, updated_at`
+
+func scanUser(row pgx.Row) (*User, error) {
+	var user User
+	err := row.Scan(
+		&user.UserID,
+		&user.Email,
+		&user.Name,
+		&user.Phone,
+		&user.Address,
+		&user.Locale,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *Repository) GetUser(ctx context.Context, userID string) (*User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE user_id = $1`
+
+	user, err := scanUser(r.pool.QueryRow(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", accountErrors.ErrUserNotFound, userID)
+		}
+		return nil, fmt.Errorf("failed to get user %s: %w", userID, err)
+	}
+
+	return user, nil
+}
+
+// EmailInUse reports whether any user has email, ignoring case.
+func (r *Repository) EmailInUse(ctx context.Context, email string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM users WHERE LOWER(email) = LOWER($1))`
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, query, email).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check email: %w", err)
+	}
+
+	return exists, nil
+}
+
+// UpdateProfile applies update to userID and records every field that
+// actually changed in user_profile_changes.
+func (r *Repository) UpdateProfile(ctx context.Context, userID string, update ProfileUpdate) (*User, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	user, err := scanUser(tx.QueryRow(ctx, `SELECT `+userColumns+` FROM users WHERE user_id = $1 FOR UPDATE`, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", accountErrors.ErrUserNotFound, userID)
+		}
+		return nil, fmt.Errorf("failed to lock user %s: %w", userID, err)
+	}
+
+	fields := []struct {
+		name    string
+		current **string
+		value   *string
+	}{
+		{"name", &user.Name, update.Name},
+		{"phone", &user.Phone, update.Phone},
+		{"address", &user.Address, update.Address},
+		{"locale", &user.Locale, update.Locale},
+	}
+
+	changed := false
+	for _, f := range fields {
+		if f.value == nil {
+			continue
+		}
+		var value *string
+		if *f.value != "" {
+			value = f.value
+		}
+		if equalOptional(*f.current, value) {
+			continue
+		}
+		if err := recordProfileChange(ctx, tx, userID, f.name, *f.current, value); err != nil {
+			return nil, err
+		}
+		*f.current = value
+		changed = true
+	}
+
+	if !changed {
+		return user, nil
+	}
+
+	err = tx.QueryRow(ctx, `
+		UPDATE users
+		SET name = $2, phone = $3, address = $4, locale = $5, updated_at = NOW()
+		WHERE user_id = $1
+		RETURNING updated_at
+	`, userID, user.Name, user.Phone, user.Address, user.Locale).Scan(&user.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user %s: %w", userID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit profile of user %s: %w", userID, err)
+	}
+
+	r.logger.Infof("Updated profile of user %s", userID)
+	return user, nil
+}
+
+func (r *Repository) CreateEmailChangeRequest(ctx context.Context, req *EmailChangeRequest) error {
+	query := `
+		INSERT INTO email_change_requests (id, user_id, new_email, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+	`
+
+	if _, err := r.pool.Exec(ctx, query, req.ID, req.UserID, req.NewEmail, req.TokenHash, req.ExpiresAt); err != nil {
+		return fmt.Errorf("failed to create email change request: %w", err)
+	}
+
+	return nil
+}
+
+// ConfirmEmailChange moves the user of the unconfirmed, unexpired request
+// with tokenHash to its new email and returns the user and their previous
+// email. Other pending requests of the user are left to expire.
+func (r *Repository) ConfirmEmailChange(ctx context.Context, tokenHash string) (*User, string, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var req EmailChangeRequest
+	err = tx.QueryRow(ctx, `
+		SELECT id, user_id, new_email
+		FROM email_change_requests
+		WHERE token_hash = $1 AND confirmed_at IS NULL AND expires_at > NOW()
+		FOR UPDATE
+	`, tokenHash).Scan(&req.ID, &req.UserID, &req.NewEmail)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, "", accountErrors.ErrInvalidEmailChangeToken
+		}
+		return nil, "", fmt.Errorf("failed to look up email change request: %w", err)
+	}
+
+	var oldEmail string
+	err = tx.QueryRow(ctx, `SELECT email FROM users WHERE user_id = $1 FOR UPDATE`, req.UserID).Scan(&oldEmail)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to lock user %s: %w", req.UserID, err)
+	}
+	if err := claimEmail(ctx, tx, req.NewEmail, req.UserID); err != nil {
+		return nil, "", err
+	}
+
+	user, err := scanUser(tx.QueryRow(ctx, `
+		UPDATE users
+		SET email = $2, updated_at = NOW()
+		WHERE user_id = $1
+		RETURNING `+userColumns,
+		req.UserID, req.NewEmail))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, "", fmt.Errorf("%w: %s", accountErrors.ErrEmailAlreadyExists, req.NewEmail)
+		}
+		return nil, "", fmt.Errorf("failed to change email of user %s: %w", req.UserID, err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE email_change_requests SET confirmed_at = NOW() WHERE id = $1`, req.ID); err != nil {
+		return nil, "", fmt.Errorf("failed to confirm email change request %s: %w", req.ID, err)
+	}
+	if err := recordProfileChange(ctx, tx, req.UserID, "email", &oldEmail, &req.NewEmail); err != nil {
+		return nil, "", err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, "", fmt.Errorf("failed to commit email change: %w", err)
+	}
+
+	r.logger.Infof("Changed email of user %s", req.UserID)
+	return user, oldEmail, nil
+}
+
+// claimEmail fails if a user other than userID has email in any case. The
+// unique constraint on users.email only covers the exact case, so the check
+// holds a lock on the lowercased address until tx ends.
+func claimEmail(ctx context.Context, tx pgx.Tx, email, userID string) error {
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('users.email'), hashtext(LOWER($1)))`, email); err != nil {
+		return fmt.Errorf("failed to lock email: %w", err)
+	}
+
+	var taken bool
+	err := tx.QueryRow(ctx, `
+		SELECT EXISTS(SELECT 1 FROM users WHERE LOWER(email) = LOWER($1) AND user_id <> $2)
+	`, email, userID).Scan(&taken)
+	if err != nil {
+		return fmt.Errorf("failed to check email: %w", err)
+	}
+	if taken {
+		return fmt.Errorf("%w: %s", accountErrors.ErrEmailAlreadyExists, email)
+	}
+	return nil
+}
+
+func recordProfileChange(ctx context.Context, tx pgx.Tx, userID, field string, oldValue, newValue *string) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO user_profile_changes (user_id, field, old_value, new_value, changed_at)
+		VALUES ($1, $2, $3, $4, NOW())
+	`, userID, field, oldValue, newValue)
+	if err != nil {
+		return fmt.Errorf("failed to record %s change of user %s: %w", field, userID, err)
+	}
+	return nil
+}
+
+func equalOptional(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,422 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	WebhookPending   = "PENDING"
+	WebhookDelivered = "DELIVERED"
+	WebhookDead      = "DEAD"
+)
+
+type WebhookEndpoint struct {
+	ID          string
+	APIKeyID    string
+	URL         string
+	Secret      string
+	Events      []string
+	Description string
+	CreatedAt   time.Time
+}
+
+type WebhookDelivery struct {
+	ID             string
+	EndpointID     string
+	EventID        string
+	Event          string
+	Payload        []byte
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode *int
+	LastError      *string
+	ReplayOf       *string
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// DueWebhookDelivery is a claimed delivery with where to send it.
+type DueWebhookDelivery struct {
+	WebhookDelivery
+	URL    string
+	Secret string
+}
+
+type WebhookAttempt struct {
+	DeliveryID  string
+	Attempt     int
+	StatusCode  *int
+	Error       *string
+	Duration    time.Duration
+	AttemptedAt time.Time
+}
+
+// WebhookDeliveryFilter narrows a delivery listing. Zero values mean "no
+// constraint".
+type WebhookDeliveryFilter struct {
+	EndpointID string
+	Status     string
+}
+
+const webhookEndpointColumns = `id, api_key_id, url, secret, events, description, created_at`
+
+func scanWebhookEndpoint(row pgx.Row) (*WebhookEndpoint, error) {
+	var ep WebhookEndpoint
+	err := row.Scan(
+		&ep.ID,
+		&ep.APIKeyID,
+		&ep.URL,
+		&ep.Secret,
+		&ep.Events,
+		&ep.Description,
+		&ep.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &ep, nil
+}
+
+const webhookDeliveryColumns = `
+	d.id, d.endpoint_id, d.event_id, d.event, d.payload, d.status, d.attempts, d.next_attempt_at,
+	d.last_status_code, d.last_error, d.replay_of, d.created_at, d.delivered_at
+`
+
+func webhookDeliveryFields(d *WebhookDelivery) []any {
+	return []any{
+		&d.ID,
+		&d.EndpointID,
+		&d.EventID,
+		&d.Event,
+		&d.Payload,
+		&d.Status,
+		&d.Attempts,
+		&d.NextAttemptAt,
+		&d.LastStatusCode,
+		&d.LastError,
+		&d.ReplayOf,
+		&d.CreatedAt,
+		&d.DeliveredAt,
+	}
+}
+
+func (r *Repository) CreateWebhookEndpoint(ctx context.Context, ep *WebhookEndpoint) error {
+	query := `
+		INSERT INTO webhook_endpoints (id, api_key_id, url, secret, events, description, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		RETURNING created_at
+	`
+
+	err := r.pool.QueryRow(ctx, query, ep.ID, ep.APIKeyID, ep.URL, ep.Secret, ep.Events, ep.Description).Scan(&ep.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+
+	return nil
+}
+
+// GetWebhookEndpoint returns the endpoint id of apiKeyID unless it was
+// deleted.
+func (r *Repository) GetWebhookEndpoint(ctx context.Context, apiKeyID, id string) (*WebhookEndpoint, error) {
+	query := `
+		SELECT ` + webhookEndpointColumns + `
+		FROM webhook_endpoints
+		WHERE id = $1 AND api_key_id = $2 AND deleted_at IS NULL
+	`
+
+	ep, err := scanWebhookEndpoint(r.pool.QueryRow(ctx, query, id, apiKeyID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", accountErrors.ErrWebhookNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get webhook endpoint %s: %w", id, err)
+	}
+
+	return ep, nil
+}
+
+func (r *Repository) ListWebhookEndpoints(ctx context.Context, apiKeyID string) ([]WebhookEndpoint, error) {
+	query := `
+		SELECT ` + webhookEndpointColumns + `
+		FROM webhook_endpoints
+		WHERE api_key_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.pool.Query(ctx, query, apiKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	var endpoints []WebhookEndpoint
+	for rows.Next() {
+		ep, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook endpoint: %w", err)
+		}
+		endpoints = append(endpoints, *ep)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read webhook endpoints: %w", err)
+	}
+
+	return endpoints, nil
+}
+
+// DeleteWebhookEndpoint stops sending to the endpoint and gives up on its
+// pending deliveries. Its delivery logs are kept.
+func (r *Repository) DeleteWebhookEndpoint(ctx context.Context, apiKeyID, id string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE webhook_endpoints
+		SET deleted_at = NOW()
+		WHERE id = $1 AND api_key_id = $2 AND deleted_at IS NULL
+	`, id, apiKeyID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: %s", accountErrors.ErrWebhookNotFound, id)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE webhook_deliveries
+		SET status = 'DEAD', last_error = 'endpoint deleted', locked_until = NULL
+		WHERE endpoint_id = $1 AND status = 'PENDING'
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to cancel deliveries of webhook endpoint %s: %w", id, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit deletion of webhook endpoint %s: %w", id, err)
+	}
+
+	r.logger.Infof("Deleted webhook endpoint %s", id)
+	return nil
+}
+
+// EnqueueWebhookEvent queues payload for every endpoint subscribed to
+// event and returns how many deliveries were added. Queuing the same
+// eventID again adds nothing.
+func (r *Repository) EnqueueWebhookEvent(ctx context.Context, eventID, event string, payload []byte) (int, error) {
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO webhook_deliveries (id, endpoint_id, event_id, event, payload, status, next_attempt_at, created_at)
+		SELECT gen_random_uuid()::TEXT, id, $1, $2, $3, 'PENDING', NOW(), NOW()
+		FROM webhook_endpoints
+		WHERE deleted_at IS NULL AND $2 = ANY(events)
+		ON CONFLICT (endpoint_id, event_id) WHERE replay_of IS NULL DO NOTHING
+	`, eventID, event, payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enqueue %s webhooks: %w", event, err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// ClaimDueWebhookDeliveries leases up to limit pending deliveries due at
+// now, so that concurrent workers do not send them twice.
+func (r *Repository) ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]DueWebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries d
+		SET locked_until = $2
+		FROM webhook_endpoints e
+		WHERE e.id = d.endpoint_id AND d.id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'PENDING' AND next_attempt_at <= $1
+			  AND (locked_until IS NULL OR locked_until < $1)
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + webhookDeliveryColumns + `, e.url, e.secret`
+
+	rows, err := r.pool.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []DueWebhookDelivery
+	for rows.Next() {
+		var d DueWebhookDelivery
+		if err := rows.Scan(append(webhookDeliveryFields(&d.WebhookDelivery), &d.URL, &d.Secret)...); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// RecordWebhookAttempt logs attempt and moves its delivery on: to
+// DELIVERED if delivered, otherwise back to PENDING until nextAttemptAt,
+// or to DEAD when nextAttemptAt is nil.
+func (r *Repository) RecordWebhookAttempt(ctx context.Context, attempt *WebhookAttempt, delivered bool, nextAttemptAt *time.Time) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO webhook_delivery_attempts (delivery_id, attempt, status_code, error, duration_ms, attempted_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, attempt.DeliveryID, attempt.Attempt, attempt.StatusCode, attempt.Error,
+		attempt.Duration.Milliseconds(), attempt.AttemptedAt)
+	if err != nil {
+		return fmt.Errorf("failed to log attempt of webhook delivery %s: %w", attempt.DeliveryID, err)
+	}
+
+	status := WebhookDelivered
+	if !delivered {
+		status = WebhookDead
+		if nextAttemptAt != nil {
+			status = WebhookPending
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $2,
+		    attempts = $3,
+		    next_attempt_at = COALESCE($4, next_attempt_at),
+		    last_status_code = $5,
+		    last_error = $6,
+		    delivered_at = CASE WHEN $2 = 'DELIVERED' THEN $7::TIMESTAMP ELSE NULL END,
+		    locked_until = NULL
+		WHERE id = $1
+	`, attempt.DeliveryID, status, attempt.Attempt, nextAttemptAt, attempt.StatusCode, attempt.Error, attempt.AttemptedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery %s: %w", attempt.DeliveryID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit attempt of webhook delivery %s: %w", attempt.DeliveryID, err)
+	}
+
+	return nil
+}
+
+// GetWebhookDelivery returns a delivery to an endpoint of apiKeyID, deleted
+// or not, with its attempts in order.
+func (r *Repository) GetWebhookDelivery(ctx context.Context, apiKeyID, id string) (*WebhookDelivery, []WebhookAttempt, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries d
+		JOIN webhook_endpoints e ON e.id = d.endpoint_id
+		WHERE d.id = $1 AND e.api_key_id = $2
+	`
+
+	var d WebhookDelivery
+	if err := r.pool.QueryRow(ctx, query, id, apiKeyID).Scan(webhookDeliveryFields(&d)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, fmt.Errorf("%w: %s", accountErrors.ErrWebhookDeliveryNotFound, id)
+		}
+		return nil, nil, fmt.Errorf("failed to get webhook delivery %s: %w", id, err)
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT delivery_id, attempt, status_code, error, duration_ms, attempted_at
+		FROM webhook_delivery_attempts
+		WHERE delivery_id = $1
+		ORDER BY attempt, id
+	`, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list attempts of webhook delivery %s: %w", id, err)
+	}
+	defer rows.Close()
+
+	var attempts []WebhookAttempt
+	for rows.Next() {
+		var a WebhookAttempt
+		var durationMs int64
+		if err := rows.Scan(&a.DeliveryID, &a.Attempt, &a.StatusCode, &a.Error, &durationMs, &a.AttemptedAt); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan webhook attempt: %w", err)
+		}
+		a.Duration = time.Duration(durationMs) * time.Millisecond
+		attempts = append(attempts, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read webhook attempts: %w", err)
+	}
+
+	return &d, attempts, nil
+}
+
+// ListWebhookDeliveries returns a page of the deliveries to endpoints of
+// apiKeyID matching filter, newest first, and the total number.
+func (r *Repository) ListWebhookDeliveries(ctx context.Context, apiKeyID string, filter WebhookDeliveryFilter, page, pageSize int) ([]WebhookDelivery, int, error) {
+	where := `
+		FROM webhook_deliveries d
+		JOIN webhook_endpoints e ON e.id = d.endpoint_id
+		WHERE e.api_key_id = $1
+		  AND ($2 = '' OR d.endpoint_id = $2)
+		  AND ($3 = '' OR d.status = $3)
+	`
+	args := []any{apiKeyID, filter.EndpointID, filter.Status}
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	query := `SELECT ` + webhookDeliveryColumns + where + `
+		ORDER BY d.created_at DESC, d.id
+		LIMIT $4 OFFSET $5
+	`
+	rows, err := r.pool.Query(ctx, query, append(args, pageSize, (page-1)*pageSize)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []WebhookDelivery
+	for rows.Next() {
+		var d WebhookDelivery
+		if err := rows.Scan(webhookDeliveryFields(&d)...); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read webhook deliveries: %w", err)
+	}
+
+	return deliveries, total, nil
+}
+
+// ReplayWebhookDelivery queues the payload of original again as a new
+// delivery with id, due now.
+func (r *Repository) ReplayWebhookDelivery(ctx context.Context, original *WebhookDelivery, id string) (*WebhookDelivery, error) {
+	query := `
+		INSERT INTO webhook_deliveries AS d (id, endpoint_id, event_id, event, payload, status, next_attempt_at, replay_of, created_at)
+		VALUES ($1, $2, $3, $4, $5, 'PENDING', NOW(), $6, NOW())
+		RETURNING ` + webhookDeliveryColumns
+
+	var d WebhookDelivery
+	err := r.pool.QueryRow(ctx, query, id, original.EndpointID, original.EventID, original.Event, original.Payload, original.ID).
+		Scan(webhookDeliveryFields(&d)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay webhook delivery %s: %w", original.ID, err)
+	}
+
+	return &d, nil
+}
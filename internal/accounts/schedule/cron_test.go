@@ -0,0 +1,126 @@
+package schedule_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/schedule"
+)
+
+// occurrences returns up to n occurrences of s from start on, the first
+// one at or after start.
+func occurrences(s schedule.Schedule, start time.Time, n int) []time.Time {
+	var got []time.Time
+	at, ok := schedule.First(s, start)
+	for ok && len(got) < n {
+		got = append(got, at)
+		at, ok = s.Next(at)
+	}
+	return got
+}
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	at, err := time.Parse("2006-01-02T15:04", value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return at
+}
+
+func checkOccurrences(t *testing.T, kind, expr, start string, want []string) {
+	t.Helper()
+	from := mustTime(t, start)
+	s, err := schedule.Parse(kind, expr, from)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", expr, err)
+	}
+
+	got := occurrences(s, from, len(want)+1)
+	if len(got) > len(want) {
+		got = got[:len(want)]
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d occurrences %v, want %d", len(got), got, len(want))
+	}
+	for i, w := range want {
+		if !got[i].Equal(mustTime(t, w)) {
+			t.Errorf("occurrence %d = %s, want %s", i, got[i].Format("2006-01-02T15:04"), w)
+		}
+	}
+}
+
+func TestCronOccurrences(t *testing.T) {
+	// 2026-01-01 is a Thursday.
+	tests := []struct {
+		name  string
+		expr  string
+		start string
+		want  []string
+	}{
+		{"minute step", "*/15 * * * *", "2026-01-01T00:07", []string{"2026-01-01T00:15", "2026-01-01T00:30", "2026-01-01T00:45", "2026-01-01T01:00"}},
+		{"range with step", "0 0-12/6 * * *", "2026-01-01T00:00", []string{"2026-01-01T00:00", "2026-01-01T06:00", "2026-01-01T12:00", "2026-01-02T00:00"}},
+		{"single value with step", "0 20/2 * * *", "2026-01-01T00:00", []string{"2026-01-01T20:00", "2026-01-01T22:00", "2026-01-02T20:00"}},
+		{"list", "0,30 9 * * *", "2026-01-01T09:10", []string{"2026-01-01T09:30", "2026-01-02T09:00", "2026-01-02T09:30"}},
+		{"weekday names", "0 9 * * MON-FRI", "2026-01-02T10:00", []string{"2026-01-05T09:00", "2026-01-06T09:00", "2026-01-07T09:00"}},
+		{"month names", "0 0 1 JAN,jul *", "2026-01-01T00:00", []string{"2026-01-01T00:00", "2026-07-01T00:00", "2027-01-01T00:00"}},
+		{"sunday as 7", "0 0 * * 7", "2026-01-01T00:00", []string{"2026-01-04T00:00", "2026-01-11T00:00"}},
+		{"sunday as 0", "0 0 * * 0", "2026-01-01T00:00", []string{"2026-01-04T00:00", "2026-01-11T00:00"}},
+		{"day of month or weekday", "0 0 13 * FRI", "2026-01-01T00:00", []string{"2026-01-02T00:00", "2026-01-09T00:00", "2026-01-13T00:00", "2026-01-16T00:00"}},
+		{"day of month only", "0 0 13 * *", "2026-01-01T00:00", []string{"2026-01-13T00:00", "2026-02-13T00:00"}},
+		{"question mark", "0 0 ? * FRI", "2026-01-01T00:00", []string{"2026-01-02T00:00", "2026-01-09T00:00"}},
+		{"leap day", "0 0 29 2 *", "2026-01-01T00:00", []string{"2028-02-29T00:00", "2032-02-29T00:00"}},
+		{"descriptor", "@monthly", "2026-01-15T00:00", []string{"2026-02-01T00:00", "2026-03-01T00:00"}},
+		{"never", "0 0 30 2 *", "2026-01-01T00:00", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkOccurrences(t, schedule.KindCron, tt.expr, tt.start, tt.want)
+		})
+	}
+}
+
+func TestCronNextStartsAfterT(t *testing.T) {
+	start := mustTime(t, "2026-01-01T00:00")
+	s, err := schedule.Parse(schedule.KindCron, "0 * * * *", start)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := s.Next(mustTime(t, "2026-03-01T10:00"))
+	if !ok || !got.Equal(mustTime(t, "2026-03-01T11:00")) {
+		t.Errorf("Next = %s, %v, want 2026-03-01T11:00", got, ok)
+	}
+
+	got, ok = s.Next(mustTime(t, "2025-06-01T10:00"))
+	if !ok || !got.Equal(start) {
+		t.Errorf("Next before start = %s, %v, want the start", got, ok)
+	}
+}
+
+func TestCronInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * FOO *",
+		"x * * * *",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := schedule.Parse(schedule.KindCron, expr, time.Now()); !errors.Is(err, schedule.ErrInvalid) {
+				t.Errorf("Parse(%q) = %v, want ErrInvalid", expr, err)
+			}
+		})
+	}
+}
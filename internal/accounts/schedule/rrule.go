@@ -0,0 +1,196 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rrule is the subset of an RFC 5545 RRULE that recurring transfers need:
+// FREQ=DAILY|WEEKLY|MONTHLY with INTERVAL, BYDAY (plain weekdays),
+// BYMONTHDAY (-1 is the last day of the month), COUNT and UNTIL. The time
+// of day and the defaults for BYDAY and BYMONTHDAY come from the start,
+// which plays the role of DTSTART. Weeks start on Monday.
+type rrule struct {
+	freq       string
+	interval   int
+	byDay      map[time.Weekday]bool
+	byMonthDay []int
+	count      int
+	until      time.Time
+	start      time.Time
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday,
+	"FR": time.Friday, "SA": time.Saturday, "SU": time.Sunday,
+}
+
+func parseRRule(expr string, start time.Time) (*rrule, error) {
+	expr = strings.TrimPrefix(strings.TrimSpace(expr), "RRULE:")
+	r := &rrule{interval: 1, start: start}
+
+	for _, part := range strings.Split(expr, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: bad rule part %q", ErrInvalid, part)
+		}
+
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.freq = strings.ToUpper(value)
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("%w: bad INTERVAL %q", ErrInvalid, value)
+			}
+			r.interval = n
+		case "BYDAY":
+			r.byDay = make(map[time.Weekday]bool)
+			for _, d := range strings.Split(value, ",") {
+				wd, ok := rruleWeekdays[strings.ToUpper(d)]
+				if !ok {
+					return nil, fmt.Errorf("%w: bad BYDAY %q", ErrInvalid, d)
+				}
+				r.byDay[wd] = true
+			}
+		case "BYMONTHDAY":
+			for _, d := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(d)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("%w: bad BYMONTHDAY %q", ErrInvalid, d)
+				}
+				r.byMonthDay = append(r.byMonthDay, n)
+			}
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("%w: bad COUNT %q", ErrInvalid, value)
+			}
+			r.count = n
+		case "UNTIL":
+			until, err := parseUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			r.until = until
+		default:
+			return nil, fmt.Errorf("%w: unsupported rule part %s", ErrInvalid, key)
+		}
+	}
+
+	switch r.freq {
+	case "DAILY", "WEEKLY", "MONTHLY":
+	case "":
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalid)
+	default:
+		return nil, fmt.Errorf("%w: unsupported FREQ %s", ErrInvalid, r.freq)
+	}
+	if r.count > 0 && !r.until.IsZero() {
+		return nil, fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrInvalid)
+	}
+
+	return r, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			if layout == "20060102" {
+				t = t.Add(24*time.Hour - time.Nanosecond)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: bad UNTIL %q", ErrInvalid, value)
+}
+
+func (r *rrule) dayMatches(d time.Time) bool {
+	first := dateOf(r.start)
+
+	switch r.freq {
+	case "DAILY":
+		days := int(d.Sub(first).Hours() / 24)
+		if days%r.interval != 0 {
+			return false
+		}
+		return r.byDay == nil || r.byDay[d.Weekday()]
+
+	case "WEEKLY":
+		weeks := int(weekStart(d).Sub(weekStart(first)).Hours() / (24 * 7))
+		if weeks%r.interval != 0 {
+			return false
+		}
+		if r.byDay == nil {
+			return d.Weekday() == first.Weekday()
+		}
+		return r.byDay[d.Weekday()]
+
+	case "MONTHLY":
+		months := (d.Year()-first.Year())*12 + int(d.Month()) - int(first.Month())
+		if months%r.interval != 0 {
+			return false
+		}
+		if r.byDay != nil && !r.byDay[d.Weekday()] {
+			return false
+		}
+		if r.byMonthDay == nil {
+			return r.byDay != nil || d.Day() == first.Day()
+		}
+		last := daysIn(d.Year(), d.Month())
+		for _, md := range r.byMonthDay {
+			if md == d.Day() || (md < 0 && last+md+1 == d.Day()) {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+func weekStart(d time.Time) time.Time {
+	return d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+}
+
+func (r *rrule) Next(t time.Time) (time.Time, bool) {
+	t = t.UTC()
+	timeOfDay := r.start.Sub(dateOf(r.start))
+
+	// With COUNT every occurrence since the start has to be counted, so the
+	// walk cannot skip ahead to t. It still only looks searchHorizon past t.
+	day := dateOf(r.start)
+	from := day
+	if t.After(r.start) {
+		from = dateOf(t)
+		if r.count == 0 {
+			day = from
+		}
+	}
+
+	n := 0
+	limit := from.Add(searchHorizon)
+	for ; day.Before(limit); day = day.AddDate(0, 0, 1) {
+		if !r.dayMatches(day) {
+			continue
+		}
+		at := day.Add(timeOfDay)
+		if at.Before(r.start) {
+			continue
+		}
+		if !r.until.IsZero() && at.After(r.until) {
+			return time.Time{}, false
+		}
+		n++
+		if r.count > 0 && n > r.count {
+			return time.Time{}, false
+		}
+		if at.After(t) {
+			return at, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,98 @@
+package schedule_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/schedule"
+)
+
+func TestRRuleOccurrences(t *testing.T) {
+	// 2026-01-01 is a Thursday.
+	tests := []struct {
+		name  string
+		expr  string
+		start string
+		want  []string
+	}{
+		{"daily", "FREQ=DAILY", "2026-01-01T09:30", []string{"2026-01-01T09:30", "2026-01-02T09:30", "2026-01-03T09:30"}},
+		{"daily interval", "FREQ=DAILY;INTERVAL=2", "2026-01-01T09:30", []string{"2026-01-01T09:30", "2026-01-03T09:30", "2026-01-05T09:30"}},
+		{"daily on weekdays", "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", "2026-01-02T08:00", []string{"2026-01-02T08:00", "2026-01-05T08:00", "2026-01-06T08:00"}},
+		{"weekly on start weekday", "FREQ=WEEKLY", "2026-01-01T12:00", []string{"2026-01-01T12:00", "2026-01-08T12:00", "2026-01-15T12:00"}},
+		{"weekly by day", "FREQ=WEEKLY;BYDAY=MO,WE", "2026-01-01T12:00", []string{"2026-01-05T12:00", "2026-01-07T12:00", "2026-01-12T12:00"}},
+		{"weekly interval", "FREQ=WEEKLY;INTERVAL=2", "2026-01-01T12:00", []string{"2026-01-01T12:00", "2026-01-15T12:00", "2026-01-29T12:00"}},
+		{"weekly interval by day", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR", "2026-01-01T12:00", []string{"2026-01-02T12:00", "2026-01-12T12:00", "2026-01-16T12:00"}},
+		{"monthly on start day", "FREQ=MONTHLY", "2026-01-10T00:00", []string{"2026-01-10T00:00", "2026-02-10T00:00", "2026-03-10T00:00"}},
+		{"monthly skips short months", "FREQ=MONTHLY", "2026-01-31T00:00", []string{"2026-01-31T00:00", "2026-03-31T00:00", "2026-05-31T00:00"}},
+		{"monthly interval", "FREQ=MONTHLY;INTERVAL=3", "2026-01-10T00:00", []string{"2026-01-10T00:00", "2026-04-10T00:00", "2026-07-10T00:00"}},
+		{"last day of month", "FREQ=MONTHLY;BYMONTHDAY=-1", "2026-01-15T00:00", []string{"2026-01-31T00:00", "2026-02-28T00:00", "2026-03-31T00:00", "2026-04-30T00:00"}},
+		{"month days", "FREQ=MONTHLY;BYMONTHDAY=1,15", "2026-01-10T00:00", []string{"2026-01-15T00:00", "2026-02-01T00:00", "2026-02-15T00:00"}},
+		{"friday the 13th", "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13", "2026-01-01T00:00", []string{"2026-02-13T00:00", "2026-03-13T00:00", "2026-11-13T00:00"}},
+		{"count", "FREQ=DAILY;COUNT=3", "2026-01-01T09:00", []string{"2026-01-01T09:00", "2026-01-02T09:00", "2026-01-03T09:00"}},
+		{"until date", "FREQ=DAILY;UNTIL=20260103", "2026-01-01T09:00", []string{"2026-01-01T09:00", "2026-01-02T09:00", "2026-01-03T09:00"}},
+		{"until time", "FREQ=DAILY;UNTIL=20260103T080000Z", "2026-01-01T09:00", []string{"2026-01-01T09:00", "2026-01-02T09:00"}},
+		{"prefix", "RRULE:FREQ=WEEKLY", "2026-01-01T12:00", []string{"2026-01-01T12:00", "2026-01-08T12:00"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkOccurrences(t, schedule.KindRRule, tt.expr, tt.start, tt.want)
+		})
+	}
+}
+
+func TestRRuleCountBeyondSearchHorizon(t *testing.T) {
+	start := mustTime(t, "2026-01-01T00:00")
+	s, err := schedule.Parse(schedule.KindRRule, "FREQ=MONTHLY;COUNT=100", start)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := occurrences(s, start, 101)
+	if len(got) != 100 {
+		t.Fatalf("got %d occurrences, want 100", len(got))
+	}
+	if last := start.AddDate(0, 99, 0); !got[99].Equal(last) {
+		t.Errorf("last occurrence = %s, want %s", got[99], last)
+	}
+}
+
+func TestRRuleNextSkipsAheadToT(t *testing.T) {
+	start := mustTime(t, "2026-01-01T09:00")
+	s, err := schedule.Parse(schedule.KindRRule, "FREQ=WEEKLY;BYDAY=MO", start)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Well past the search horizon from the start.
+	got, ok := s.Next(mustTime(t, "2040-01-01T00:00"))
+	if !ok || !got.Equal(mustTime(t, "2040-01-02T09:00")) {
+		t.Errorf("Next = %s, %v, want 2040-01-02T09:00", got, ok)
+	}
+}
+
+func TestRRuleInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=YEARLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;BYDAY=XX",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;UNTIL=tomorrow",
+		"FREQ=DAILY;COUNT=2;UNTIL=20260101",
+		"FREQ=DAILY;BYHOUR=9",
+		"FREQ",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := schedule.Parse(schedule.KindRRule, expr, time.Now()); !errors.Is(err, schedule.ErrInvalid) {
+				t.Errorf("Parse(%q) = %v, want ErrInvalid", expr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Package schedule computes the occurrences of one-off and recurring
+// schedules. All times are UTC with minute precision.
+package schedule
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	KindOnce  = "ONCE"
+	KindCron  = "CRON"
+	KindRRule = "RRULE"
+)
+
+var ErrInvalid = errors.New("invalid schedule")
+
+// searchHorizon bounds how far ahead Next looks for an occurrence, so that
+// expressions that can never match (30 February) terminate.
+const searchHorizon = 5 * 366 * 24 * time.Hour
+
+type Schedule interface {
+	// Next returns the first occurrence strictly after t, or false if there
+	// is none.
+	Next(t time.Time) (time.Time, bool)
+}
+
+// Parse builds the schedule of kind from expr. No occurrence is ever
+// earlier than start, which is also the only occurrence of a ONCE schedule
+// and the anchor (time of day, weekday, interval) of an RRULE.
+func Parse(kind, expr string, start time.Time) (Schedule, error) {
+	start = start.UTC().Truncate(time.Minute)
+
+	switch kind {
+	case KindOnce:
+		return once{at: start}, nil
+	case KindCron:
+		return parseCron(expr, start)
+	case KindRRule:
+		return parseRRule(expr, start)
+	}
+	return nil, errors.New("unknown schedule kind " + kind)
+}
+
+// First returns the first occurrence at or after start.
+func First(s Schedule, start time.Time) (time.Time, bool) {
+	return s.Next(start.UTC().Truncate(time.Minute).Add(-time.Nanosecond))
+}
+
+type once struct {
+	at time.Time
+}
+
+func (o once) Next(t time.Time) (time.Time, bool) {
+	if o.at.After(t) {
+		return o.at, true
+	}
+	return time.Time{}, false
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,393 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/interest"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
+	pbSub "github.com/ChotongW/grit_demo_wallet/pb/subledger"
+
+	"github.com/shopspring/decimal"
+)
+
+type AccrualResult struct {
+	Date          time.Time
+	AccountsCount int
+	TotalAccrued  decimal.Decimal
+	PostedAmount  decimal.Decimal
+	TransactionID string
+	AlreadyRun    bool
+}
+
+type PayoutResult struct {
+	Period        string
+	AccountsCount int
+	AccruedAmount decimal.Decimal
+	PaidAmount    decimal.Decimal
+	TransactionID string
+	AlreadyRun    bool
+}
+
+// AccrueInterest accrues one day of interest on the end-of-day balance of
+// every account whose tier has a rate. Accruals are kept at the rate's
+// accrual scale; their total, rounded to cents, is posted from
+// INTEREST_EXPENSE to INTEREST_PAYABLE.
+//
+// Running the same date again returns the recorded run. A run that was
+// recorded but not posted is resumed, and the ledger is checked for its
+// reference first so the posting is never duplicated.
+func (s *Service) AccrueInterest(ctx context.Context, date time.Time) (*AccrualResult, error) {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if !date.Before(today) {
+		return nil, fmt.Errorf("%w: %s has not ended yet", accountErrors.ErrInvalidAccrualDate, date.Format(time.DateOnly))
+	}
+
+	run, err := s.repo.GetInterestAccrualRun(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	if run != nil && run.Status == repository.RunStatusPosted {
+		return accrualResult(run, true), nil
+	}
+
+	if run == nil {
+		payout, err := s.repo.GetInterestPayout(ctx, date.Format("2006-01"))
+		if err != nil {
+			return nil, err
+		}
+		if payout != nil {
+			return nil, fmt.Errorf("%w: interest for %s has already been paid out", accountErrors.ErrInvalidAccrualDate, payout.Period)
+		}
+
+		run, err = s.recordAccrualRun(ctx, date)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	transactionID, err := s.postInterest(ctx, accrualReference(date),
+		fmt.Sprintf("Interest accrual for %s", date.Format(time.DateOnly)),
+		func() ([]*pbSub.Entry, error) {
+			return s.accrualEntries(ctx, run.PostedAmount)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.MarkInterestAccrualRunPosted(ctx, date, transactionID); err != nil {
+		return nil, err
+	}
+	run.TransactionID = &transactionID
+
+	s.logger.Infof("Accrued interest for %s: %d accounts, %s posted", date.Format(time.DateOnly), run.AccountsCount, run.PostedAmount.String())
+	return accrualResult(run, false), nil
+}
+
+func (s *Service) recordAccrualRun(ctx context.Context, date time.Time) (*repository.InterestAccrualRun, error) {
+	rates, err := s.repo.ListInterestRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byTier := make(map[string]interest.Rate, len(rates))
+	tiers := make([]string, 0, len(rates))
+	for _, rate := range rates {
+		byTier[rate.Tier] = rate
+		tiers = append(tiers, rate.Tier)
+	}
+
+	endOfDay := date.AddDate(0, 0, 1)
+	accounts, err := s.repo.ListUserAccountsByTier(ctx, tiers, endOfDay)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &repository.InterestAccrualRun{
+		Date:         date,
+		Status:       repository.RunStatusPending,
+		TotalAccrued: decimal.Zero,
+	}
+
+	var accountIDs []string
+	for _, tier := range tiers {
+		accountIDs = append(accountIDs, accounts[tier]...)
+	}
+	balances, err := s.balancesAt(ctx, accountIDs, endOfDay)
+	if err != nil {
+		return nil, err
+	}
+
+	var accruals []repository.InterestAccrual
+	for _, tier := range tiers {
+		rate := byTier[tier]
+		for _, accountID := range accounts[tier] {
+			balance := balances[accountID]
+			amount := rate.Daily(balance, date)
+			if !amount.IsPositive() {
+				continue
+			}
+
+			accruals = append(accruals, repository.InterestAccrual{
+				AccountID:  accountID,
+				Date:       date,
+				Balance:    balance,
+				AnnualRate: rate.AnnualRate,
+				DayCount:   rate.DayCount,
+				Amount:     amount,
+			})
+			run.TotalAccrued = run.TotalAccrued.Add(amount)
+		}
+	}
+
+	run.AccountsCount = len(accruals)
+	run.PostedAmount = interest.Round(run.TotalAccrued, interest.RoundHalfEven, interest.PayoutScale)
+
+	if err := s.repo.CreateInterestAccrualRun(ctx, run, accruals); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+func (s *Service) accrualEntries(ctx context.Context, amount decimal.Decimal) ([]*pbSub.Entry, error) {
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+
+	expenseAccount, err := s.systemAccount(ctx, RoleInterestExpense)
+	if err != nil {
+		return nil, err
+	}
+	payableAccount, err := s.systemAccount(ctx, RoleInterestPayable)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*pbSub.Entry{
+		{AccountId: expenseAccount, Amount: amount.String(), Direction: "DEBIT"},
+		{AccountId: payableAccount, Amount: amount.String(), Direction: "CREDIT"},
+	}, nil
+}
+
+// PayOutInterest pays every account the interest it accrued during period
+// (YYYY-MM), rounded to cents with its tier's rounding mode, out of
+// INTEREST_PAYABLE. The difference between the cents paid and the cents
+// accrued is booked to INTEREST_EXPENSE. Re-running a period is safe in the
+// same way as AccrueInterest.
+func (s *Service) PayOutInterest(ctx context.Context, period string) (*PayoutResult, error) {
+	start, err := time.Parse("2006-01", period)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", accountErrors.ErrInvalidInterestPeriod, period)
+	}
+	end := start.AddDate(0, 1, 0)
+	if end.After(time.Now().UTC()) {
+		return nil, fmt.Errorf("%w: %s has not ended yet", accountErrors.ErrInvalidInterestPeriod, period)
+	}
+
+	payout, err := s.repo.GetInterestPayout(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+	if payout != nil && payout.Status == repository.RunStatusPosted {
+		return payoutResult(payout, true), nil
+	}
+
+	if payout == nil {
+		payout, err = s.recordPayout(ctx, period, start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	transactionID, err := s.postInterest(ctx, payoutReference(period),
+		fmt.Sprintf("Interest payout for %s", period),
+		func() ([]*pbSub.Entry, error) {
+			return s.payoutEntries(ctx, payout)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.MarkInterestPayoutPosted(ctx, period, transactionID); err != nil {
+		return nil, err
+	}
+	payout.TransactionID = &transactionID
+
+	s.logger.Infof("Paid out interest for %s: %d accounts, %s paid", period, len(payout.Lines), payout.PaidAmount.String())
+	return payoutResult(payout, false), nil
+}
+
+func (s *Service) recordPayout(ctx context.Context, period string, start, end time.Time) (*repository.InterestPayout, error) {
+	pending, err := s.repo.CountPendingInterestAccrualRuns(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if pending > 0 {
+		return nil, fmt.Errorf("%w: %d accrual runs in %s are not posted", accountErrors.ErrInterestRunPending, pending, period)
+	}
+
+	accrued, posted, err := s.repo.SumUnpaidInterest(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := s.repo.ListInterestRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	modes := make(map[string]string, len(rates))
+	for _, rate := range rates {
+		modes[rate.Tier] = rate.RoundingMode
+	}
+
+	accountIDs := make([]string, 0, len(accrued))
+	for accountID := range accrued {
+		accountIDs = append(accountIDs, accountID)
+	}
+	sort.Strings(accountIDs)
+
+	accounts, err := s.repo.GetAccounts(ctx, accountIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	payout := &repository.InterestPayout{
+		Period:        period,
+		Status:        repository.RunStatusPending,
+		AccruedAmount: posted,
+		PaidAmount:    decimal.Zero,
+	}
+	for _, accountID := range accountIDs {
+		mode := interest.RoundHalfEven
+		if account, ok := accounts[accountID]; ok {
+			if m, ok := modes[account.Tier]; ok {
+				mode = m
+			}
+		}
+
+		amount := interest.Round(accrued[accountID], mode, interest.PayoutScale)
+		if !amount.IsPositive() {
+			continue
+		}
+		payout.Lines = append(payout.Lines, repository.InterestPayoutLine{AccountID: accountID, Amount: amount})
+		payout.PaidAmount = payout.PaidAmount.Add(amount)
+	}
+
+	if err := s.repo.CreateInterestPayout(ctx, payout, start, end); err != nil {
+		return nil, err
+	}
+
+	return payout, nil
+}
+
+// payoutEntries debits INTEREST_PAYABLE by what the accrual runs credited to
+// it and credits each account its payout. Rounding leaves a residue of a few
+// cents, which INTEREST_EXPENSE absorbs in either direction.
+func (s *Service) payoutEntries(ctx context.Context, payout *repository.InterestPayout) ([]*pbSub.Entry, error) {
+	if len(payout.Lines) == 0 && payout.AccruedAmount.IsZero() {
+		return nil, nil
+	}
+
+	expenseAccount, err := s.systemAccount(ctx, RoleInterestExpense)
+	if err != nil {
+		return nil, err
+	}
+	payableAccount, err := s.systemAccount(ctx, RoleInterestPayable)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*pbSub.Entry
+	if payout.AccruedAmount.IsPositive() {
+		entries = append(entries, &pbSub.Entry{AccountId: payableAccount, Amount: payout.AccruedAmount.String(), Direction: "DEBIT"})
+	}
+
+	residue := payout.PaidAmount.Sub(payout.AccruedAmount)
+	if residue.IsPositive() {
+		entries = append(entries, &pbSub.Entry{AccountId: expenseAccount, Amount: residue.String(), Direction: "DEBIT"})
+	} else if residue.IsNegative() {
+		entries = append(entries, &pbSub.Entry{AccountId: expenseAccount, Amount: residue.Neg().String(), Direction: "CREDIT"})
+	}
+
+	for _, line := range payout.Lines {
+		entries = append(entries, &pbSub.Entry{AccountId: line.AccountID, Amount: line.Amount.String(), Direction: "CREDIT"})
+	}
+
+	return entries, nil
+}
+
+// postInterest posts the entries built by build under referenceID unless a
+// transaction with that reference already exists, and returns the id of
+// the transaction. Nothing is posted, and the id is empty, when build
+// returns no entries.
+func (s *Service) postInterest(ctx context.Context, referenceID, description string, build func() ([]*pbSub.Entry, error)) (string, error) {
+	existing, err := s.transactionByReference(ctx, referenceID)
+	if err != nil {
+		return "", err
+	}
+	if existing != "" {
+		return existing, nil
+	}
+
+	entries, err := build()
+	if err != nil {
+		return "", err
+	}
+	if len(entries) == 0 {
+		return "", nil
+	}
+
+	req := &pbSub.CreateTransactionRequest{
+		ReferenceId: referenceID,
+		Description: description,
+		Entries:     entries,
+	}
+	resp, err := s.subledgerClient.CreateTransaction(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to post %s: %w", referenceID, err)
+	}
+	s.publishPosted(ctx, resp.TransactionId, req)
+
+	return resp.TransactionId, nil
+}
+
+func accrualReference(date time.Time) string {
+	return "interest-accrual-" + date.Format(time.DateOnly)
+}
+
+func payoutReference(period string) string {
+	return "interest-payout-" + period
+}
+
+func accrualResult(run *repository.InterestAccrualRun, alreadyRun bool) *AccrualResult {
+	result := &AccrualResult{
+		Date:          run.Date,
+		AccountsCount: run.AccountsCount,
+		TotalAccrued:  run.TotalAccrued,
+		PostedAmount:  run.PostedAmount,
+		AlreadyRun:    alreadyRun,
+	}
+	if run.TransactionID != nil {
+		result.TransactionID = *run.TransactionID
+	}
+	return result
+}
+
+func payoutResult(payout *repository.InterestPayout, alreadyRun bool) *PayoutResult {
+	result := &PayoutResult{
+		Period:        payout.Period,
+		AccountsCount: len(payout.Lines),
+		AccruedAmount: payout.AccruedAmount,
+		PaidAmount:    payout.PaidAmount,
+		AlreadyRun:    alreadyRun,
+	}
+	if payout.TransactionID != nil {
+		result.TransactionID = *payout.TransactionID
+	}
+	return result
+}
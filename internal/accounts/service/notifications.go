@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/notifications"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/notifier"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	notificationLease       = time.Minute
+	notificationBatchSize   = 100
+	maxNotificationAttempts = 6
+	notificationRetryDelay  = 30 * time.Second
+	maxNotificationDelay    = time.Hour
+)
+
+// movement is money posted to or from one wallet, as users are told
+// about it.
+type movement struct {
+	event         string
+	accountID     string
+	transactionID string
+	amount        decimal.Decimal
+	fee           decimal.Decimal
+	counterparty  string
+}
+
+// notifyMovement queues notifications about m for the wallet's owner on
+// each channel they chose, and a low balance alert if a debit took the
+// wallet below their threshold. Failures are logged rather than returned:
+// the money has already moved and the caller must still report success.
+func (s *Service) notifyMovement(ctx context.Context, m movement) {
+	if err := s.enqueueMovement(ctx, m); err != nil {
+		s.logger.Errorf("Failed to queue %s notification for transaction %s: %v", m.event, m.transactionID, err)
+	}
+}
+
+func (s *Service) enqueueMovement(ctx context.Context, m movement) error {
+	account, err := s.repo.GetAccount(ctx, m.accountID)
+	if err != nil {
+		return err
+	}
+	if account.AccountType != "USER" || account.UserID == nil {
+		return nil
+	}
+
+	user, err := s.repo.GetUser(ctx, *account.UserID)
+	if err != nil {
+		return err
+	}
+	prefs, err := s.repo.GetNotificationPreferences(ctx, user.UserID)
+	if err != nil {
+		return err
+	}
+
+	balance, err := s.balance(ctx, account.AccountID)
+	if err != nil {
+		return err
+	}
+
+	data := notifications.Data{
+		WalletName:    account.Name,
+		Amount:        m.amount.StringFixed(2),
+		Balance:       balance.StringFixed(2),
+		Counterparty:  m.counterparty,
+		Currency:      account.Currency,
+		TransactionID: m.transactionID,
+	}
+	if m.fee.IsPositive() {
+		data.Fee = m.fee.StringFixed(2)
+	}
+
+	events := []string{m.event}
+	debit := m.event == notifications.EventWithdrawal || m.event == notifications.EventTransferOut
+	if debit && prefs.LowBalanceThreshold != nil {
+		threshold := *prefs.LowBalanceThreshold
+		before := balance.Add(m.amount).Add(m.fee)
+		if balance.LessThan(threshold) && !before.LessThan(threshold) {
+			events = append(events, notifications.EventLowBalance)
+			data.Threshold = threshold.StringFixed(2)
+		}
+	}
+
+	var queued []repository.Notification
+	for _, event := range events {
+		subject, body, err := notifications.Render(event, data)
+		if err != nil {
+			return err
+		}
+		for _, channel := range prefs.Channels {
+			recipient := notificationRecipient(channel, user, prefs)
+			if recipient == "" {
+				continue
+			}
+			queued = append(queued, repository.Notification{
+				ID:            uuid.New().String(),
+				UserID:        user.UserID,
+				AccountID:     account.AccountID,
+				Event:         event,
+				Channel:       channel,
+				Recipient:     recipient,
+				TransactionID: m.transactionID,
+				Subject:       subject,
+				Body:          body,
+			})
+		}
+	}
+	if len(queued) == 0 {
+		return nil
+	}
+
+	_, err = s.repo.EnqueueNotifications(ctx, queued)
+	return err
+}
+
+// notificationRecipient is the address of user on channel, or an empty
+// string if they have none.
+func notificationRecipient(channel string, user *repository.User, prefs *repository.NotificationPreferences) string {
+	switch channel {
+	case notifier.ChannelEmail:
+		return user.Email
+	case notifier.ChannelSMS:
+		if user.Phone != nil {
+			return *user.Phone
+		}
+	case notifier.ChannelPush:
+		if prefs.PushToken != nil {
+			return *prefs.PushToken
+		}
+	}
+	return ""
+}
+
+// RunNotifier delivers queued notifications every interval until ctx is
+// cancelled.
+func (s *Service) RunNotifier(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.DeliverDueNotifications(ctx, time.Now().UTC()); err != nil && ctx.Err() == nil {
+			s.logger.Errorf("failed to deliver notifications: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverDueNotifications sends one batch of notifications due at now and
+// returns how many it picked up. A failed delivery is retried with
+// exponential backoff and given up on after maxNotificationAttempts.
+func (s *Service) DeliverDueNotifications(ctx context.Context, now time.Time) (int, error) {
+	due, err := s.repo.ClaimDueNotifications(ctx, now, notificationLease, notificationBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range due {
+		n := &due[i]
+		attempts := n.Attempts + 1
+
+		sendErr := s.channels.Notify(ctx, n.Channel, notifier.Message{
+			To:      n.Recipient,
+			Subject: n.Subject,
+			Body:    n.Body,
+		})
+		if sendErr == nil {
+			err = s.repo.MarkNotificationSent(ctx, n.ID, attempts)
+		} else {
+			var retryAt *time.Time
+			if attempts < maxNotificationAttempts {
+				at := now.Add(notificationBackoff(attempts))
+				retryAt = &at
+			}
+			s.logger.Warnf("Notification %s attempt %d failed: %v", n.ID, attempts, sendErr)
+			err = s.repo.DeferNotification(ctx, n.ID, attempts, retryAt, sendErr.Error())
+		}
+		if err != nil {
+			// The lease expires and the notification is picked up again.
+			s.logger.Errorf("failed to record delivery of notification %s: %v", n.ID, err)
+		}
+	}
+
+	return len(due), nil
+}
+
+func notificationBackoff(attempts int) time.Duration {
+	delay := notificationRetryDelay << (attempts - 1)
+	if delay <= 0 || delay > maxNotificationDelay {
+		return maxNotificationDelay
+	}
+	return delay
+}
+
+func (s *Service) GetNotificationPreferences(ctx context.Context, userID string) (*repository.NotificationPreferences, error) {
+	if _, err := s.repo.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetNotificationPreferences(ctx, userID)
+}
+
+// SetNotificationPreferences replaces the preferences of prefs.UserID. SMS
+// needs a phone number on the profile and push a device token.
+func (s *Service) SetNotificationPreferences(ctx context.Context, prefs *repository.NotificationPreferences) (*repository.NotificationPreferences, error) {
+	user, err := s.repo.GetUser(ctx, prefs.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if prefs.PushToken != nil && strings.TrimSpace(*prefs.PushToken) == "" {
+		prefs.PushToken = nil
+	}
+
+	channels := make([]string, 0, len(prefs.Channels))
+	seen := make(map[string]bool)
+	for _, channel := range prefs.Channels {
+		channel = strings.ToUpper(strings.TrimSpace(channel))
+		if !notifier.IsChannel(channel) {
+			return nil, fmt.Errorf("%w: unknown channel %q", accountErrors.ErrInvalidNotificationChannel, channel)
+		}
+		if seen[channel] {
+			continue
+		}
+		seen[channel] = true
+		channels = append(channels, channel)
+	}
+	if seen[notifier.ChannelSMS] && user.Phone == nil {
+		return nil, fmt.Errorf("%w: add a phone number to the profile first", accountErrors.ErrInvalidNotificationChannel)
+	}
+	if seen[notifier.ChannelPush] && prefs.PushToken == nil {
+		return nil, fmt.Errorf("%w: push needs a push_token", accountErrors.ErrInvalidNotificationChannel)
+	}
+	prefs.Channels = channels
+
+	if prefs.LowBalanceThreshold != nil && !prefs.LowBalanceThreshold.IsPositive() {
+		return nil, fmt.Errorf("%w: %s", accountErrors.ErrInvalidLowBalanceThreshold, prefs.LowBalanceThreshold.String())
+	}
+
+	if err := s.repo.SetNotificationPreferences(ctx, prefs); err != nil {
+		return nil, err
+	}
+
+	s.logger.Infof("Notification preferences of user %s set to %v", prefs.UserID, prefs.Channels)
+	return prefs, nil
+}
+
+func (s *Service) ListNotifications(ctx context.Context, userID string, page, pageSize int) ([]repository.Notification, int, error) {
+	if _, err := s.repo.GetUser(ctx, userID); err != nil {
+		return nil, 0, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return s.repo.ListNotifications(ctx, userID, page, pageSize)
+}
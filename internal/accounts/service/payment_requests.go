@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/fees"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultPaymentRequestExpiry = 7 * 24 * time.Hour
+	maxPaymentRequestExpiry     = 30 * 24 * time.Hour
+)
+
+// AcceptedPaymentRequest is an accepted request together with the transfer
+// that paid it.
+type AcceptedPaymentRequest struct {
+	Request    *repository.PaymentRequest
+	NewBalance decimal.Decimal
+	Fee        *fees.Quote
+}
+
+// CreatePaymentRequest asks payerAccountID to pay amount to
+// requesterAccountID. A zero expiresAt defaults to a week from now.
+func (s *Service) CreatePaymentRequest(ctx context.Context, requesterAccountID, payerAccountID string, amount decimal.Decimal, memo string, expiresAt time.Time) (*repository.PaymentRequest, error) {
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return nil, accountErrors.ErrTransferAmountMustBePositive
+	}
+	if requesterAccountID == payerAccountID {
+		return nil, accountErrors.ErrPaymentRequestToSelf
+	}
+
+	now := time.Now().UTC()
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(defaultPaymentRequestExpiry)
+	}
+	if !expiresAt.After(now) {
+		return nil, fmt.Errorf("%w: expires_at is in the past", accountErrors.ErrInvalidPaymentRequestExpiry)
+	}
+	if expiresAt.Sub(now) > maxPaymentRequestExpiry {
+		return nil, fmt.Errorf("%w: requests expire within %s", accountErrors.ErrInvalidPaymentRequestExpiry, maxPaymentRequestExpiry)
+	}
+
+	for _, accountID := range []string{requesterAccountID, payerAccountID} {
+		exists, err := s.repo.AccountExists(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: %s", accountErrors.ErrAccountNotFound, accountID)
+		}
+	}
+
+	pr := &repository.PaymentRequest{
+		ID:                 uuid.New().String(),
+		RequesterAccountID: requesterAccountID,
+		PayerAccountID:     payerAccountID,
+		Amount:             amount,
+		Memo:               memo,
+		ExpiresAt:          expiresAt.UTC(),
+	}
+	if err := s.repo.CreatePaymentRequest(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	s.logger.Infof("Payment request %s: %s asked %s for %s", pr.ID, requesterAccountID, payerAccountID, amount.String())
+	return pr, nil
+}
+
+const (
+	DirectionIncoming = "INCOMING"
+	DirectionOutgoing = "OUTGOING"
+)
+
+// ListPaymentRequests lists the requests accountID has to pay (INCOMING,
+// the default) or has made (OUTGOING).
+func (s *Service) ListPaymentRequests(ctx context.Context, accountID, direction, status string, page, pageSize int) ([]repository.PaymentRequest, int, error) {
+	direction = strings.ToUpper(direction)
+	if direction == "" {
+		direction = DirectionIncoming
+	}
+	if direction != DirectionIncoming && direction != DirectionOutgoing {
+		return nil, 0, fmt.Errorf("%w: %q", accountErrors.ErrInvalidPaymentRequestFilter, direction)
+	}
+
+	exists, err := s.repo.AccountExists(ctx, accountID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !exists {
+		return nil, 0, fmt.Errorf("%w: %s", accountErrors.ErrAccountNotFound, accountID)
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return s.repo.ListPaymentRequests(ctx, accountID, direction == DirectionIncoming, strings.ToUpper(status), page, pageSize)
+}
+
+// paymentRequestClaimTTL is how long accepting a request keeps it from
+// being declined or cancelled. It comfortably outlasts a transfer.
+const paymentRequestClaimTTL = 2 * time.Minute
+
+// AcceptPaymentRequest pays a pending request from the payer's account. The
+// request is claimed, rather than locked, while the transfer is posted, and
+// the transfer carries a reference derived from the request, so the request
+// is paid at most once even if accepting is retried after a failure between
+// posting and recording it.
+func (s *Service) AcceptPaymentRequest(ctx context.Context, payerAccountID, id string) (*AcceptedPaymentRequest, error) {
+	pr, err := s.repo.ClaimPaymentRequest(ctx, id, payerAccountID, paymentRequestClaimTTL)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		current, err := s.repo.GetPaymentRequest(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if current.PayerAccountID != payerAccountID {
+			return nil, fmt.Errorf("%w: %s", accountErrors.ErrPaymentRequestNotFound, id)
+		}
+		if err := checkPending(current); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s is already being accepted", accountErrors.ErrPaymentRequestNotPending, id)
+	}
+
+	accepted, err := s.payPaymentRequest(ctx, pr)
+	if err != nil {
+		// A transfer that did post is found by its reference by whoever
+		// touches the request next.
+		if err := s.repo.ReleasePaymentRequest(context.WithoutCancel(ctx), id); err != nil {
+			s.logger.Errorf("failed to release payment request %s: %v", id, err)
+		}
+		return nil, err
+	}
+
+	s.logger.Infof("Payment request %s accepted by %s, transaction %s", id, payerAccountID, *accepted.Request.TransactionID)
+	return accepted, nil
+}
+
+// payPaymentRequest settles a claimed request, posting the transfer unless
+// an earlier attempt already did.
+func (s *Service) payPaymentRequest(ctx context.Context, pr *repository.PaymentRequest) (*AcceptedPaymentRequest, error) {
+	accepted := &AcceptedPaymentRequest{}
+
+	// A transfer posted by an earlier attempt that failed to record it
+	// settles the request, even if it has expired since.
+	refID := paymentRequestReference(pr.ID)
+	transactionID, err := s.transactionByReference(ctx, refID)
+	if err != nil {
+		return nil, err
+	}
+	if transactionID != "" {
+		accepted.NewBalance, err = s.balance(ctx, pr.PayerAccountID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if err := checkPending(pr); err != nil {
+			return nil, err
+		}
+
+		description := fmt.Sprintf("Payment request %s from %s", pr.ID, pr.RequesterAccountID)
+		if pr.Memo != "" {
+			description = fmt.Sprintf("%s: %s", description, pr.Memo)
+		}
+
+		transactionID, accepted.NewBalance, accepted.Fee, err = s.transfer(ctx, pr.PayerAccountID, pr.RequesterAccountID, pr.Amount, description, refID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	accepted.Request, err = s.repo.SettlePaymentRequest(ctx, pr.ID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	return accepted, nil
+}
+
+func paymentRequestReference(id string) string {
+	return "payment-request-" + id
+}
+
+// DeclinePaymentRequest is the payer turning a pending request down.
+func (s *Service) DeclinePaymentRequest(ctx context.Context, payerAccountID, id string) (*repository.PaymentRequest, error) {
+	return s.closePaymentRequest(ctx, id, repository.PaymentRequestDeclined, func(pr *repository.PaymentRequest) bool {
+		return pr.PayerAccountID == payerAccountID
+	})
+}
+
+// CancelPaymentRequest is the requester withdrawing a pending request.
+func (s *Service) CancelPaymentRequest(ctx context.Context, requesterAccountID, id string) (*repository.PaymentRequest, error) {
+	return s.closePaymentRequest(ctx, id, repository.PaymentRequestCancelled, func(pr *repository.PaymentRequest) bool {
+		return pr.RequesterAccountID == requesterAccountID
+	})
+}
+
+func (s *Service) closePaymentRequest(ctx context.Context, id, status string, allowed func(pr *repository.PaymentRequest) bool) (*repository.PaymentRequest, error) {
+	pr, err := s.repo.GetPaymentRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// Requests of other accounts are reported as not found so that ids
+	// cannot be probed.
+	if !allowed(pr) {
+		return nil, fmt.Errorf("%w: %s", accountErrors.ErrPaymentRequestNotFound, id)
+	}
+	if err := checkPending(pr); err != nil {
+		return nil, err
+	}
+
+	// An accept that posted the transfer but failed to record it has paid
+	// the request, which can then no longer be turned down.
+	transactionID, err := s.transactionByReference(ctx, paymentRequestReference(id))
+	if err != nil {
+		return nil, err
+	}
+	if transactionID != "" {
+		settled, err := s.repo.SettlePaymentRequest(ctx, id, transactionID)
+		if err != nil {
+			return nil, err
+		}
+		s.logger.Infof("Payment request %s was paid by transaction %s, not %s", id, transactionID, status)
+		return nil, checkPending(settled)
+	}
+
+	closed, err := s.repo.ClosePaymentRequest(ctx, id, status)
+	if err != nil {
+		return nil, err
+	}
+	if closed == nil {
+		// Settled or expired since it was read.
+		current, err := s.repo.GetPaymentRequest(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkPending(current); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s is being accepted", accountErrors.ErrPaymentRequestNotPending, id)
+	}
+
+	s.logger.Infof("Payment request %s %s", id, closed.Status)
+	return closed, nil
+}
+
+func checkPending(pr *repository.PaymentRequest) error {
+	switch pr.Status {
+	case repository.PaymentRequestPending:
+		return nil
+	case repository.PaymentRequestExpired:
+		return fmt.Errorf("%w: expired at %s", accountErrors.ErrPaymentRequestExpired, pr.ExpiresAt.Format(time.RFC3339))
+	}
+	return fmt.Errorf("%w: %s is %s", accountErrors.ErrPaymentRequestNotPending, pr.ID, pr.Status)
+}
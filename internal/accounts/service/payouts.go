@@ -0,0 +1,670 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/notifications"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
+	pbSub "github.com/ChotongW/grit_demo_wallet/pb/subledger"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// maxPayoutFileSize keeps an upload well inside a single gRPC message.
+	maxPayoutFileSize = 2 << 20
+	maxPayoutRows     = 10000
+
+	payoutChunkSize = 500
+	payoutLease     = 5 * time.Minute
+)
+
+// payoutColumns are the columns of a payout file. The header row names
+// them, in any order; description is optional.
+var payoutColumns = []string{"recipient", "amount", "description"}
+
+var payoutRowStatuses = map[string]bool{
+	repository.PayoutRowValid:     true,
+	repository.PayoutRowInvalid:   true,
+	repository.PayoutRowSubmitted: true,
+	repository.PayoutRowPaid:      true,
+	repository.PayoutRowFailed:    true,
+}
+
+var payoutBatchStatuses = map[string]bool{
+	repository.PayoutPendingApproval: true,
+	repository.PayoutApproved:        true,
+	repository.PayoutCompleted:       true,
+	repository.PayoutCancelled:       true,
+}
+
+// FundDisbursementPool moves amount from the INSTITUTION_MAIN account into
+// the DISBURSEMENT pool, which payouts are paid from and which nothing else
+// credits. It returns the transaction and the pool's new balance.
+func (s *Service) FundDisbursementPool(ctx context.Context, amount decimal.Decimal, description string) (string, string, decimal.Decimal, error) {
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return "", "", decimal.Zero, accountErrors.ErrFundingAmountMustBePositive
+	}
+
+	institutionAccount, err := s.systemAccount(ctx, RoleInstitutionMain)
+	if err != nil {
+		return "", "", decimal.Zero, err
+	}
+	poolAccount, err := s.systemAccount(ctx, RoleDisbursement)
+	if err != nil {
+		return "", "", decimal.Zero, err
+	}
+
+	if description == "" {
+		description = "Disbursement pool funding"
+	}
+	req := &pbSub.CreateTransactionRequest{
+		ReferenceId: fmt.Sprintf("disbursement-funding-%s", uuid.New().String()),
+		Description: description,
+		Entries: []*pbSub.Entry{
+			{
+				AccountId: institutionAccount,
+				Amount:    amount.String(),
+				Direction: "DEBIT",
+			},
+			{
+				AccountId: poolAccount,
+				Amount:    amount.String(),
+				Direction: "CREDIT",
+			},
+		},
+	}
+	resp, err := s.subledgerClient.CreateTransaction(ctx, req)
+	if err != nil {
+		return "", "", decimal.Zero, fmt.Errorf("failed to fund disbursement pool: %w", err)
+	}
+	s.publishPosted(ctx, resp.TransactionId, req)
+
+	balance, err := s.balance(ctx, poolAccount)
+	if err != nil {
+		return resp.TransactionId, poolAccount, decimal.Zero, err
+	}
+
+	s.logger.Infof("Funded disbursement pool %s with %s from %s, new balance: %s", poolAccount, amount.String(), institutionAccount, balance.String())
+	return resp.TransactionId, poolAccount, balance, nil
+}
+
+// CreatePayoutBatch validates a payout file, a CSV with a header row and
+// one payment per line, and records it to await approval. A recipient is a
+// USER account id, an email or a handle; amounts are positive with at most
+// two decimal places. Lines that fail validation, including repeated
+// recipients, are kept as INVALID rows and are never paid. createdBy is
+// the user uploading it, who may not also approve it.
+func (s *Service) CreatePayoutBatch(ctx context.Context, filename string, data []byte, createdBy string) (*repository.PayoutBatch, error) {
+	if createdBy == "" {
+		return nil, accountErrors.ErrPayoutUserRequired
+	}
+	if len(data) > maxPayoutFileSize {
+		return nil, fmt.Errorf("%w: files are at most %d bytes", accountErrors.ErrInvalidPayoutFile, maxPayoutFileSize)
+	}
+
+	rows, err := parsePayoutFile(data)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceAccountID, err := s.systemAccount(ctx, RoleDisbursement)
+	if err != nil {
+		return nil, err
+	}
+	source, err := s.repo.GetAccount(ctx, sourceAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recipients that look like account ids are looked up together; the
+	// rest are resolved one by one as aliases.
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.Recipient)
+	}
+	accounts, err := s.repo.GetAccounts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validatePayoutRows(ctx, rows, accounts, source); err != nil {
+		return nil, err
+	}
+
+	batch := &repository.PayoutBatch{
+		ID:              uuid.New().String(),
+		Filename:        filename,
+		Status:          repository.PayoutPendingApproval,
+		SourceAccountID: sourceAccountID,
+		TotalRows:       len(rows),
+		TotalAmount:     decimal.Zero,
+		CreatedBy:       createdBy,
+	}
+	for _, row := range rows {
+		if row.Status == repository.PayoutRowValid {
+			batch.ValidRows++
+			batch.TotalAmount = batch.TotalAmount.Add(*row.Amount)
+		}
+	}
+
+	if err := s.repo.CreatePayoutBatch(ctx, batch, rows); err != nil {
+		return nil, err
+	}
+
+	s.logger.Infof("Payout batch %s uploaded: %d rows, %d valid, %s to pay", batch.ID, batch.TotalRows, batch.ValidRows, batch.TotalAmount.StringFixed(2))
+	return s.repo.GetPayoutBatch(ctx, batch.ID)
+}
+
+// parsePayoutFile reads the lines of a payout file, numbered as in the
+// file, without validating their values.
+func parsePayoutFile(data []byte) ([]repository.PayoutRow, error) {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: file is empty", accountErrors.ErrInvalidPayoutFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", accountErrors.ErrInvalidPayoutFile, err)
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range payoutColumns[:2] {
+		if _, ok := index[name]; !ok {
+			return nil, fmt.Errorf("%w: header must name the columns %s", accountErrors.ErrInvalidPayoutFile, strings.Join(payoutColumns, ","))
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := index[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []repository.PayoutRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", accountErrors.ErrInvalidPayoutFile, err)
+		}
+		if len(rows) == maxPayoutRows {
+			return nil, fmt.Errorf("%w: files hold at most %d payments", accountErrors.ErrInvalidPayoutFile, maxPayoutRows)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := repository.PayoutRow{
+			Line:        line,
+			Recipient:   field(record, "recipient"),
+			Description: field(record, "description"),
+			Status:      repository.PayoutRowValid,
+		}
+		if amount := field(record, "amount"); amount != "" {
+			if parsed, err := decimal.NewFromString(amount); err == nil {
+				row.Amount = &parsed
+			} else {
+				row.Status = repository.PayoutRowInvalid
+				row.Error = payoutError(fmt.Errorf("%w: %q", accountErrors.ErrInvalidAmount, amount))
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: file has no payments", accountErrors.ErrInvalidPayoutFile)
+	}
+	return rows, nil
+}
+
+// validatePayoutRows resolves the recipient of every row and marks the
+// rows that cannot be paid from source as INVALID. accounts holds the
+// recipients already looked up as account ids; the rest are resolved as
+// aliases.
+func (s *Service) validatePayoutRows(ctx context.Context, rows []repository.PayoutRow, accounts map[string]*repository.Account, source *repository.Account) error {
+	firstLine := make(map[string]int, len(rows))
+	for i := range rows {
+		row := &rows[i]
+		if row.Status != repository.PayoutRowValid {
+			continue
+		}
+
+		accountID, err := s.validatePayoutRow(ctx, row, accounts, source)
+		if err == nil {
+			if line, ok := firstLine[accountID]; ok {
+				err = fmt.Errorf("%w: %s also appears on line %d", accountErrors.ErrInvalidRecipient, row.Recipient, line)
+			} else {
+				firstLine[accountID] = row.Line
+			}
+		}
+		if err != nil {
+			if accountErrors.Reason(err) == accountErrors.ReasonInternal {
+				return err
+			}
+			row.Status = repository.PayoutRowInvalid
+			row.Error = payoutError(err)
+			continue
+		}
+		row.AccountID = &accountID
+	}
+
+	return nil
+}
+
+func (s *Service) validatePayoutRow(ctx context.Context, row *repository.PayoutRow, accounts map[string]*repository.Account, source *repository.Account) (string, error) {
+	switch {
+	case row.Amount == nil:
+		return "", fmt.Errorf("%w: amount is required", accountErrors.ErrInvalidAmount)
+	case !row.Amount.IsPositive():
+		return "", accountErrors.ErrTransferAmountMustBePositive
+	case !row.Amount.Equal(row.Amount.Round(2)):
+		return "", fmt.Errorf("%w: %s has more than two decimal places", accountErrors.ErrInvalidAmount, row.Amount.String())
+	}
+
+	account, ok := accounts[row.Recipient]
+	if !ok {
+		recipient, err := s.ResolveRecipient(ctx, row.Recipient)
+		if err != nil {
+			return "", err
+		}
+		if account, err = s.repo.GetAccount(ctx, recipient.AccountID); err != nil {
+			return "", err
+		}
+	}
+
+	if account.AccountType != "USER" {
+		return "", fmt.Errorf("%w: %s is not a user account", accountErrors.ErrInvalidRecipient, row.Recipient)
+	}
+	if account.Currency != source.Currency {
+		return "", fmt.Errorf("%w: cannot pay %s to a %s wallet", accountErrors.ErrUnsupportedCurrency, source.Currency, account.Currency)
+	}
+
+	return account.AccountID, nil
+}
+
+func payoutError(err error) *string {
+	message := err.Error()
+	return &message
+}
+
+func (s *Service) GetPayoutBatch(ctx context.Context, id string) (*repository.PayoutBatch, error) {
+	return s.repo.GetPayoutBatch(ctx, id)
+}
+
+func (s *Service) ListPayoutBatches(ctx context.Context, status string, page, pageSize int) ([]repository.PayoutBatch, int, error) {
+	status = strings.ToUpper(status)
+	if status != "" && !payoutBatchStatuses[status] {
+		return nil, 0, fmt.Errorf("%w: %s", accountErrors.ErrInvalidPayoutFilter, status)
+	}
+	page, pageSize = payoutPage(page, pageSize)
+	return s.repo.ListPayoutBatches(ctx, status, page, pageSize)
+}
+
+// ListPayoutRows returns a page of the rows of a batch, e.g. its INVALID
+// rows to review before approving it.
+func (s *Service) ListPayoutRows(ctx context.Context, batchID, status string, page, pageSize int) ([]repository.PayoutRow, int, error) {
+	status = strings.ToUpper(status)
+	if status != "" && !payoutRowStatuses[status] {
+		return nil, 0, fmt.Errorf("%w: %s", accountErrors.ErrInvalidPayoutFilter, status)
+	}
+	if _, err := s.repo.GetPayoutBatch(ctx, batchID); err != nil {
+		return nil, 0, err
+	}
+	page, pageSize = payoutPage(page, pageSize)
+	return s.repo.ListPayoutRows(ctx, batchID, status, page, pageSize)
+}
+
+func payoutPage(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	return page, pageSize
+}
+
+// ApprovePayoutBatch queues a batch awaiting approval to be paid by the
+// payout worker. Its INVALID rows are skipped. The source account must hold
+// enough to pay every valid row, and approvedBy must not be the user who
+// uploaded the batch.
+func (s *Service) ApprovePayoutBatch(ctx context.Context, id, approvedBy string) (*repository.PayoutBatch, error) {
+	batch, err := s.repo.GetPayoutBatch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPayoutApprover(batch, approvedBy); err != nil {
+		return nil, err
+	}
+	if batch.Status != repository.PayoutPendingApproval {
+		return nil, fmt.Errorf("%w: batch is %s", accountErrors.ErrInvalidPayoutState, batch.Status)
+	}
+	if batch.ValidRows == 0 {
+		return nil, fmt.Errorf("%w: batch has no valid rows", accountErrors.ErrInvalidPayoutState)
+	}
+
+	available, err := s.balance(ctx, batch.SourceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if batch.TotalAmount.GreaterThan(available) {
+		return nil, fmt.Errorf("%w: batch pays %s, account %s holds %s",
+			accountErrors.ErrInsufficientBalance, batch.TotalAmount.StringFixed(2), batch.SourceAccountID, available.StringFixed(2))
+	}
+
+	approved, err := s.repo.ApprovePayoutBatch(ctx, id, approvedBy)
+	if err != nil {
+		return nil, err
+	}
+	if !approved {
+		return nil, fmt.Errorf("%w: batch is no longer awaiting approval", accountErrors.ErrInvalidPayoutState)
+	}
+
+	s.logger.Infof("Payout batch %s approved by %s: %d rows, %s to pay", id, approvedBy, batch.ValidRows, batch.TotalAmount.StringFixed(2))
+	return s.repo.GetPayoutBatch(ctx, id)
+}
+
+// checkPayoutApprover keeps a batch from being approved by its uploader.
+func checkPayoutApprover(batch *repository.PayoutBatch, approvedBy string) error {
+	if approvedBy == "" {
+		return accountErrors.ErrPayoutUserRequired
+	}
+	if approvedBy == batch.CreatedBy {
+		return fmt.Errorf("%w: %s uploaded batch %s", accountErrors.ErrPayoutSelfApproval, approvedBy, batch.ID)
+	}
+	return nil
+}
+
+func (s *Service) CancelPayoutBatch(ctx context.Context, id string) (*repository.PayoutBatch, error) {
+	if _, err := s.repo.GetPayoutBatch(ctx, id); err != nil {
+		return nil, err
+	}
+
+	cancelled, err := s.repo.CancelPayoutBatch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !cancelled {
+		return nil, fmt.Errorf("%w: only batches awaiting approval can be cancelled", accountErrors.ErrInvalidPayoutState)
+	}
+
+	s.logger.Infof("Payout batch %s cancelled", id)
+	return s.repo.GetPayoutBatch(ctx, id)
+}
+
+// RunPayouts pays approved payout batches every interval until ctx is
+// done. Several replicas may run it at once.
+func (s *Service) RunPayouts(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ExecuteApprovedPayouts(ctx, time.Now().UTC()); err != nil && ctx.Err() == nil {
+			s.logger.Errorf("failed to execute payouts: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExecuteApprovedPayouts pays one approved batch to the end and returns
+// whether it found one. A batch left unfinished by an error or a crash is
+// picked up again, by any replica, once its lease expires.
+func (s *Service) ExecuteApprovedPayouts(ctx context.Context, now time.Time) (bool, error) {
+	id, err := s.repo.ClaimPayoutBatch(ctx, now, payoutLease)
+	if err != nil || id == "" {
+		return false, err
+	}
+
+	batch, err := s.repo.GetPayoutBatch(ctx, id)
+	if err != nil {
+		return true, err
+	}
+
+	for {
+		rows, err := s.repo.NextPayoutRows(ctx, id, payoutChunkSize)
+		if err != nil {
+			return true, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		if err := s.repo.RenewPayoutBatchLease(ctx, id, time.Now().UTC().Add(payoutLease)); err != nil {
+			return true, err
+		}
+		if err := s.payPayoutChunk(ctx, batch, rows); err != nil {
+			return true, fmt.Errorf("payout batch %s: %w", id, err)
+		}
+	}
+
+	if err := s.repo.CompletePayoutBatch(ctx, id); err != nil {
+		return true, err
+	}
+
+	batch, err = s.repo.GetPayoutBatch(ctx, id)
+	if err != nil {
+		return true, err
+	}
+	s.logger.Infof("Payout batch %s completed: %d paid (%s), %d failed", id, batch.PaidRows, batch.PaidAmount.StringFixed(2), batch.FailedRows)
+	return true, nil
+}
+
+// payPayoutChunk posts rows to the ledger as one best-effort batch, each
+// row its own transaction debiting the batch's source account under the
+// reference payout-<batch>-<line>. Rows are marked SUBMITTED before they
+// are posted; a SUBMITTED row found again, after a crash or a failed call,
+// is looked up by its reference first so that it is never paid twice. Rows
+// the source account can no longer cover fail without being posted.
+func (s *Service) payPayoutChunk(ctx context.Context, batch *repository.PayoutBatch, rows []repository.PayoutRow) error {
+	settled, pending, err := s.preparePayoutRows(ctx, batch, rows)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) > 0 {
+		lines := make([]int, len(pending))
+		for i, row := range pending {
+			lines[i] = row.Line
+		}
+		if err := s.repo.MarkPayoutRowsSubmitted(ctx, batch.ID, lines); err != nil {
+			return err
+		}
+
+		posted, paid, err := s.postPayoutRows(ctx, batch, pending)
+		if err != nil {
+			return err
+		}
+		settled = append(settled, posted...)
+
+		for _, row := range paid {
+			s.publishPosted(ctx, *row.TransactionID, payoutTransaction(batch, &row))
+			s.notifyMovement(ctx, movement{
+				event:         notifications.EventDeposit,
+				accountID:     *row.AccountID,
+				transactionID: *row.TransactionID,
+				amount:        *row.Amount,
+			})
+		}
+	}
+
+	return s.repo.SettlePayoutRows(ctx, batch.ID, settled)
+}
+
+// preparePayoutRows settles the rows of a chunk that need no posting, the
+// SUBMITTED rows the ledger already holds and the rows the source account
+// cannot cover, and returns the rest to post.
+func (s *Service) preparePayoutRows(ctx context.Context, batch *repository.PayoutBatch, rows []repository.PayoutRow) (settled, pending []repository.PayoutRow, err error) {
+	for _, row := range rows {
+		if row.Status == repository.PayoutRowSubmitted {
+			transactionID, err := s.transactionByReference(ctx, payoutRowReference(batch.ID, row.Line))
+			if err != nil {
+				return nil, nil, err
+			}
+			if transactionID != "" {
+				row.Status = repository.PayoutRowPaid
+				row.TransactionID = &transactionID
+				settled = append(settled, row)
+				continue
+			}
+		}
+		pending = append(pending, row)
+	}
+	if len(pending) == 0 {
+		return settled, nil, nil
+	}
+
+	available, err := s.balance(ctx, batch.SourceAccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	covered := pending[:0]
+	for _, row := range pending {
+		if row.Amount.GreaterThan(available) {
+			row.Status = repository.PayoutRowFailed
+			reason := payoutInsufficientFunds
+			row.Error = &reason
+			settled = append(settled, row)
+			continue
+		}
+		available = available.Sub(*row.Amount)
+		covered = append(covered, row)
+	}
+
+	return settled, covered, nil
+}
+
+// postPayoutRows posts rows and returns them settled, along with those of
+// them this call paid. A row the ledger rejects as already posted was paid
+// by an earlier attempt whose answer was lost, e.g. one that committed
+// after its caller gave up, and is settled as PAID from the ledger.
+func (s *Service) postPayoutRows(ctx context.Context, batch *repository.PayoutBatch, rows []repository.PayoutRow) (settled, paid []repository.PayoutRow, err error) {
+	req := &pbSub.CreateTransactionBatchRequest{Mode: "BEST_EFFORT"}
+	for i := range rows {
+		req.Transactions = append(req.Transactions, payoutTransaction(batch, &rows[i]))
+	}
+
+	resp, err := s.subledgerClient.CreateTransactionBatch(ctx, req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to post payouts: %w", err)
+	}
+	if len(resp.Results) != len(rows) {
+		return nil, nil, fmt.Errorf("failed to post payouts: %d results for %d payouts", len(resp.Results), len(rows))
+	}
+
+	for i, result := range resp.Results {
+		row := rows[i]
+		transactionID := result.TransactionId
+
+		switch {
+		case result.Success:
+			row.Status = repository.PayoutRowPaid
+			row.TransactionID = &transactionID
+			paid = append(paid, row)
+
+		case result.Reason == subledgerErrors.ReasonDuplicateReference:
+			if transactionID, err = s.transactionByReference(ctx, result.ReferenceId); err != nil {
+				return nil, nil, err
+			}
+			if transactionID == "" {
+				return nil, nil, fmt.Errorf("failed to post payouts: %s is posted but cannot be found", result.ReferenceId)
+			}
+			row.Status = repository.PayoutRowPaid
+			row.TransactionID = &transactionID
+
+		default:
+			row.Status = repository.PayoutRowFailed
+			row.Error = &result.Error
+		}
+		settled = append(settled, row)
+	}
+
+	return settled, paid, nil
+}
+
+// payoutInsufficientFunds is the error recorded on rows the source account
+// could not cover when their turn came.
+const payoutInsufficientFunds = "insufficient funds in the source account"
+
+func payoutTransaction(batch *repository.PayoutBatch, row *repository.PayoutRow) *pbSub.CreateTransactionRequest {
+	description := row.Description
+	if description == "" {
+		description = fmt.Sprintf("Payout %s", batch.ID)
+	}
+	return &pbSub.CreateTransactionRequest{
+		ReferenceId: payoutRowReference(batch.ID, row.Line),
+		Description: description,
+		Entries: []*pbSub.Entry{
+			{AccountId: batch.SourceAccountID, Amount: row.Amount.String(), Direction: "DEBIT"},
+			{AccountId: *row.AccountID, Amount: row.Amount.String(), Direction: "CREDIT"},
+		},
+	}
+}
+
+func payoutRowReference(batchID string, line int) string {
+	return fmt.Sprintf("payout-%s-%d", batchID, line)
+}
+
+// ExportPayoutResults renders every row of a batch with its status as CSV,
+// in file order, and returns it with a file name.
+func (s *Service) ExportPayoutResults(ctx context.Context, id string) ([]byte, string, error) {
+	if _, err := s.repo.GetPayoutBatch(ctx, id); err != nil {
+		return nil, "", err
+	}
+	rows, _, err := s.repo.ListPayoutRows(ctx, id, "", 1, 0)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"line", "recipient", "account_id", "amount", "description", "status", "transaction_id", "error"})
+	for _, row := range rows {
+		record := []string{strconv.Itoa(row.Line), row.Recipient, "", "", row.Description, row.Status, "", ""}
+		if row.AccountID != nil {
+			record[2] = *row.AccountID
+		}
+		if row.Amount != nil {
+			record[3] = row.Amount.StringFixed(2)
+		}
+		if row.TransactionID != nil {
+			record[6] = *row.TransactionID
+		}
+		if row.Error != nil {
+			record[7] = *row.Error
+		}
+		_ = w.Write(record)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, "", fmt.Errorf("failed to render payout results: %w", err)
+	}
+
+	return buf.Bytes(), fmt.Sprintf("payout-%s-results.csv", id), nil
+}
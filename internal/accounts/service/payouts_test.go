@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
+	pbSub "github.com/ChotongW/grit_demo_wallet/pb/subledger"
+
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func decPtr(s string) *decimal.Decimal {
+	d := decimal.RequireFromString(s)
+	return &d
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func newTestService(ledger pbSub.SubledgerServiceClient) *Service {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return NewService(nil, ledger, nil, logger)
+}
+
+func TestParsePayoutFile(t *testing.T) {
+	input := "\ufeffAmount, Recipient ,description\n" +
+		"10.50,acc-1,\"rent, March\"\n" +
+		"\n" +
+		" 7 , alice@example.com \n" +
+		"ten,@bob,\n" +
+		",acc-2,no amount\n"
+
+	rows, err := parsePayoutFile([]byte(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		line        int
+		recipient   string
+		amount      string
+		description string
+		status      string
+	}{
+		{2, "acc-1", "10.5", "rent, March", repository.PayoutRowValid},
+		{4, "alice@example.com", "7", "", repository.PayoutRowValid},
+		{5, "@bob", "", "", repository.PayoutRowInvalid},
+		{6, "acc-2", "", "no amount", repository.PayoutRowValid},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(rows), len(want))
+	}
+	for i, w := range want {
+		row := rows[i]
+		amount := ""
+		if row.Amount != nil {
+			amount = row.Amount.String()
+		}
+		if row.Line != w.line || row.Recipient != w.recipient || amount != w.amount || row.Description != w.description || row.Status != w.status {
+			t.Errorf("row %d = line %d %q %q %q %s, want line %d %q %q %q %s", i,
+				row.Line, row.Recipient, amount, row.Description, row.Status,
+				w.line, w.recipient, w.amount, w.description, w.status)
+		}
+	}
+	if rows[2].Error == nil || !strings.HasPrefix(*rows[2].Error, accountErrors.ErrInvalidAmount.Error()) {
+		t.Errorf("unparsable amount recorded %v, want %v", rows[2].Error, accountErrors.ErrInvalidAmount)
+	}
+}
+
+func TestParsePayoutFileFails(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"empty", "", "file is empty"},
+		{"header only", "recipient,amount\n", "file has no payments"},
+		{"missing column", "recipient,description\nacc-1,rent\n", "header must name the columns"},
+		{"bad quoting", "recipient,amount\n\"acc-1,10\n", "invalid payout file"},
+		{"too many rows", "recipient,amount\n" + strings.Repeat("acc-1,1\n", maxPayoutRows+1), "at most"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parsePayoutFile([]byte(tt.input))
+			if !errors.Is(err, accountErrors.ErrInvalidPayoutFile) || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("parsePayoutFile error = %v, want ErrInvalidPayoutFile containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePayoutRows(t *testing.T) {
+	source := &repository.Account{AccountID: "disbursement", AccountType: "SYSTEM", Currency: "USD"}
+	accounts := map[string]*repository.Account{
+		"acc-1":    {AccountID: "acc-1", AccountType: "USER", Currency: "USD"},
+		"acc-2":    {AccountID: "acc-2", AccountType: "USER", Currency: "USD"},
+		"acc-eur":  {AccountID: "acc-eur", AccountType: "USER", Currency: "EUR"},
+		"sys-fees": {AccountID: "sys-fees", AccountType: "SYSTEM", Currency: "USD"},
+	}
+
+	tests := []struct {
+		name   string
+		row    repository.PayoutRow
+		status string
+		err    error
+	}{
+		{"valid", repository.PayoutRow{Recipient: "acc-1", Amount: decPtr("10.50")}, repository.PayoutRowValid, nil},
+		{"missing amount", repository.PayoutRow{Recipient: "acc-2"}, repository.PayoutRowInvalid, accountErrors.ErrInvalidAmount},
+		{"zero amount", repository.PayoutRow{Recipient: "acc-2", Amount: decPtr("0")}, repository.PayoutRowInvalid, accountErrors.ErrTransferAmountMustBePositive},
+		{"negative amount", repository.PayoutRow{Recipient: "acc-2", Amount: decPtr("-1")}, repository.PayoutRowInvalid, accountErrors.ErrTransferAmountMustBePositive},
+		{"three decimal places", repository.PayoutRow{Recipient: "acc-2", Amount: decPtr("1.005")}, repository.PayoutRowInvalid, accountErrors.ErrInvalidAmount},
+		{"not a user account", repository.PayoutRow{Recipient: "sys-fees", Amount: decPtr("1")}, repository.PayoutRowInvalid, accountErrors.ErrInvalidRecipient},
+		{"other currency", repository.PayoutRow{Recipient: "acc-eur", Amount: decPtr("1")}, repository.PayoutRowInvalid, accountErrors.ErrUnsupportedCurrency},
+		{"repeated recipient", repository.PayoutRow{Recipient: "acc-1", Amount: decPtr("2")}, repository.PayoutRowInvalid, accountErrors.ErrInvalidRecipient},
+		{"already invalid", repository.PayoutRow{Recipient: "acc-2", Status: repository.PayoutRowInvalid, Error: strPtr("bad amount")}, repository.PayoutRowInvalid, nil},
+	}
+
+	rows := make([]repository.PayoutRow, len(tests))
+	for i, tt := range tests {
+		rows[i] = tt.row
+		rows[i].Line = i + 2
+		if rows[i].Status == "" {
+			rows[i].Status = repository.PayoutRowValid
+		}
+	}
+
+	svc := newTestService(nil)
+	if err := svc.validatePayoutRows(context.Background(), rows, accounts, source); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := rows[i]
+			if row.Status != tt.status {
+				t.Errorf("status = %s, want %s", row.Status, tt.status)
+			}
+			switch {
+			case tt.status == repository.PayoutRowValid:
+				if row.AccountID == nil || *row.AccountID != row.Recipient || row.Error != nil {
+					t.Errorf("valid row resolved to %v with error %v", row.AccountID, row.Error)
+				}
+			case tt.err != nil:
+				if row.Error == nil || !strings.HasPrefix(*row.Error, tt.err.Error()) {
+					t.Errorf("error = %v, want %v", row.Error, tt.err)
+				}
+			}
+		})
+	}
+}
+
+// fakeLedger stands in for the subledger, posting batches the way it does:
+// a reference already posted is rejected as a duplicate.
+type fakeLedger struct {
+	pbSub.SubledgerServiceClient
+
+	balance string
+	posted  map[string]string // transaction id by reference
+
+	// loseAnswer makes the next batch commit while its caller hears a
+	// timeout. Lookups only see the commit once the next batch comes in.
+	loseAnswer bool
+	late       map[string]string
+
+	posts int
+}
+
+func newFakeLedger(balance string) *fakeLedger {
+	return &fakeLedger{balance: balance, posted: map[string]string{}, late: map[string]string{}}
+}
+
+func (l *fakeLedger) GetBalance(_ context.Context, req *pbSub.GetBalanceRequest, _ ...grpc.CallOption) (*pbSub.GetBalanceResponse, error) {
+	return &pbSub.GetBalanceResponse{AccountId: req.AccountId, Amount: l.balance}, nil
+}
+
+func (l *fakeLedger) ListEntries(_ context.Context, req *pbSub.ListEntriesRequest, _ ...grpc.CallOption) (*pbSub.ListEntriesResponse, error) {
+	resp := &pbSub.ListEntriesResponse{}
+	if id, ok := l.posted[req.ReferenceId]; ok {
+		resp.Entries = []*pbSub.LedgerEntry{{TransactionId: id, ReferenceId: req.ReferenceId}}
+	}
+	return resp, nil
+}
+
+func (l *fakeLedger) CreateTransactionBatch(_ context.Context, req *pbSub.CreateTransactionBatchRequest, _ ...grpc.CallOption) (*pbSub.CreateTransactionBatchResponse, error) {
+	for ref, id := range l.late {
+		l.posted[ref] = id
+	}
+	clear(l.late)
+
+	resp := &pbSub.CreateTransactionBatchResponse{Mode: req.Mode}
+	for i, txn := range req.Transactions {
+		result := &pbSub.BatchItemResult{Index: int32(i), ReferenceId: txn.ReferenceId}
+		if _, ok := l.posted[txn.ReferenceId]; ok {
+			result.Reason = subledgerErrors.ReasonDuplicateReference
+			result.Error = subledgerErrors.ErrDuplicateReference.Error()
+			resp.Rejected++
+		} else {
+			l.posts++
+			result.Success = true
+			result.TransactionId = fmt.Sprintf("txn-%d", l.posts)
+			if l.loseAnswer {
+				l.late[txn.ReferenceId] = result.TransactionId
+			} else {
+				l.posted[txn.ReferenceId] = result.TransactionId
+			}
+			resp.Posted++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	if l.loseAnswer {
+		l.loseAnswer = false
+		return nil, status.Error(codes.DeadlineExceeded, "context deadline exceeded")
+	}
+	return resp, nil
+}
+
+func TestCheckPayoutApprover(t *testing.T) {
+	batch := &repository.PayoutBatch{ID: "batch-1", CreatedBy: "alice"}
+
+	tests := []struct {
+		name       string
+		approvedBy string
+		want       error
+	}{
+		{"another user", "bob", nil},
+		{"no user", "", accountErrors.ErrPayoutUserRequired},
+		{"the uploader", "alice", accountErrors.ErrPayoutSelfApproval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkPayoutApprover(batch, tt.approvedBy)
+			if (tt.want == nil) != (err == nil) || (tt.want != nil && !errors.Is(err, tt.want)) {
+				t.Errorf("checkPayoutApprover(%q) = %v, want %v", tt.approvedBy, err, tt.want)
+			}
+		})
+	}
+}
+
+// payChunk runs the ledger side of payPayoutChunk over rows, marking the
+// rows it posts SUBMITTED as the repository would, and returns the rows it
+// settled and how many of them it paid itself.
+func payChunk(t *testing.T, svc *Service, batch *repository.PayoutBatch, rows []repository.PayoutRow) ([]repository.PayoutRow, int, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	settled, pending, err := svc.preparePayoutRows(ctx, batch, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range rows {
+		for _, p := range pending {
+			if rows[i].Line == p.Line {
+				rows[i].Status = repository.PayoutRowSubmitted
+			}
+		}
+	}
+	if len(pending) == 0 {
+		return settled, 0, nil
+	}
+
+	posted, paid, err := svc.postPayoutRows(ctx, batch, pending)
+	return append(settled, posted...), len(paid), err
+}
+
+func testPayoutRows() []repository.PayoutRow {
+	return []repository.PayoutRow{
+		{Line: 2, AccountID: strPtr("acc-1"), Amount: decPtr("10"), Status: repository.PayoutRowValid},
+		{Line: 3, AccountID: strPtr("acc-2"), Amount: decPtr("20"), Status: repository.PayoutRowValid},
+		{Line: 4, AccountID: strPtr("acc-3"), Amount: decPtr("30"), Status: repository.PayoutRowValid},
+	}
+}
+
+func TestPayPayoutChunkTwice(t *testing.T) {
+	tests := []struct {
+		name       string
+		loseAnswer bool // the first run's post commits late and reports a timeout
+	}{
+		{"first run's settle lost", false},
+		{"first run's answer lost", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ledger := newFakeLedger("1000")
+			ledger.loseAnswer = tt.loseAnswer
+			svc := newTestService(ledger)
+			batch := &repository.PayoutBatch{ID: "batch-1", SourceAccountID: "disbursement"}
+			rows := testPayoutRows()
+
+			// The first run dies before settling its rows, which stay
+			// SUBMITTED for the next worker to pick up.
+			if _, _, err := payChunk(t, svc, batch, rows); (err != nil) != tt.loseAnswer {
+				t.Fatalf("first run error = %v", err)
+			}
+			for _, row := range rows {
+				if row.Status != repository.PayoutRowSubmitted {
+					t.Fatalf("row %d is %s after the first run, want SUBMITTED", row.Line, row.Status)
+				}
+			}
+
+			settled, paid, err := payChunk(t, svc, batch, rows)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if ledger.posts != len(rows) {
+				t.Errorf("ledger posted %d payouts for %d rows", ledger.posts, len(rows))
+			}
+			if paid != 0 {
+				t.Errorf("second run paid %d rows, want none", paid)
+			}
+			if len(settled) != len(rows) {
+				t.Fatalf("second run settled %d rows, want %d", len(settled), len(rows))
+			}
+			for _, row := range settled {
+				want := ledger.posted[payoutRowReference(batch.ID, row.Line)]
+				if row.Status != repository.PayoutRowPaid || row.TransactionID == nil || *row.TransactionID != want {
+					t.Errorf("row %d settled %s as %v, want PAID as %s", row.Line, row.Status, row.TransactionID, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPreparePayoutRows(t *testing.T) {
+	ledger := newFakeLedger("35")
+	ledger.posted[payoutRowReference("batch-1", 2)] = "txn-earlier"
+	svc := newTestService(ledger)
+	batch := &repository.PayoutBatch{ID: "batch-1", SourceAccountID: "disbursement"}
+
+	rows := testPayoutRows()
+	rows[0].Status = repository.PayoutRowSubmitted // posted before a crash
+	rows[1].Status = repository.PayoutRowSubmitted // never reached the ledger
+
+	settled, pending, err := svc.preparePayoutRows(context.Background(), batch, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(settled) != 2 || len(pending) != 1 {
+		t.Fatalf("settled %d and left %d pending, want 2 and 1", len(settled), len(pending))
+	}
+	if row := settled[0]; row.Line != 2 || row.Status != repository.PayoutRowPaid || *row.TransactionID != "txn-earlier" {
+		t.Errorf("posted row settled as line %d %s, want line 2 PAID as txn-earlier", row.Line, row.Status)
+	}
+	if row := pending[0]; row.Line != 3 {
+		t.Errorf("pending row is line %d, want 3", row.Line)
+	}
+	// 35 covers line 3's 20 but not line 4's 30 on top of it.
+	if row := settled[1]; row.Line != 4 || row.Status != repository.PayoutRowFailed || *row.Error != payoutInsufficientFunds {
+		t.Errorf("uncovered row settled as line %d %s, want line 4 FAILED", row.Line, row.Status)
+	}
+	if ledger.posts != 0 {
+		t.Errorf("preparing posted %d payouts", ledger.posts)
+	}
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/notifier"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
+	"github.com/ChotongW/grit_demo_wallet/pkg/mask"
+
+	"github.com/google/uuid"
+)
+
+const (
+	emailChangeTokenTTL = 24 * time.Hour
+	maxNameLength       = 100
+	maxAddressLength    = 500
+)
+
+var (
+	phonePattern  = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+)
+
+func (s *Service) GetUser(ctx context.Context, userID string) (*repository.User, error) {
+	return s.repo.GetUser(ctx, userID)
+}
+
+// UpdateProfile changes the profile fields set in update; see
+// repository.ProfileUpdate.
+func (s *Service) UpdateProfile(ctx context.Context, userID string, update repository.ProfileUpdate) (*repository.User, error) {
+	for _, field := range []*string{update.Name, update.Phone, update.Address, update.Locale} {
+		if field != nil {
+			*field = strings.TrimSpace(*field)
+		}
+	}
+
+	if update.Name != nil && len([]rune(*update.Name)) > maxNameLength {
+		return nil, accountErrors.ErrInvalidName
+	}
+	if update.Phone != nil && *update.Phone != "" && !phonePattern.MatchString(*update.Phone) {
+		return nil, fmt.Errorf("%w: %q", accountErrors.ErrInvalidPhone, *update.Phone)
+	}
+	if update.Address != nil && len([]rune(*update.Address)) > maxAddressLength {
+		return nil, accountErrors.ErrInvalidAddress
+	}
+	if update.Locale != nil && *update.Locale != "" && !localePattern.MatchString(*update.Locale) {
+		return nil, fmt.Errorf("%w: %q", accountErrors.ErrInvalidLocale, *update.Locale)
+	}
+
+	return s.repo.UpdateProfile(ctx, userID, update)
+}
+
+// RequestEmailChange sends a verification token to newEmail. The email only
+// changes once the token is confirmed with ConfirmEmailChange.
+func (s *Service) RequestEmailChange(ctx context.Context, userID, newEmail string) (time.Time, error) {
+	addr, err := mail.ParseAddress(strings.TrimSpace(newEmail))
+	if err != nil || addr.Address != strings.TrimSpace(newEmail) {
+		return time.Time{}, fmt.Errorf("%w: %q", accountErrors.ErrInvalidEmail, newEmail)
+	}
+	newEmail = addr.Address
+
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if strings.EqualFold(user.Email, newEmail) {
+		return time.Time{}, fmt.Errorf("%w: already the user's email", accountErrors.ErrInvalidEmail)
+	}
+
+	// Checked again, under a lock on the address, when the change is
+	// confirmed; this only saves sending a token that cannot be used.
+	inUse, err := s.repo.EmailInUse(ctx, newEmail)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if inUse {
+		return time.Time{}, fmt.Errorf("%w: %s", accountErrors.ErrEmailAlreadyExists, newEmail)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	req := &repository.EmailChangeRequest{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		NewEmail:  newEmail,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().UTC().Add(emailChangeTokenTTL),
+	}
+	if err := s.repo.CreateEmailChangeRequest(ctx, req); err != nil {
+		return time.Time{}, err
+	}
+
+	err = s.channels.Notify(ctx, notifier.ChannelEmail, notifier.Message{
+		To:      newEmail,
+		Subject: "Confirm your new email address",
+		Body:    fmt.Sprintf("Use this code to confirm your new email address: %s. It expires at %s.", token, req.ExpiresAt.Format(time.RFC3339)),
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to send email change token: %w", err)
+	}
+
+	s.logger.Infof("Email change requested for user %s to %s", userID, mask.Email(newEmail))
+	return req.ExpiresAt, nil
+}
+
+// ConfirmEmailChange completes the email change token was issued for and
+// tells the previous address about it.
+func (s *Service) ConfirmEmailChange(ctx context.Context, token string) (*repository.User, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, accountErrors.ErrInvalidEmailChangeToken
+	}
+
+	user, oldEmail, err := s.repo.ConfirmEmailChange(ctx, hashToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.channels.Notify(ctx, notifier.ChannelEmail, notifier.Message{
+		To:      oldEmail,
+		Subject: "Your email address was changed",
+		Body:    fmt.Sprintf("The email address of your wallet was changed to %s. If you did not do this, contact support.", mask.Email(user.Email)),
+	})
+	if err != nil {
+		s.logger.Errorf("Failed to notify %s of email change: %v", mask.Email(oldEmail), err)
+	}
+
+	s.logger.Infof("Email of user %s changed", user.UserID)
+	return user, nil
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
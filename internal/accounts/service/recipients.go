@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/fees"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
+	"github.com/ChotongW/grit_demo_wallet/pkg/mask"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	RecipientEmail  = "EMAIL"
+	RecipientHandle = "HANDLE"
+)
+
+var handlePattern = regexp.MustCompile(`^[a-z][a-z0-9_.]{2,29}$`)
+
+// Recipient is an account resolved from an email or handle, with what may
+// be shown to the sender to confirm it.
+type Recipient struct {
+	AccountID   string
+	Type        string
+	DisplayName string
+	MaskedEmail string
+	Handle      string
+}
+
+// normalizeHandle strips a leading @ and lowercases handle.
+func normalizeHandle(handle string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(handle), "@"))
+}
+
+// ResolveRecipient finds the USER account an alias refers to. An alias
+// with an @ after its first character is an email; anything else, with or
+// without a leading @, is a handle.
+func (s *Service) ResolveRecipient(ctx context.Context, alias string) (*Recipient, error) {
+	alias = strings.TrimSpace(alias)
+	if alias == "" {
+		return nil, fmt.Errorf("%w: recipient is required", accountErrors.ErrInvalidRecipient)
+	}
+
+	var (
+		account *repository.Account
+		err     error
+		kind    string
+	)
+	if strings.Contains(alias[1:], "@") {
+		kind = RecipientEmail
+		account, err = s.repo.GetUserAccountByEmail(ctx, alias)
+	} else {
+		kind = RecipientHandle
+		handle := normalizeHandle(alias)
+		if !handlePattern.MatchString(handle) {
+			return nil, fmt.Errorf("%w: %q is neither an email nor a handle", accountErrors.ErrInvalidRecipient, alias)
+		}
+		account, err = s.repo.GetUserAccountByHandle(ctx, handle)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	recipient := &Recipient{AccountID: account.AccountID, Type: kind, DisplayName: displayName(account)}
+	if account.Email != nil {
+		recipient.MaskedEmail = mask.Email(*account.Email)
+	}
+	if account.Handle != nil {
+		recipient.Handle = *account.Handle
+	}
+
+	return recipient, nil
+}
+
+// displayName is how an account is shown to other users: its handle if it
+// has one, otherwise its owner's masked email.
+func displayName(account *repository.Account) string {
+	switch {
+	case account.Handle != nil:
+		return "@" + *account.Handle
+	case account.Email != nil:
+		return mask.Email(*account.Email)
+	}
+	return account.AccountID
+}
+
+// TransferToRecipient transfers to the account alias resolves to.
+func (s *Service) TransferToRecipient(ctx context.Context, fromAccountID, alias string, amount decimal.Decimal, description string) (*Recipient, string, decimal.Decimal, *fees.Quote, error) {
+	recipient, err := s.ResolveRecipient(ctx, alias)
+	if err != nil {
+		return nil, "", decimal.Zero, nil, err
+	}
+
+	transactionID, newBalance, quote, err := s.Transfer(ctx, fromAccountID, recipient.AccountID, amount, description)
+	if err != nil {
+		return nil, "", decimal.Zero, nil, err
+	}
+
+	return recipient, transactionID, newBalance, quote, nil
+}
+
+// SetHandle sets the handle of a USER account. An empty handle removes it.
+func (s *Service) SetHandle(ctx context.Context, accountID, handle string) (*repository.Account, error) {
+	handle = normalizeHandle(handle)
+	if handle != "" && !handlePattern.MatchString(handle) {
+		return nil, fmt.Errorf("%w: %q", accountErrors.ErrInvalidHandle, handle)
+	}
+
+	account, err := s.repo.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.AccountType != "USER" {
+		return nil, fmt.Errorf("%w: only user accounts have handles", accountErrors.ErrInvalidHandle)
+	}
+
+	account, err = s.repo.SetHandle(ctx, accountID, handle)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Infof("Account %s handle set to %q", accountID, handle)
+	return account, nil
+}
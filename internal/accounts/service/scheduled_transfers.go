@@ -0,0 +1,387 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/schedule"
+	"github.com/ChotongW/grit_demo_wallet/pkg/apperror"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// What the worker does when the payer cannot cover an occurrence: RETRY
+// tries again every retry interval up to max_retries times before skipping
+// the occurrence, SKIP skips it straight away.
+const (
+	PolicyRetry = "RETRY"
+	PolicySkip  = "SKIP"
+)
+
+const (
+	defaultMaxRetries    = 3
+	defaultRetryInterval = time.Hour
+	minRetryInterval     = time.Minute
+
+	// minScheduleInterval keeps recurring transfers from being used as a
+	// high-frequency payment loop.
+	minScheduleInterval = time.Hour
+
+	schedulerBatchSize = 50
+	schedulerLease     = 5 * time.Minute
+
+	// maxInternalAttempts bounds how often an occurrence is retried after
+	// errors of our own, e.g. the ledger being unreachable, before it fails.
+	maxInternalAttempts = 10
+)
+
+type ScheduledTransferRequest struct {
+	FromAccountID           string
+	ToAccountID             string
+	Amount                  decimal.Decimal
+	Description             string
+	ScheduleKind            string // inferred from Schedule when empty
+	Schedule                string
+	StartAt                 time.Time // now when zero
+	EndAt                   *time.Time
+	MaxOccurrences          *int
+	InsufficientFundsPolicy string
+	MaxRetries              *int
+	RetryInterval           time.Duration
+}
+
+func (s *Service) CreateScheduledTransfer(ctx context.Context, req ScheduledTransferRequest) (*repository.ScheduledTransfer, error) {
+	if req.Amount.LessThanOrEqual(decimal.Zero) {
+		return nil, accountErrors.ErrTransferAmountMustBePositive
+	}
+	if req.FromAccountID == req.ToAccountID {
+		return nil, accountErrors.ErrTransferToSameAccount
+	}
+
+	if _, err := s.repo.GetAccount(ctx, req.FromAccountID); err != nil {
+		return nil, fmt.Errorf("source %w", err)
+	}
+	toExists, err := s.repo.AccountExists(ctx, req.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if !toExists {
+		return nil, fmt.Errorf("destination %w: %s", accountErrors.ErrAccountNotFound, req.ToAccountID)
+	}
+
+	st := &repository.ScheduledTransfer{
+		ID:                      uuid.New().String(),
+		FromAccountID:           req.FromAccountID,
+		ToAccountID:             req.ToAccountID,
+		Amount:                  req.Amount,
+		Description:             req.Description,
+		ScheduleKind:            strings.ToUpper(req.ScheduleKind),
+		ScheduleExpr:            strings.TrimSpace(req.Schedule),
+		StartAt:                 req.StartAt.UTC().Truncate(time.Minute),
+		EndAt:                   req.EndAt,
+		MaxOccurrences:          req.MaxOccurrences,
+		InsufficientFundsPolicy: strings.ToUpper(req.InsufficientFundsPolicy),
+		MaxRetries:              defaultMaxRetries,
+		RetryIntervalSeconds:    int(defaultRetryInterval / time.Second),
+		Status:                  repository.ScheduleStatusActive,
+	}
+
+	if st.ScheduleKind == "" {
+		switch {
+		case st.ScheduleExpr == "":
+			st.ScheduleKind = schedule.KindOnce
+		case strings.HasPrefix(strings.ToUpper(st.ScheduleExpr), "FREQ=") || strings.HasPrefix(strings.ToUpper(st.ScheduleExpr), "RRULE:"):
+			st.ScheduleKind = schedule.KindRRule
+		default:
+			st.ScheduleKind = schedule.KindCron
+		}
+	}
+
+	now := time.Now().UTC()
+	if req.StartAt.IsZero() {
+		st.StartAt = now.Truncate(time.Minute)
+	} else if st.StartAt.Before(now.Add(-time.Minute)) {
+		return nil, fmt.Errorf("%w: start_at is in the past", accountErrors.ErrInvalidSchedule)
+	}
+
+	first, err := firstOccurrence(st)
+	if err != nil {
+		return nil, err
+	}
+	st.DueAt = &first
+
+	if st.InsufficientFundsPolicy == "" {
+		st.InsufficientFundsPolicy = PolicyRetry
+	}
+	if st.InsufficientFundsPolicy != PolicyRetry && st.InsufficientFundsPolicy != PolicySkip {
+		return nil, fmt.Errorf("%w: %q", accountErrors.ErrInvalidSchedulePolicy, req.InsufficientFundsPolicy)
+	}
+	if req.MaxRetries != nil {
+		if *req.MaxRetries < 0 {
+			return nil, fmt.Errorf("%w: max_retries must not be negative", accountErrors.ErrInvalidSchedulePolicy)
+		}
+		st.MaxRetries = *req.MaxRetries
+	}
+	if req.RetryInterval != 0 {
+		if req.RetryInterval < minRetryInterval {
+			return nil, fmt.Errorf("%w: retry interval must be at least %s", accountErrors.ErrInvalidSchedulePolicy, minRetryInterval)
+		}
+		st.RetryIntervalSeconds = int(req.RetryInterval / time.Second)
+	}
+
+	if err := s.repo.CreateScheduledTransfer(ctx, st); err != nil {
+		return nil, err
+	}
+
+	s.logger.Infof("Scheduled transfer %s from %s to %s (%s %q), first run at %s", st.ID, st.FromAccountID, st.ToAccountID, st.ScheduleKind, st.ScheduleExpr, first.Format(time.RFC3339))
+	return st, nil
+}
+
+// firstOccurrence validates the schedule of st and returns its first
+// occurrence.
+func firstOccurrence(st *repository.ScheduledTransfer) (time.Time, error) {
+	if st.ScheduleKind == schedule.KindOnce && st.ScheduleExpr != "" {
+		return time.Time{}, fmt.Errorf("%w: a ONCE schedule takes no expression", accountErrors.ErrInvalidSchedule)
+	}
+	if st.MaxOccurrences != nil && *st.MaxOccurrences <= 0 {
+		return time.Time{}, fmt.Errorf("%w: max_occurrences must be positive", accountErrors.ErrInvalidSchedule)
+	}
+
+	sched, err := schedule.Parse(st.ScheduleKind, st.ScheduleExpr, st.StartAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", accountErrors.ErrInvalidSchedule, err)
+	}
+
+	first, ok := schedule.First(sched, st.StartAt)
+	if !ok || (st.EndAt != nil && first.After(*st.EndAt)) {
+		return time.Time{}, fmt.Errorf("%w: the schedule never occurs", accountErrors.ErrInvalidSchedule)
+	}
+
+	if second, ok := sched.Next(first); ok && second.Sub(first) < minScheduleInterval {
+		return time.Time{}, fmt.Errorf("%w: occurrences must be at least %s apart", accountErrors.ErrInvalidSchedule, minScheduleInterval)
+	}
+
+	return first, nil
+}
+
+func (s *Service) ListScheduledTransfers(ctx context.Context, accountID, status string) ([]repository.ScheduledTransfer, error) {
+	exists, err := s.repo.AccountExists(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", accountErrors.ErrAccountNotFound, accountID)
+	}
+
+	return s.repo.ListScheduledTransfers(ctx, accountID, strings.ToUpper(status))
+}
+
+// scheduledTransferOf returns the schedule id paid from accountID. A
+// schedule of another account is reported as not found.
+func (s *Service) scheduledTransferOf(ctx context.Context, accountID, id string) (*repository.ScheduledTransfer, error) {
+	st, err := s.repo.GetScheduledTransfer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if st.FromAccountID != accountID {
+		return nil, fmt.Errorf("%w: %s", accountErrors.ErrScheduledTransferNotFound, id)
+	}
+	return st, nil
+}
+
+func (s *Service) PauseScheduledTransfer(ctx context.Context, accountID, id string) (*repository.ScheduledTransfer, error) {
+	st, err := s.scheduledTransferOf(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.setScheduledTransferStatus(ctx, st, []string{repository.ScheduleStatusActive}, repository.ScheduleStatusPaused, st.DueAt)
+}
+
+// ResumeScheduledTransfer reactivates a paused schedule. Occurrences that
+// fell due while it was paused are not made up, except that a one-off
+// transfer whose time has passed runs straight away.
+func (s *Service) ResumeScheduledTransfer(ctx context.Context, accountID, id string) (*repository.ScheduledTransfer, error) {
+	st, err := s.scheduledTransferOf(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	due := st.DueAt
+	if due != nil && due.Before(now) {
+		if st.ScheduleKind == schedule.KindOnce {
+			due = &now
+		} else {
+			due = nextOccurrence(st, st.Occurrences, now)
+		}
+	}
+
+	status := repository.ScheduleStatusActive
+	if due == nil {
+		status = repository.ScheduleStatusCompleted
+	}
+
+	return s.setScheduledTransferStatus(ctx, st, []string{repository.ScheduleStatusPaused}, status, due)
+}
+
+func (s *Service) CancelScheduledTransfer(ctx context.Context, accountID, id string) (*repository.ScheduledTransfer, error) {
+	st, err := s.scheduledTransferOf(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.setScheduledTransferStatus(ctx, st,
+		[]string{repository.ScheduleStatusActive, repository.ScheduleStatusPaused},
+		repository.ScheduleStatusCancelled, nil)
+}
+
+func (s *Service) setScheduledTransferStatus(ctx context.Context, st *repository.ScheduledTransfer, from []string, status string, due *time.Time) (*repository.ScheduledTransfer, error) {
+	updated, err := s.repo.SetScheduledTransferStatus(ctx, st.ID, from, status, due)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, fmt.Errorf("%w: %s is %s", accountErrors.ErrInvalidScheduleState, st.ID, st.Status)
+	}
+
+	s.logger.Infof("Scheduled transfer %s is now %s", st.ID, updated.Status)
+	return updated, nil
+}
+
+// RunScheduler executes due scheduled transfers every interval until ctx
+// is done. Several replicas may run it at once.
+func (s *Service) RunScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ExecuteDueTransfers(ctx, time.Now().UTC()); err != nil && ctx.Err() == nil {
+			s.logger.Errorf("failed to execute scheduled transfers: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExecuteDueTransfers runs one batch of scheduled transfers due at now and
+// returns how many it picked up.
+func (s *Service) ExecuteDueTransfers(ctx context.Context, now time.Time) (int, error) {
+	due, err := s.repo.ClaimDueScheduledTransfers(ctx, now, schedulerLease, schedulerBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range due {
+		if err := s.executeScheduledTransfer(ctx, &due[i], now); err != nil {
+			// The lease expires and another run picks the schedule up again.
+			s.logger.Errorf("failed to record scheduled transfer %s: %v", due[i].ID, err)
+		}
+	}
+
+	return len(due), nil
+}
+
+// executeScheduledTransfer attempts the current occurrence of st. The
+// ledger reference is derived from the occurrence number, so an attempt
+// repeated after a crash finds the earlier posting instead of paying twice.
+func (s *Service) executeScheduledTransfer(ctx context.Context, st *repository.ScheduledTransfer, now time.Time) error {
+	occurrence := st.Occurrences + 1
+	attempts := st.Attempts + 1
+	refID := fmt.Sprintf("scheduled-%s-%d", st.ID, occurrence)
+	retryAt := now.Add(time.Duration(st.RetryIntervalSeconds) * time.Second)
+
+	transactionID, err := s.transactionByReference(ctx, refID)
+	if err == nil && transactionID == "" {
+		description := st.Description
+		if description == "" {
+			description = fmt.Sprintf("Scheduled transfer from %s to %s", st.FromAccountID, st.ToAccountID)
+		}
+		transactionID, _, _, err = s.transfer(ctx, st.FromAccountID, st.ToAccountID, st.Amount, description, refID)
+	}
+
+	run := repository.ScheduledTransferRun{
+		ScheduleID:   st.ID,
+		Occurrence:   occurrence,
+		ScheduledFor: *st.DueAt,
+		Attempts:     attempts,
+	}
+
+	switch {
+	case err == nil:
+		run.Status = repository.ScheduledRunSucceeded
+		run.TransactionID = transactionID
+
+	case errors.Is(err, accountErrors.ErrInsufficientBalance):
+		if st.InsufficientFundsPolicy == PolicyRetry && attempts <= st.MaxRetries {
+			s.logger.Infof("Scheduled transfer %s occurrence %d: insufficient funds, retrying at %s", st.ID, occurrence, retryAt.Format(time.RFC3339))
+			return s.repo.DeferScheduledTransfer(ctx, st.ID, attempts, retryAt, err.Error())
+		}
+		run.Status = repository.ScheduledRunSkipped
+		run.Error = err.Error()
+
+	case apperror.KindOf(err) != apperror.KindInternal:
+		// Retrying will not help, e.g. an account was closed.
+		run.Status = repository.ScheduledRunFailed
+		run.Error = err.Error()
+
+	case attempts < maxInternalAttempts:
+		s.logger.Errorf("Scheduled transfer %s occurrence %d, attempt %d: %v", st.ID, occurrence, attempts, err)
+		return s.repo.DeferScheduledTransfer(ctx, st.ID, attempts, retryAt, err.Error())
+
+	default:
+		s.logger.Errorf("Scheduled transfer %s occurrence %d failed after %d attempts: %v", st.ID, occurrence, attempts, err)
+		run.Status = repository.ScheduledRunFailed
+		run.Error = err.Error()
+	}
+
+	next := nextOccurrence(st, occurrence, now)
+	if err := s.repo.SettleScheduledTransferOccurrence(ctx, run, next); err != nil {
+		return err
+	}
+
+	s.logger.Infof("Scheduled transfer %s occurrence %d: %s", st.ID, occurrence, run.Status)
+	return nil
+}
+
+// nextOccurrence returns the occurrence that follows the current one once
+// settled occurrences are done, or nil if the schedule is finished. When
+// the worker has fallen behind by more than one occurrence, the missed
+// ones are skipped rather than paid in a burst.
+func nextOccurrence(st *repository.ScheduledTransfer, settled int, now time.Time) *time.Time {
+	if st.MaxOccurrences != nil && settled >= *st.MaxOccurrences {
+		return nil
+	}
+	if st.ScheduleKind == schedule.KindOnce && settled > 0 {
+		return nil
+	}
+
+	sched, err := schedule.Parse(st.ScheduleKind, st.ScheduleExpr, st.StartAt)
+	if err != nil {
+		return nil
+	}
+
+	after := now
+	if st.DueAt != nil {
+		after = *st.DueAt
+	}
+	next, ok := sched.Next(after)
+	if ok && next.Before(now) {
+		next, ok = sched.Next(now)
+	}
+	if !ok || (st.EndAt != nil && next.After(*st.EndAt)) {
+		return nil
+	}
+
+	return &next
+}
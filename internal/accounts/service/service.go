@@ -3,35 +3,100 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/fees"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/notifications"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/notifier"
 	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/statement"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/webhooks"
 	pbSub "github.com/ChotongW/grit_demo_wallet/pb/subledger"
+	"github.com/ChotongW/grit_demo_wallet/pkg/rpcerror"
 
 	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
-	ReferralFundingPoolAccount     = "1001"
-	InstitutionMainAccount         = "1002"
-	InstitutionDisbursementAccount = "1003"
-	PSPAccount                     = "1004"
-	ReferralRewardAmount           = "10.00"
+	ReferralRewardAmount = "10.00"
+
+	// CustomerWalletClass is the chart of accounts class of user wallets:
+	// money held on behalf of a customer is a liability of the institution.
+	CustomerWalletClass = "LIABILITY"
 )
 
+// Transaction is one ledger entry of an account as seen in its history.
+type Transaction struct {
+	ID            string
+	TransactionID string
+	AccountID     string
+	Amount        decimal.Decimal
+	Direction     string
+	ReferenceID   string
+	Description   string
+	CreatedAt     time.Time
+	// Counterparties are the accounts on the opposite side of the posting.
+	Counterparties []Counterparty
+}
+
+type Counterparty struct {
+	AccountID   string
+	AccountType string
+	Email       *string
+}
+
+// HistoryFilter narrows a transaction history listing. Zero values mean
+// "no constraint".
+type HistoryFilter struct {
+	From            *time.Time
+	To              *time.Time
+	Direction       string
+	MinAmount       *decimal.Decimal
+	MaxAmount       *decimal.Decimal
+	ReferencePrefix string
+	Search          string
+}
+
+type TransactionLeg struct {
+	ID        string
+	AccountID string
+	Amount    decimal.Decimal
+	Direction string
+}
+
+type TransactionDetail struct {
+	TransactionID            string
+	ReferenceID              string
+	Description              string
+	CreatedAt                string
+	Legs                     []TransactionLeg
+	Counterparties           []Counterparty
+	ReversesTransactionID    string
+	ReversedByTransactionIDs []string
+}
+
 type Service struct {
 	repo            *repository.Repository
 	subledgerClient pbSub.SubledgerServiceClient
+	channels        notifier.Channels
+	webhooks        *webhooks.Client
+	systemAccounts  systemAccountCache
 	logger          *logrus.Entry
 }
 
-func NewService(repo *repository.Repository, subledgerClient pbSub.SubledgerServiceClient, logger *logrus.Logger) *Service {
+func NewService(repo *repository.Repository, subledgerClient pbSub.SubledgerServiceClient, channels notifier.Channels, logger *logrus.Logger) *Service {
 	return &Service{
 		repo:            repo,
 		subledgerClient: subledgerClient,
+		channels:        channels,
+		webhooks:        webhooks.NewClient(),
 		logger: logger.WithFields(logrus.Fields{
 			"package": "accounts/service",
 		}),
@@ -54,16 +119,27 @@ func (s *Service) CreateAccount(ctx context.Context, email string, initialBalanc
 		return nil, err
 	}
 
+	if err := s.registerWallet(ctx, account.AccountID); err != nil {
+		s.discardAccount(ctx, account.AccountID)
+		return nil, err
+	}
+	s.publishAccountCreated(ctx, account)
+
 	if initialBalance.GreaterThan(decimal.Zero) {
+		pspAccount, err := s.systemAccount(ctx, RolePSP)
+		if err != nil {
+			return nil, err
+		}
+
 		refID := fmt.Sprintf("initial-deposit-%s", account.AccountID)
 		desc := fmt.Sprintf("Initial deposit for account %s", account.AccountID)
 
-		_, err = s.subledgerClient.CreateTransaction(ctx, &pbSub.CreateTransactionRequest{
+		req := &pbSub.CreateTransactionRequest{
 			ReferenceId: refID,
 			Description: desc,
 			Entries: []*pbSub.Entry{
 				{
-					AccountId: PSPAccount,
+					AccountId: pspAccount,
 					Amount:    initialBalance.String(),
 					Direction: "DEBIT",
 				},
@@ -73,14 +149,22 @@ func (s *Service) CreateAccount(ctx context.Context, email string, initialBalanc
 					Direction: "CREDIT",
 				},
 			},
-		})
+		}
+		resp, err := s.subledgerClient.CreateTransaction(ctx, req)
 
 		if err != nil {
 			s.logger.Errorf("Failed to create initial balance transaction: %v", err)
 			return nil, fmt.Errorf("failed to create initial balance: %w", err)
 		}
+		s.publishPosted(ctx, resp.TransactionId, req)
 
 		s.logger.Infof("Created initial balance %s for account %s", initialBalance.String(), account.AccountID)
+		s.notifyMovement(ctx, movement{
+			event:         notifications.EventDeposit,
+			accountID:     account.AccountID,
+			transactionID: resp.TransactionId,
+			amount:        initialBalance,
+		})
 	}
 
 	if referrerAccountID != "" {
@@ -93,17 +177,88 @@ func (s *Service) CreateAccount(ctx context.Context, email string, initialBalanc
 	return account, nil
 }
 
+// registerWallet adds a wallet to the subledger's chart of accounts. The
+// subledger rejects postings to accounts missing from it, so this must
+// happen before anything is posted. A wallet already registered is fine.
+func (s *Service) registerWallet(ctx context.Context, accountID string) error {
+	_, err := s.subledgerClient.CreateLedgerAccount(ctx, &pbSub.CreateLedgerAccountRequest{
+		AccountId: accountID,
+		Name:      "Customer Wallet",
+		Class:     CustomerWalletClass,
+	})
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		return fmt.Errorf("failed to register ledger account: %w", err)
+	}
+	return nil
+}
+
+// discardAccount removes an account whose wallet could not be registered,
+// so that opening it can simply be retried. Should that fail too, the
+// wallet is registered by the next RegisterWallets.
+func (s *Service) discardAccount(ctx context.Context, accountID string) {
+	if err := s.repo.DeleteNewAccount(ctx, accountID); err != nil {
+		s.logger.Errorf("failed to discard unregistered account %s: %v", accountID, err)
+	}
+}
+
+// RegisterWallets registers every wallet missing from the subledger's chart
+// of accounts, such as wallets opened before it existed or whose
+// registration failed, and returns how many it registered.
+func (s *Service) RegisterWallets(ctx context.Context) (int, error) {
+	ids, err := s.repo.ListUserAccountIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	const pageSize = 100
+	registered := make(map[string]bool, len(ids))
+	for page := 1; ; page++ {
+		resp, err := s.subledgerClient.ListLedgerAccounts(ctx, &pbSub.ListLedgerAccountsRequest{
+			Class:    CustomerWalletClass,
+			Page:     int32(page),
+			PageSize: pageSize,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to list ledger accounts: %w", err)
+		}
+		for _, account := range resp.Accounts {
+			registered[account.AccountId] = true
+		}
+		if len(resp.Accounts) < pageSize {
+			break
+		}
+	}
+
+	count := 0
+	for _, id := range ids {
+		if registered[id] {
+			continue
+		}
+		if err := s.registerWallet(ctx, id); err != nil {
+			return count, fmt.Errorf("wallet %s: %w", id, err)
+		}
+		count++
+	}
+
+	return count, nil
+}
+
 func (s *Service) giveReferralReward(ctx context.Context, referrerAccountID, newAccountID string) error {
+	poolAccount, err := s.systemAccount(ctx, RoleReferralPool)
+	if err != nil {
+		return err
+	}
+
 	rewardAmount, _ := decimal.NewFromString(ReferralRewardAmount)
 	refID := fmt.Sprintf("referral-reward-%s-%s", referrerAccountID, newAccountID)
 	desc := fmt.Sprintf("Referral reward for referring account %s", newAccountID)
 
-	_, err := s.subledgerClient.CreateTransaction(ctx, &pbSub.CreateTransactionRequest{
+	req := &pbSub.CreateTransactionRequest{
 		ReferenceId: refID,
 		Description: desc,
 		Entries: []*pbSub.Entry{
 			{
-				AccountId: ReferralFundingPoolAccount,
+				AccountId: poolAccount,
 				Amount:    rewardAmount.String(),
 				Direction: "DEBIT",
 			},
@@ -113,13 +268,21 @@ func (s *Service) giveReferralReward(ctx context.Context, referrerAccountID, new
 				Direction: "CREDIT",
 			},
 		},
-	})
+	}
+	resp, err := s.subledgerClient.CreateTransaction(ctx, req)
 
 	if err != nil {
 		return fmt.Errorf("failed to create referral reward transaction: %w", err)
 	}
+	s.publishPosted(ctx, resp.TransactionId, req)
 
 	s.logger.Infof("Gave referral reward %s to account %s", rewardAmount.String(), referrerAccountID)
+	s.notifyMovement(ctx, movement{
+		event:         notifications.EventReferralReward,
+		accountID:     referrerAccountID,
+		transactionID: resp.TransactionId,
+		amount:        rewardAmount,
+	})
 	return nil
 }
 
@@ -130,7 +293,7 @@ func (s *Service) GetAccount(ctx context.Context, accountID string) (*repository
 		return nil, decimal.Zero, err
 	}
 
-	balance, err := s.repo.GetBalance(ctx, accountID)
+	balance, err := s.balance(ctx, accountID)
 	if err != nil {
 		return nil, decimal.Zero, err
 	}
@@ -147,7 +310,85 @@ func (s *Service) GetBalance(ctx context.Context, accountID string) (decimal.Dec
 		return decimal.Zero, fmt.Errorf("%w: %s", accountErrors.ErrAccountNotFound, accountID)
 	}
 
-	return s.repo.GetBalance(ctx, accountID)
+	return s.balance(ctx, accountID)
+}
+
+// balance asks the subledger for the current balance of accountID. An
+// account has no balance in the subledger until its first posting, which
+// counts as zero here.
+func (s *Service) balance(ctx context.Context, accountID string) (decimal.Decimal, error) {
+	return s.balanceAt(ctx, accountID, time.Time{})
+}
+
+// balanceAt is balance as it stood just before asOf; a zero asOf means now.
+func (s *Service) balanceAt(ctx context.Context, accountID string, asOf time.Time) (decimal.Decimal, error) {
+	req := &pbSub.GetBalanceRequest{AccountId: accountID}
+	if !asOf.IsZero() {
+		req.AsOf = asOf.Format(time.RFC3339Nano)
+	}
+
+	resp, err := s.subledgerClient.GetBalance(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return decimal.Zero, nil
+		}
+		return decimal.Zero, fmt.Errorf("failed to get balance for account %s: %w", accountID, err)
+	}
+
+	amount, err := decimal.NewFromString(resp.Amount)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid balance %q for account %s: %w", resp.Amount, accountID, err)
+	}
+
+	return amount, nil
+}
+
+// balancesPerCall is how many accounts balancesAt asks the subledger for at
+// a time, the most GetBalances accepts.
+const balancesPerCall = 1000
+
+// balancesAt returns the balance of each of accountIDs just before asOf, in
+// as few subledger calls as it can.
+func (s *Service) balancesAt(ctx context.Context, accountIDs []string, asOf time.Time) (map[string]decimal.Decimal, error) {
+	balances := make(map[string]decimal.Decimal, len(accountIDs))
+	for start := 0; start < len(accountIDs); start += balancesPerCall {
+		ids := accountIDs[start:min(start+balancesPerCall, len(accountIDs))]
+		resp, err := s.subledgerClient.GetBalances(ctx, &pbSub.GetBalancesRequest{
+			AccountIds: ids,
+			AsOf:       asOf.Format(time.RFC3339Nano),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get balances: %w", err)
+		}
+
+		for _, b := range resp.Balances {
+			amount, err := decimal.NewFromString(b.Amount)
+			if err != nil {
+				return nil, fmt.Errorf("invalid balance %q for account %s: %w", b.Amount, b.AccountId, err)
+			}
+			balances[b.AccountId] = amount
+		}
+	}
+	return balances, nil
+}
+
+// transactionByReference returns the id of the transaction posted under
+// referenceID, or an empty string if there is none. Jobs that post under a
+// deterministic reference check it before posting so that a re-run never
+// posts twice.
+func (s *Service) transactionByReference(ctx context.Context, referenceID string) (string, error) {
+	resp, err := s.subledgerClient.ListEntries(ctx, &pbSub.ListEntriesRequest{
+		ReferenceId: referenceID,
+		PageSize:    1,
+		UseCursor:   true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to look up %s: %w", referenceID, err)
+	}
+	if len(resp.Entries) == 0 {
+		return "", nil
+	}
+	return resp.Entries[0].TransactionId, nil
 }
 
 func (s *Service) Deposit(ctx context.Context, accountID string, amount decimal.Decimal, description string) (string, decimal.Decimal, error) {
@@ -162,17 +403,22 @@ func (s *Service) Deposit(ctx context.Context, accountID string, amount decimal.
 		return "", decimal.Zero, fmt.Errorf("%w: %s", accountErrors.ErrAccountNotFound, accountID)
 	}
 
+	pspAccount, err := s.systemAccount(ctx, RolePSP)
+	if err != nil {
+		return "", decimal.Zero, err
+	}
+
 	refID := fmt.Sprintf("deposit-%s-%s", accountID, uuid.New().String())
 	if description == "" {
 		description = fmt.Sprintf("Deposit to account %s", accountID)
 	}
 
-	resp, err := s.subledgerClient.CreateTransaction(ctx, &pbSub.CreateTransactionRequest{
+	req := &pbSub.CreateTransactionRequest{
 		ReferenceId: refID,
 		Description: description,
 		Entries: []*pbSub.Entry{
 			{
-				AccountId: PSPAccount,
+				AccountId: pspAccount,
 				Amount:    amount.String(),
 				Direction: "DEBIT",
 			},
@@ -182,32 +428,60 @@ func (s *Service) Deposit(ctx context.Context, accountID string, amount decimal.
 				Direction: "CREDIT",
 			},
 		},
-	})
+	}
+	resp, err := s.subledgerClient.CreateTransaction(ctx, req)
 
 	if err != nil {
 		return "", decimal.Zero, fmt.Errorf("failed to create deposit transaction: %w", err)
 	}
+	s.publishPosted(ctx, resp.TransactionId, req)
 
-	newBalance, err := s.repo.GetBalance(ctx, accountID)
+	newBalance, err := s.balance(ctx, accountID)
 	if err != nil {
 		return resp.TransactionId, decimal.Zero, err
 	}
 
 	s.logger.Infof("Deposited %s to account %s, new balance: %s", amount.String(), accountID, newBalance.String())
+	s.notifyMovement(ctx, movement{
+		event:         notifications.EventDeposit,
+		accountID:     accountID,
+		transactionID: resp.TransactionId,
+		amount:        amount,
+	})
 	return resp.TransactionId, newBalance, nil
 }
 
-func (s *Service) Withdraw(ctx context.Context, accountID string, amount decimal.Decimal, description string) (string, decimal.Decimal, error) {
+func (s *Service) Withdraw(ctx context.Context, accountID string, amount decimal.Decimal, description string) (string, decimal.Decimal, *fees.Quote, error) {
 	if amount.LessThanOrEqual(decimal.Zero) {
-		return "", decimal.Zero, accountErrors.ErrWithdrawAmountMustBePositive
+		return "", decimal.Zero, nil, accountErrors.ErrWithdrawAmountMustBePositive
 	}
-	currentBalance, err := s.repo.GetBalance(ctx, accountID)
+	account, err := s.repo.GetAccount(ctx, accountID)
 	if err != nil {
-		return "", decimal.Zero, err
+		return "", decimal.Zero, nil, err
 	}
 
-	if currentBalance.LessThan(amount) {
-		return "", decimal.Zero, fmt.Errorf("%w: have %s, need %s", accountErrors.ErrInsufficientBalance, currentBalance.String(), amount.String())
+	quote, err := s.quoteFee(ctx, account, fees.OperationWithdraw, amount)
+	if err != nil {
+		return "", decimal.Zero, nil, err
+	}
+
+	currentBalance, err := s.balance(ctx, accountID)
+	if err != nil {
+		return "", decimal.Zero, nil, err
+	}
+
+	if currentBalance.LessThan(quote.TotalDebit()) {
+		return "", decimal.Zero, nil, fmt.Errorf("%w: have %s, need %s", accountErrors.ErrInsufficientBalance, currentBalance.String(), quote.TotalDebit().String())
+	}
+
+	pspAccount, err := s.systemAccount(ctx, RolePSP)
+	if err != nil {
+		return "", decimal.Zero, nil, err
+	}
+
+	entries, err := s.feeEntries(ctx, quote, accountID, pspAccount)
+	if err != nil {
+		return "", decimal.Zero, nil, err
 	}
 
 	refID := fmt.Sprintf("withdraw-%s-%s", accountID, uuid.New().String())
@@ -215,106 +489,190 @@ func (s *Service) Withdraw(ctx context.Context, accountID string, amount decimal
 		description = fmt.Sprintf("Withdrawal from account %s", accountID)
 	}
 
-	resp, err := s.subledgerClient.CreateTransaction(ctx, &pbSub.CreateTransactionRequest{
+	req := &pbSub.CreateTransactionRequest{
 		ReferenceId: refID,
 		Description: description,
-		Entries: []*pbSub.Entry{
-			{
-				AccountId: accountID,
-				Amount:    amount.String(),
-				Direction: "DEBIT",
-			},
-			{
-				AccountId: PSPAccount,
-				Amount:    amount.String(),
-				Direction: "CREDIT",
-			},
-		},
-	})
+		Entries:     entries,
+	}
+	resp, err := s.subledgerClient.CreateTransaction(ctx, req)
 
 	if err != nil {
-		return "", decimal.Zero, fmt.Errorf("failed to create withdrawal transaction: %w", err)
+		return "", decimal.Zero, nil, fmt.Errorf("failed to create withdrawal transaction: %w", err)
 	}
+	s.publishPosted(ctx, resp.TransactionId, req)
 
-	newBalance, err := s.repo.GetBalance(ctx, accountID)
+	newBalance, err := s.balance(ctx, accountID)
 	if err != nil {
-		return resp.TransactionId, decimal.Zero, err
+		return resp.TransactionId, decimal.Zero, quote, err
 	}
 
-	s.logger.Infof("Withdrew %s from account %s, new balance: %s", amount.String(), accountID, newBalance.String())
-	return resp.TransactionId, newBalance, nil
+	s.logger.Infof("Withdrew %s (fee %s) from account %s, new balance: %s", amount.String(), quote.Fee.String(), accountID, newBalance.String())
+	s.notifyMovement(ctx, movement{
+		event:         notifications.EventWithdrawal,
+		accountID:     accountID,
+		transactionID: resp.TransactionId,
+		amount:        amount,
+		fee:           quote.Fee,
+	})
+	return resp.TransactionId, newBalance, quote, nil
+}
+
+func (s *Service) Transfer(ctx context.Context, fromAccountID, toAccountID string, amount decimal.Decimal, description string) (string, decimal.Decimal, *fees.Quote, error) {
+	refID := fmt.Sprintf("transfer-%s-%s-%s", fromAccountID, toAccountID, uuid.New().String())
+	return s.transfer(ctx, fromAccountID, toAccountID, amount, description, refID)
 }
 
-func (s *Service) Transfer(ctx context.Context, fromAccountID, toAccountID string, amount decimal.Decimal, description string) (string, decimal.Decimal, error) {
+// transfer posts a transfer under refID. Callers that retry must check the
+// reference with transactionByReference first.
+func (s *Service) transfer(ctx context.Context, fromAccountID, toAccountID string, amount decimal.Decimal, description, refID string) (string, decimal.Decimal, *fees.Quote, error) {
 	if amount.LessThanOrEqual(decimal.Zero) {
-		return "", decimal.Zero, accountErrors.ErrTransferAmountMustBePositive
+		return "", decimal.Zero, nil, accountErrors.ErrTransferAmountMustBePositive
 	}
 
 	if fromAccountID == toAccountID {
-		return "", decimal.Zero, accountErrors.ErrTransferToSameAccount
+		return "", decimal.Zero, nil, accountErrors.ErrTransferToSameAccount
 	}
 
-	fromExists, err := s.repo.AccountExists(ctx, fromAccountID)
+	fromAccount, err := s.repo.GetAccount(ctx, fromAccountID)
 	if err != nil {
-		return "", decimal.Zero, err
-	}
-	if !fromExists {
-		return "", decimal.Zero, fmt.Errorf("source %w: %s", accountErrors.ErrAccountNotFound, fromAccountID)
+		return "", decimal.Zero, nil, fmt.Errorf("source %w", err)
 	}
 
-	toExists, err := s.repo.AccountExists(ctx, toAccountID)
+	toAccount, err := s.repo.GetAccount(ctx, toAccountID)
 	if err != nil {
-		return "", decimal.Zero, err
+		return "", decimal.Zero, nil, fmt.Errorf("destination %w", err)
 	}
-	if !toExists {
-		return "", decimal.Zero, fmt.Errorf("destination %w: %s", accountErrors.ErrAccountNotFound, toAccountID)
+	if fromAccount.Currency != toAccount.Currency {
+		return "", decimal.Zero, nil, fmt.Errorf("%w: cannot transfer %s to a %s wallet", accountErrors.ErrUnsupportedCurrency, fromAccount.Currency, toAccount.Currency)
 	}
 
-	currentBalance, err := s.repo.GetBalance(ctx, fromAccountID)
+	// Moving money between a user's own wallets is free.
+	quote := fees.Calculate(nil, fees.OperationTransfer, fromAccount.Tier, amount)
+	quote.Currency = fromAccount.Currency
+	if !sameOwner(fromAccount, toAccount) {
+		quote, err = s.quoteFee(ctx, fromAccount, fees.OperationTransfer, amount)
+		if err != nil {
+			return "", decimal.Zero, nil, err
+		}
+	}
+
+	currentBalance, err := s.balance(ctx, fromAccountID)
 	if err != nil {
-		return "", decimal.Zero, err
+		return "", decimal.Zero, nil, err
 	}
 
-	if currentBalance.LessThan(amount) {
-		return "", decimal.Zero, fmt.Errorf("%w: have %s, need %s", accountErrors.ErrInsufficientBalance, currentBalance.String(), amount.String())
+	if currentBalance.LessThan(quote.TotalDebit()) {
+		return "", decimal.Zero, nil, fmt.Errorf("%w: have %s, need %s", accountErrors.ErrInsufficientBalance, currentBalance.String(), quote.TotalDebit().String())
+	}
+
+	entries, err := s.feeEntries(ctx, quote, fromAccountID, toAccountID)
+	if err != nil {
+		return "", decimal.Zero, nil, err
 	}
 
-	refID := fmt.Sprintf("transfer-%s-%s-%s", fromAccountID, toAccountID, uuid.New().String())
 	if description == "" {
 		description = fmt.Sprintf("Transfer from %s to %s", fromAccountID, toAccountID)
 	}
 
-	resp, err := s.subledgerClient.CreateTransaction(ctx, &pbSub.CreateTransactionRequest{
+	req := &pbSub.CreateTransactionRequest{
 		ReferenceId: refID,
 		Description: description,
-		Entries: []*pbSub.Entry{
-			{
-				AccountId: fromAccountID,
-				Amount:    amount.String(),
-				Direction: "DEBIT",
-			},
-			{
-				AccountId: toAccountID,
-				Amount:    amount.String(),
-				Direction: "CREDIT",
-			},
-		},
+		Entries:     entries,
+	}
+	resp, err := s.subledgerClient.CreateTransaction(ctx, req)
+
+	if err != nil {
+		return "", decimal.Zero, nil, fmt.Errorf("failed to create transfer transaction: %w", err)
+	}
+	s.publishPosted(ctx, resp.TransactionId, req)
+
+	newBalance, err := s.balance(ctx, fromAccountID)
+	if err != nil {
+		return resp.TransactionId, decimal.Zero, quote, err
+	}
+
+	s.logger.Infof("Transferred %s (fee %s) from %s to %s, new balance: %s", amount.String(), quote.Fee.String(), fromAccountID, toAccountID, newBalance.String())
+	s.notifyMovement(ctx, movement{
+		event:         notifications.EventTransferOut,
+		accountID:     fromAccountID,
+		transactionID: resp.TransactionId,
+		amount:        amount,
+		fee:           quote.Fee,
+		counterparty:  displayName(toAccount),
+	})
+	s.notifyMovement(ctx, movement{
+		event:         notifications.EventTransferIn,
+		accountID:     toAccountID,
+		transactionID: resp.TransactionId,
+		amount:        amount,
+		counterparty:  displayName(fromAccount),
 	})
+	return resp.TransactionId, newBalance, quote, nil
+}
+
+// QuoteFee returns the fee accountID would pay for operation on amount,
+// without posting anything.
+func (s *Service) QuoteFee(ctx context.Context, accountID, operation string, amount decimal.Decimal) (*fees.Quote, error) {
+	if !fees.IsSupportedOperation(operation) {
+		return nil, fmt.Errorf("%w: %q", accountErrors.ErrUnsupportedFeeOperation, operation)
+	}
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return nil, fmt.Errorf("%w: %s", accountErrors.ErrInvalidAmount, amount.String())
+	}
 
+	account, err := s.repo.GetAccount(ctx, accountID)
 	if err != nil {
-		return "", decimal.Zero, fmt.Errorf("failed to create transfer transaction: %w", err)
+		return nil, err
 	}
 
-	newBalance, err := s.repo.GetBalance(ctx, fromAccountID)
+	return s.quoteFee(ctx, account, operation, amount)
+}
+
+func (s *Service) quoteFee(ctx context.Context, account *repository.Account, operation string, amount decimal.Decimal) (*fees.Quote, error) {
+	rules, err := s.repo.ListFeeRules(ctx, operation)
 	if err != nil {
-		return resp.TransactionId, decimal.Zero, err
+		return nil, err
 	}
 
-	s.logger.Infof("Transferred %s from %s to %s, new balance: %s", amount.String(), fromAccountID, toAccountID, newBalance.String())
-	return resp.TransactionId, newBalance, nil
+	quote := fees.Calculate(rules, operation, account.Tier, amount)
+	quote.Currency = account.Currency
+	return quote, nil
+}
+
+// feeEntries builds the legs of a fee-bearing posting: the payer is debited
+// the amount plus the fee, the beneficiary is credited the amount and the
+// fee, if any, is credited to the fee income account in the same
+// transaction.
+func (s *Service) feeEntries(ctx context.Context, quote *fees.Quote, payerAccountID, beneficiaryAccountID string) ([]*pbSub.Entry, error) {
+	entries := []*pbSub.Entry{
+		{
+			AccountId: payerAccountID,
+			Amount:    quote.TotalDebit().String(),
+			Direction: "DEBIT",
+		},
+		{
+			AccountId: beneficiaryAccountID,
+			Amount:    quote.Amount.String(),
+			Direction: "CREDIT",
+		},
+	}
+
+	if quote.Fee.IsPositive() {
+		feeAccount, err := s.systemAccount(ctx, RoleFeeIncome)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &pbSub.Entry{
+			AccountId: feeAccount,
+			Amount:    quote.Fee.String(),
+			Direction: "CREDIT",
+		})
+	}
+
+	return entries, nil
 }
 
-func (s *Service) GetTransactionHistory(ctx context.Context, accountID string, page, pageSize int) ([]repository.Transaction, int, error) {
+func (s *Service) GetTransactionHistory(ctx context.Context, accountID string, filter HistoryFilter, page, pageSize int) ([]Transaction, int, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -322,5 +680,290 @@ func (s *Service) GetTransactionHistory(ctx context.Context, accountID string, p
 		pageSize = 20
 	}
 
-	return s.repo.GetTransactionHistory(ctx, accountID, page, pageSize)
+	req := listEntriesRequest(accountID, filter)
+	req.Page = int32(page)
+	req.PageSize = int32(pageSize)
+
+	resp, err := s.subledgerClient.ListEntries(ctx, req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list ledger entries: %w", err)
+	}
+
+	transactions, err := s.toTransactions(ctx, resp.Entries)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return transactions, int(resp.TotalCount), nil
+}
+
+// ListTransactionHistory returns the page of history that follows cursor
+// (an empty cursor starts from the newest entry), together with the cursor
+// for the next page. The returned cursor is empty when there are no more rows.
+// Cursors are issued by the subledger and passed through unchanged.
+func (s *Service) ListTransactionHistory(ctx context.Context, accountID string, filter HistoryFilter, cursor string, pageSize int) ([]Transaction, string, error) {
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	req := listEntriesRequest(accountID, filter)
+	req.Cursor = cursor
+	req.UseCursor = true
+	req.PageSize = int32(pageSize)
+
+	resp, err := s.subledgerClient.ListEntries(ctx, req)
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			if info, _ := rpcerror.Details(st); info != nil && info.GetReason() == subledgerErrors.ReasonInvalidCursor {
+				return nil, "", fmt.Errorf("%w: %s", accountErrors.ErrInvalidCursor, st.Message())
+			}
+		}
+		return nil, "", fmt.Errorf("failed to list ledger entries: %w", err)
+	}
+
+	transactions, err := s.toTransactions(ctx, resp.Entries)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return transactions, resp.NextCursor, nil
+}
+
+func listEntriesRequest(accountID string, filter HistoryFilter) *pbSub.ListEntriesRequest {
+	req := &pbSub.ListEntriesRequest{
+		AccountId:       accountID,
+		Direction:       filter.Direction,
+		ReferencePrefix: filter.ReferencePrefix,
+		Search:          filter.Search,
+	}
+	if filter.From != nil {
+		req.From = filter.From.Format(time.RFC3339Nano)
+	}
+	if filter.To != nil {
+		req.To = filter.To.Format(time.RFC3339Nano)
+	}
+	if filter.MinAmount != nil {
+		req.MinAmount = filter.MinAmount.String()
+	}
+	if filter.MaxAmount != nil {
+		req.MaxAmount = filter.MaxAmount.String()
+	}
+	return req
+}
+
+// toTransactions converts subledger entries into history rows and resolves
+// their counterparties against the accounts table.
+func (s *Service) toTransactions(ctx context.Context, entries []*pbSub.LedgerEntry) ([]Transaction, error) {
+	var counterpartyIDs []string
+	seen := make(map[string]bool)
+	for _, e := range entries {
+		for _, id := range e.CounterpartyAccountIds {
+			if !seen[id] {
+				seen[id] = true
+				counterpartyIDs = append(counterpartyIDs, id)
+			}
+		}
+	}
+
+	accounts, err := s.repo.GetAccounts(ctx, counterpartyIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]Transaction, 0, len(entries))
+	for _, e := range entries {
+		amount, err := decimal.NewFromString(e.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %q on ledger entry %s: %w", e.Amount, e.Id, err)
+		}
+		createdAt, err := time.Parse(time.RFC3339Nano, e.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid created_at %q on ledger entry %s: %w", e.CreatedAt, e.Id, err)
+		}
+
+		txn := Transaction{
+			ID:            e.Id,
+			TransactionID: e.TransactionId,
+			AccountID:     e.AccountId,
+			Amount:        amount,
+			Direction:     e.Direction,
+			ReferenceID:   e.ReferenceId,
+			Description:   e.Description,
+			CreatedAt:     createdAt,
+		}
+		for _, id := range e.CounterpartyAccountIds {
+			txn.Counterparties = append(txn.Counterparties, counterpartyOf(id, accounts))
+		}
+		transactions = append(transactions, txn)
+	}
+
+	return transactions, nil
+}
+
+func counterpartyOf(accountID string, accounts map[string]*repository.Account) Counterparty {
+	counterparty := Counterparty{AccountID: accountID}
+	if account, ok := accounts[accountID]; ok {
+		counterparty.AccountType = account.AccountType
+		counterparty.Email = account.Email
+	}
+	return counterparty
+}
+
+// GetTransaction returns every leg of a posted transaction. When accountID is
+// non-empty the transaction must touch that account, otherwise it is reported
+// as not found so that callers cannot probe other users' transactions; the
+// counterparties are then the accounts on the other side from accountID.
+func (s *Service) GetTransaction(ctx context.Context, transactionID, accountID string) (*TransactionDetail, error) {
+	resp, err := s.subledgerClient.GetTransaction(ctx, &pbSub.GetTransactionRequest{
+		TransactionId: transactionID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("%w: %s", accountErrors.ErrTransactionNotFound, transactionID)
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	detail := &TransactionDetail{
+		TransactionID:            resp.TransactionId,
+		ReferenceID:              resp.ReferenceId,
+		Description:              resp.Description,
+		CreatedAt:                resp.CreatedAt,
+		ReversesTransactionID:    resp.ReversesTransactionId,
+		ReversedByTransactionIDs: resp.ReversedByTransactionIds,
+	}
+
+	ownDirections := make(map[string]bool)
+	for _, e := range resp.Entries {
+		amount, err := decimal.NewFromString(e.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %q on ledger entry %s: %w", e.Amount, e.Id, err)
+		}
+		detail.Legs = append(detail.Legs, TransactionLeg{
+			ID:        e.Id,
+			AccountID: e.AccountId,
+			Amount:    amount,
+			Direction: e.Direction,
+		})
+		if e.AccountId == accountID {
+			ownDirections[e.Direction] = true
+		}
+	}
+
+	if accountID != "" && len(ownDirections) == 0 {
+		return nil, fmt.Errorf("%w: %s", accountErrors.ErrTransactionNotFound, transactionID)
+	}
+
+	var counterpartyIDs []string
+	seen := make(map[string]bool)
+	for _, leg := range detail.Legs {
+		if leg.AccountID == accountID || ownDirections[leg.Direction] || seen[leg.AccountID] {
+			continue
+		}
+		seen[leg.AccountID] = true
+		counterpartyIDs = append(counterpartyIDs, leg.AccountID)
+	}
+
+	accounts, err := s.repo.GetAccounts(ctx, counterpartyIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range counterpartyIDs {
+		detail.Counterparties = append(detail.Counterparties, counterpartyOf(id, accounts))
+	}
+
+	return detail, nil
+}
+
+// GenerateStatement builds the statement for a calendar month (YYYY-MM).
+// The opening balance is the subledger balance as of the first instant of
+// the period and the lines are the period's entries in posting order, so the
+// closing balance always reconciles with the subledger.
+func (s *Service) GenerateStatement(ctx context.Context, accountID, period string) (*statement.Statement, error) {
+	start, err := time.Parse("2006-01", period)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", accountErrors.ErrInvalidStatementPeriod, period)
+	}
+	end := start.AddDate(0, 1, 0)
+
+	now := time.Now()
+	if start.After(now) {
+		return nil, fmt.Errorf("%w: %s is in the future", accountErrors.ErrInvalidStatementPeriod, period)
+	}
+
+	account, err := s.repo.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opening, err := s.balanceAt(ctx, accountID, start)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := &statement.Statement{
+		AccountID:      accountID,
+		Currency:       account.Currency,
+		Period:         period,
+		PeriodStart:    start,
+		PeriodEnd:      end,
+		OpeningBalance: opening,
+		TotalCredits:   decimal.Zero,
+		TotalDebits:    decimal.Zero,
+		Lines:          []statement.Line{},
+		GeneratedAt:    now.UTC(),
+	}
+
+	req := &pbSub.ListEntriesRequest{
+		AccountId: accountID,
+		From:      start.Format(time.RFC3339Nano),
+		To:        end.Format(time.RFC3339Nano),
+		PageSize:  100,
+		Ascending: true,
+		UseCursor: true,
+	}
+
+	running := opening
+	for {
+		resp, err := s.subledgerClient.ListEntries(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list statement entries: %w", err)
+		}
+
+		for _, e := range resp.Entries {
+			amount, err := decimal.NewFromString(e.Amount)
+			if err != nil {
+				return nil, fmt.Errorf("invalid amount %q on ledger entry %s: %w", e.Amount, e.Id, err)
+			}
+			postedAt, err := time.Parse(time.RFC3339Nano, e.CreatedAt)
+			if err != nil {
+				return nil, fmt.Errorf("invalid created_at %q on ledger entry %s: %w", e.CreatedAt, e.Id, err)
+			}
+
+			if e.Direction == "DEBIT" {
+				stmt.TotalDebits = stmt.TotalDebits.Add(amount)
+				running = running.Sub(amount)
+			} else {
+				stmt.TotalCredits = stmt.TotalCredits.Add(amount)
+				running = running.Add(amount)
+			}
+			stmt.Lines = append(stmt.Lines, statement.Line{
+				PostedAt:       postedAt,
+				TransactionID:  e.TransactionId,
+				ReferenceID:    e.ReferenceId,
+				Description:    e.Description,
+				Direction:      e.Direction,
+				Amount:         amount,
+				RunningBalance: running,
+			})
+		}
+
+		if !resp.HasMore {
+			break
+		}
+		req.Cursor = resp.NextCursor
+	}
+	stmt.ClosingBalance = running
+
+	return stmt, nil
 }
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
+	pbSub "github.com/ChotongW/grit_demo_wallet/pb/subledger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// System account roles. Which account plays each role is stored in the
+// system_accounts table and may differ between environments.
+const (
+	RoleReferralPool    = "REFERRAL_POOL"
+	RoleInstitutionMain = "INSTITUTION_MAIN"
+	RoleDisbursement    = "DISBURSEMENT"
+	RolePSP             = "PSP"
+	RoleFeeIncome       = "FEE_INCOME"
+	RoleFXClearing      = "FX_CLEARING"
+	RoleInterestExpense = "INTEREST_EXPENSE"
+	RoleInterestPayable = "INTEREST_PAYABLE"
+)
+
+// systemRoleClasses is the chart of accounts class an account must have to
+// play each role. Every role listed here must be configured at startup.
+var systemRoleClasses = map[string]string{
+	RoleReferralPool:    "EQUITY",
+	RoleInstitutionMain: "ASSET",
+	RoleDisbursement:    "EQUITY",
+	RolePSP:             "ASSET",
+	RoleFeeIncome:       "REVENUE",
+	RoleFXClearing:      "ASSET",
+	RoleInterestExpense: "EXPENSE",
+	RoleInterestPayable: "LIABILITY",
+}
+
+// systemAccountsTTL bounds how long a replica keeps using a role assignment
+// after another replica changed it.
+const systemAccountsTTL = time.Minute
+
+type systemAccountCache struct {
+	mu       sync.RWMutex
+	byRole   map[string]string
+	loadedAt time.Time
+}
+
+// InitSystemAccounts applies the role overrides from configuration, loads
+// the registry and checks that every role points at an existing SYSTEM
+// account. An override must also be in the subledger's chart of accounts
+// with the class its role requires. The service must not start if it fails.
+func (s *Service) InitSystemAccounts(ctx context.Context, overrides map[string]string) error {
+	roles := make([]string, 0, len(overrides))
+	for role := range overrides {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	for _, role := range roles {
+		accountID := overrides[role]
+		role = strings.ToUpper(role)
+		if _, ok := systemRoleClasses[role]; !ok {
+			return fmt.Errorf("%w: %s", accountErrors.ErrUnknownSystemRole, role)
+		}
+		if err := s.checkSystemAccount(ctx, accountID); err != nil {
+			return fmt.Errorf("system account for %s: %w", role, err)
+		}
+		if err := s.checkLedgerClass(ctx, role, accountID); err != nil {
+			return fmt.Errorf("system account for %s: %w", role, err)
+		}
+		if _, err := s.repo.SetSystemAccount(ctx, role, accountID, ""); err != nil {
+			return err
+		}
+	}
+
+	if err := s.reloadSystemAccounts(ctx); err != nil {
+		return err
+	}
+
+	s.systemAccounts.mu.RLock()
+	defer s.systemAccounts.mu.RUnlock()
+
+	for role := range systemRoleClasses {
+		accountID, ok := s.systemAccounts.byRole[role]
+		if !ok {
+			return fmt.Errorf("%w: %s", accountErrors.ErrSystemAccountNotConfigured, role)
+		}
+		if err := s.checkSystemAccount(ctx, accountID); err != nil {
+			return fmt.Errorf("system account for %s: %w", role, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) checkSystemAccount(ctx context.Context, accountID string) error {
+	account, err := s.repo.GetAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if account.AccountType != "SYSTEM" {
+		return fmt.Errorf("%w: %s is a %s account", accountErrors.ErrInvalidSystemAccount, accountID, account.AccountType)
+	}
+	return nil
+}
+
+// checkLedgerClass checks that the subledger has accountID in the class
+// role requires, so that the role's postings move its balance the right
+// way.
+func (s *Service) checkLedgerClass(ctx context.Context, role, accountID string) error {
+	resp, err := s.subledgerClient.GetLedgerAccount(ctx, &pbSub.GetLedgerAccountRequest{AccountId: accountID})
+	if status.Code(err) == codes.NotFound {
+		return fmt.Errorf("%w: %s is not in the chart of accounts", accountErrors.ErrInvalidSystemAccount, accountID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get ledger account %s: %w", accountID, err)
+	}
+
+	class := systemRoleClasses[role]
+	if resp.Account.Class != class {
+		return fmt.Errorf("%w: %s is a %s ledger account, %s needs %s", accountErrors.ErrInvalidSystemAccount, accountID, resp.Account.Class, role, class)
+	}
+	return nil
+}
+
+func (s *Service) reloadSystemAccounts(ctx context.Context) error {
+	accounts, err := s.repo.ListSystemAccounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	byRole := make(map[string]string, len(accounts))
+	for _, account := range accounts {
+		byRole[account.Role] = account.AccountID
+	}
+
+	s.systemAccounts.mu.Lock()
+	s.systemAccounts.byRole = byRole
+	s.systemAccounts.loadedAt = time.Now()
+	s.systemAccounts.mu.Unlock()
+
+	return nil
+}
+
+// systemAccount returns the id of the account that currently plays role.
+func (s *Service) systemAccount(ctx context.Context, role string) (string, error) {
+	s.systemAccounts.mu.RLock()
+	accountID, ok := s.systemAccounts.byRole[role]
+	stale := time.Since(s.systemAccounts.loadedAt) > systemAccountsTTL
+	s.systemAccounts.mu.RUnlock()
+
+	if stale {
+		if err := s.reloadSystemAccounts(ctx); err != nil {
+			// Keep serving the last known layout rather than failing postings.
+			s.logger.Errorf("failed to reload system accounts: %v", err)
+		} else {
+			s.systemAccounts.mu.RLock()
+			accountID, ok = s.systemAccounts.byRole[role]
+			s.systemAccounts.mu.RUnlock()
+		}
+	}
+
+	if !ok {
+		return "", fmt.Errorf("%w: %s", accountErrors.ErrSystemAccountNotConfigured, role)
+	}
+	return accountID, nil
+}
+
+func (s *Service) ListSystemAccounts(ctx context.Context) ([]repository.SystemAccount, error) {
+	return s.repo.ListSystemAccounts(ctx)
+}
+
+// SetSystemAccount points role at accountID. An unknown account is created
+// as a SYSTEM account and registered in the subledger with the class the
+// role requires; an existing account must already be a SYSTEM account of
+// that class in the subledger.
+func (s *Service) SetSystemAccount(ctx context.Context, role, accountID, description string) (*repository.SystemAccount, error) {
+	role = strings.ToUpper(role)
+	class, ok := systemRoleClasses[role]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", accountErrors.ErrUnknownSystemRole, role)
+	}
+	if accountID == "" {
+		return nil, fmt.Errorf("%w: account_id is required", accountErrors.ErrInvalidSystemAccount)
+	}
+
+	exists, err := s.repo.AccountExists(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		if err := s.checkSystemAccount(ctx, accountID); err != nil {
+			return nil, err
+		}
+		if err := s.checkLedgerClass(ctx, role, accountID); err != nil {
+			return nil, err
+		}
+	} else {
+		name := description
+		if name == "" {
+			name = role
+		}
+		_, err := s.subledgerClient.CreateLedgerAccount(ctx, &pbSub.CreateLedgerAccountRequest{
+			AccountId: accountID,
+			Name:      name,
+			Class:     class,
+		})
+		if err != nil && status.Code(err) != codes.AlreadyExists {
+			return nil, fmt.Errorf("failed to register ledger account: %w", err)
+		}
+		// It may have been in the chart of accounts already, with any class.
+		if err := s.checkLedgerClass(ctx, role, accountID); err != nil {
+			return nil, err
+		}
+		if err := s.repo.CreateSystemAccount(ctx, accountID); err != nil {
+			return nil, err
+		}
+	}
+
+	account, err := s.repo.SetSystemAccount(ctx, role, accountID, description)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reloadSystemAccounts(ctx); err != nil {
+		s.logger.Errorf("failed to reload system accounts: %v", err)
+	}
+
+	s.logger.Infof("System role %s assigned to account %s", role, accountID)
+	return account, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCurrency is the currency of wallets opened without one. It is the
+// only currency the ledger posts in so far.
+const DefaultCurrency = "USD"
+
+var supportedCurrencies = map[string]bool{
+	DefaultCurrency: true,
+}
+
+const maxWalletNameLength = 50
+
+// Wallet is one of a user's accounts with its current balance.
+type Wallet struct {
+	Account *repository.Account
+	Balance decimal.Decimal
+}
+
+// OpenWallet opens another wallet for userID, e.g. for savings. It is not
+// the default wallet until SetDefaultWallet makes it so.
+func (s *Service) OpenWallet(ctx context.Context, userID, name, currency string) (*repository.Account, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > maxWalletNameLength {
+		return nil, fmt.Errorf("%w: %q", accountErrors.ErrInvalidWalletName, name)
+	}
+
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	if !supportedCurrencies[currency] {
+		return nil, fmt.Errorf("%w: %s", accountErrors.ErrUnsupportedCurrency, currency)
+	}
+
+	if _, err := s.repo.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	account, err := s.repo.CreateWallet(ctx, userID, name, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.registerWallet(ctx, account.AccountID); err != nil {
+		s.discardAccount(ctx, account.AccountID)
+		return nil, err
+	}
+	s.publishAccountCreated(ctx, account)
+
+	s.logger.Infof("Opened wallet %s (%s, %s) for user %s", account.AccountID, name, currency, userID)
+	return account, nil
+}
+
+// ListAccountsForUser returns every wallet of userID with its balance, the
+// default wallet first.
+func (s *Service) ListAccountsForUser(ctx context.Context, userID string) ([]Wallet, error) {
+	if _, err := s.repo.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.repo.ListAccountsForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	wallets := make([]Wallet, len(accounts))
+	for i := range accounts {
+		balance, err := s.balance(ctx, accounts[i].AccountID)
+		if err != nil {
+			return nil, err
+		}
+		wallets[i] = Wallet{Account: &accounts[i], Balance: balance}
+	}
+
+	return wallets, nil
+}
+
+// SetDefaultWallet makes accountID, one of userID's wallets, the one money
+// sent to the user's email is credited to.
+func (s *Service) SetDefaultWallet(ctx context.Context, userID, accountID string) (*repository.Account, error) {
+	if _, err := s.repo.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.SetDefaultWallet(ctx, userID, accountID)
+}
+
+// sameOwner reports whether two accounts are wallets of the same user.
+func sameOwner(a, b *repository.Account) bool {
+	return a.AccountType == "USER" && b.AccountType == "USER" &&
+		a.UserID != nil && b.UserID != nil && *a.UserID == *b.UserID
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	accountErrors "github.com/ChotongW/grit_demo_wallet/internal/accounts/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/repository"
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/webhooks"
+	pbSub "github.com/ChotongW/grit_demo_wallet/pb/subledger"
+
+	"github.com/google/uuid"
+)
+
+const (
+	webhookLease       = time.Minute
+	webhookBatchSize   = 100
+	maxWebhookAttempts = 8
+	webhookRetryDelay  = 30 * time.Second
+	maxWebhookDelay    = time.Hour
+)
+
+// RegisterWebhook adds an endpoint for apiKeyID that receives events. The
+// returned endpoint carries the signing secret, which is not shown again.
+func (s *Service) RegisterWebhook(ctx context.Context, apiKeyID, endpointURL string, events []string, description string) (*repository.WebhookEndpoint, error) {
+	endpointURL = strings.TrimSpace(endpointURL)
+	if err := webhooks.CheckURL(ctx, endpointURL); err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", accountErrors.ErrInvalidWebhookURL, endpointURL, err)
+	}
+
+	if len(events) == 0 {
+		return nil, fmt.Errorf("%w: subscribe to at least one event", accountErrors.ErrInvalidWebhookEvents)
+	}
+	subscribed := make([]string, 0, len(events))
+	seen := make(map[string]bool)
+	for _, event := range events {
+		event = strings.ToLower(strings.TrimSpace(event))
+		if !webhooks.IsEvent(event) {
+			return nil, fmt.Errorf("%w: unknown event %q", accountErrors.ErrInvalidWebhookEvents, event)
+		}
+		if seen[event] {
+			continue
+		}
+		seen[event] = true
+		subscribed = append(subscribed, event)
+	}
+
+	secret, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	ep := &repository.WebhookEndpoint{
+		ID:          uuid.New().String(),
+		APIKeyID:    apiKeyID,
+		URL:         endpointURL,
+		Secret:      "whsec_" + secret,
+		Events:      subscribed,
+		Description: strings.TrimSpace(description),
+	}
+	if err := s.repo.CreateWebhookEndpoint(ctx, ep); err != nil {
+		return nil, err
+	}
+
+	s.logger.Infof("Registered webhook %s for %v", ep.ID, ep.Events)
+	return ep, nil
+}
+
+func (s *Service) ListWebhooks(ctx context.Context, apiKeyID string) ([]repository.WebhookEndpoint, error) {
+	return s.repo.ListWebhookEndpoints(ctx, apiKeyID)
+}
+
+func (s *Service) DeleteWebhook(ctx context.Context, apiKeyID, webhookID string) error {
+	return s.repo.DeleteWebhookEndpoint(ctx, apiKeyID, webhookID)
+}
+
+// ListWebhookDeliveries returns a page of the delivery logs of apiKeyID's
+// endpoints. Filtering on DEAD lists the deliveries that were given up on.
+func (s *Service) ListWebhookDeliveries(ctx context.Context, apiKeyID string, filter repository.WebhookDeliveryFilter, page, pageSize int) ([]repository.WebhookDelivery, int, error) {
+	filter.Status = strings.ToUpper(strings.TrimSpace(filter.Status))
+	switch filter.Status {
+	case "", repository.WebhookPending, repository.WebhookDelivered, repository.WebhookDead:
+	default:
+		return nil, 0, fmt.Errorf("%w: %q", accountErrors.ErrInvalidDeliveryFilter, filter.Status)
+	}
+
+	if filter.EndpointID != "" {
+		if _, err := s.repo.GetWebhookEndpoint(ctx, apiKeyID, filter.EndpointID); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return s.repo.ListWebhookDeliveries(ctx, apiKeyID, filter, page, pageSize)
+}
+
+func (s *Service) GetWebhookDelivery(ctx context.Context, apiKeyID, deliveryID string) (*repository.WebhookDelivery, []repository.WebhookAttempt, error) {
+	return s.repo.GetWebhookDelivery(ctx, apiKeyID, deliveryID)
+}
+
+// ReplayWebhookDelivery sends the event of a delivery to its endpoint
+// again, whatever became of the original. The endpoint must still exist.
+func (s *Service) ReplayWebhookDelivery(ctx context.Context, apiKeyID, deliveryID string) (*repository.WebhookDelivery, error) {
+	original, _, err := s.repo.GetWebhookDelivery(ctx, apiKeyID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetWebhookEndpoint(ctx, apiKeyID, original.EndpointID); err != nil {
+		return nil, err
+	}
+
+	replay, err := s.repo.ReplayWebhookDelivery(ctx, original, uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Infof("Replaying webhook delivery %s as %s", deliveryID, replay.ID)
+	return replay, nil
+}
+
+// publishWebhook queues event for every endpoint subscribed to it.
+// Failures are logged rather than returned, like notifications: whatever
+// the event reports has already happened.
+func (s *Service) publishWebhook(ctx context.Context, event string, data any) {
+	envelope := webhooks.Envelope{
+		ID:        uuid.New().String(),
+		Type:      event,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		s.logger.Errorf("Failed to encode %s webhook: %v", event, err)
+		return
+	}
+	if _, err := s.repo.EnqueueWebhookEvent(ctx, envelope.ID, event, payload); err != nil {
+		s.logger.Errorf("Failed to queue %s webhook: %v", event, err)
+	}
+}
+
+// publishPosted announces a transaction posted to the subledger.
+func (s *Service) publishPosted(ctx context.Context, transactionID string, req *pbSub.CreateTransactionRequest) {
+	entries := make([]webhooks.Entry, len(req.Entries))
+	for i, e := range req.Entries {
+		entries[i] = webhooks.Entry{AccountID: e.AccountId, Amount: e.Amount, Direction: e.Direction}
+	}
+
+	s.publishWebhook(ctx, webhooks.EventTransactionPosted, webhooks.TransactionPosted{
+		TransactionID: transactionID,
+		ReferenceID:   req.ReferenceId,
+		Description:   req.Description,
+		Entries:       entries,
+	})
+}
+
+func (s *Service) publishAccountCreated(ctx context.Context, account *repository.Account) {
+	data := webhooks.AccountCreated{
+		AccountID:   account.AccountID,
+		AccountType: account.AccountType,
+		Name:        account.Name,
+		Currency:    account.Currency,
+		IsDefault:   account.IsDefault,
+		CreatedAt:   account.CreatedAt,
+	}
+	if account.UserID != nil {
+		data.UserID = *account.UserID
+	}
+
+	s.publishWebhook(ctx, webhooks.EventAccountCreated, data)
+}
+
+// RunWebhooks delivers queued webhooks every interval until ctx is
+// cancelled.
+func (s *Service) RunWebhooks(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.DeliverDueWebhooks(ctx, time.Now().UTC()); err != nil && ctx.Err() == nil {
+			s.logger.Errorf("failed to deliver webhooks: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverDueWebhooks sends one batch of webhooks due at now and returns
+// how many it picked up. A failed delivery is retried with exponential
+// backoff and becomes DEAD after maxWebhookAttempts.
+func (s *Service) DeliverDueWebhooks(ctx context.Context, now time.Time) (int, error) {
+	due, err := s.repo.ClaimDueWebhookDeliveries(ctx, now, webhookLease, webhookBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range due {
+		d := &due[i]
+		attempt := &repository.WebhookAttempt{
+			DeliveryID:  d.ID,
+			Attempt:     d.Attempts + 1,
+			AttemptedAt: time.Now().UTC(),
+		}
+
+		result, sendErr := s.webhooks.Send(ctx, webhooks.Request{
+			URL:        d.URL,
+			Secret:     d.Secret,
+			DeliveryID: d.ID,
+			Event:      d.Event,
+			Payload:    d.Payload,
+		})
+		attempt.Duration = result.Duration
+		if result.StatusCode != 0 {
+			attempt.StatusCode = &result.StatusCode
+		}
+
+		var retryAt *time.Time
+		if sendErr != nil {
+			msg := sendErr.Error()
+			attempt.Error = &msg
+			if attempt.Attempt < maxWebhookAttempts {
+				at := now.Add(webhookBackoff(attempt.Attempt))
+				retryAt = &at
+			}
+			s.logger.Warnf("Webhook delivery %s attempt %d failed: %v", d.ID, attempt.Attempt, sendErr)
+		}
+
+		if err := s.repo.RecordWebhookAttempt(ctx, attempt, sendErr == nil, retryAt); err != nil {
+			// The lease expires and the delivery is picked up again.
+			s.logger.Errorf("failed to record attempt of webhook delivery %s: %v", d.ID, err)
+		}
+	}
+
+	return len(due), nil
+}
+
+func webhookBackoff(attempts int) time.Duration {
+	delay := webhookRetryDelay << (attempts - 1)
+	if delay <= 0 || delay > maxWebhookDelay {
+		return maxWebhookDelay
+	}
+	return delay
+}
@@ -0,0 +1,155 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A deliberately small PDF 1.4 writer: A4 pages of monospaced text using the
+// built-in Courier font, which every reader ships with, so no fonts need to
+// be embedded and no third-party renderer is required.
+const (
+	pdfPageWidth    = 595
+	pdfPageHeight   = 842
+	pdfMargin       = 40
+	pdfFontSize     = 8
+	pdfLineHeight   = 11
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLineHeight
+	pdfMaxColumns   = 110
+)
+
+func renderPDF(s *Statement) []byte {
+	return buildPDF(statementText(s))
+}
+
+func statementText(s *Statement) []string {
+	lines := []string{
+		"ACCOUNT STATEMENT",
+		"",
+		fmt.Sprintf("Account:   %s", s.AccountID),
+		fmt.Sprintf("Period:    %s (%s to %s)", s.Period, s.PeriodStart.Format("2006-01-02"), s.PeriodEnd.AddDate(0, 0, -1).Format("2006-01-02")),
+		fmt.Sprintf("Currency:  %s", s.Currency),
+		fmt.Sprintf("Generated: %s", s.GeneratedAt.Format(time.RFC3339)),
+		"",
+		fmt.Sprintf("Opening balance: %s", s.OpeningBalance.StringFixed(2)),
+		"",
+		fmt.Sprintf("%-19s  %-36s  %-6s  %14s  %14s", "Posted at", "Description", "Dir", "Amount", "Balance"),
+		strings.Repeat("-", 95),
+	}
+
+	for _, l := range s.Lines {
+		dir := "CR"
+		if l.Direction == "DEBIT" {
+			dir = "DR"
+		}
+		lines = append(lines, fmt.Sprintf("%-19s  %-36s  %-6s  %14s  %14s",
+			l.PostedAt.Format("2006-01-02 15:04:05"),
+			truncate(l.Description, 36),
+			dir,
+			l.Amount.StringFixed(2),
+			l.RunningBalance.StringFixed(2),
+		))
+		lines = append(lines, fmt.Sprintf("  txn %s  ref %s", l.TransactionID, truncate(l.ReferenceID, 60)))
+	}
+	if len(s.Lines) == 0 {
+		lines = append(lines, "No transactions in this period.")
+	}
+
+	lines = append(lines,
+		strings.Repeat("-", 95),
+		fmt.Sprintf("Total credits:   %s", s.TotalCredits.StringFixed(2)),
+		fmt.Sprintf("Total debits:    %s", s.TotalDebits.StringFixed(2)),
+		fmt.Sprintf("Closing balance: %s", s.ClosingBalance.StringFixed(2)),
+	)
+
+	return lines
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-3]) + "..."
+}
+
+func buildPDF(lines []string) []byte {
+	var pages [][]string
+	for len(lines) > 0 {
+		n := pdfLinesPerPage
+		if len(lines) < n {
+			n = len(lines)
+		}
+		pages = append(pages, lines[:n])
+		lines = lines[n:]
+	}
+	if len(pages) == 0 {
+		pages = [][]string{{}}
+	}
+
+	// Object numbers: 1 catalog, 2 page tree, 3 font, then a page and a
+	// content stream object for every page.
+	var objects []string
+	objects = append(objects, "<< /Type /Catalog /Pages 2 0 R >>")
+
+	var kids []string
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+	objects = append(objects, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	objects = append(objects, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range pages {
+		var content bytes.Buffer
+		fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLineHeight, pdfMargin, pdfPageHeight-pdfMargin)
+		for _, line := range page {
+			fmt.Fprintf(&content, "(%s) '\n", pdfEscape(truncate(line, pdfMaxColumns)))
+		}
+		fmt.Fprintf(&content, "ET\nBT\n/F1 %d Tf\n%d %d Td\n(Page %d of %d) Tj\nET\n",
+			pdfFontSize, pdfPageWidth-pdfMargin-80, pdfMargin/2, i+1, len(pages))
+
+		objects = append(objects, fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, 5+2*i,
+		))
+		objects = append(objects, fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return out.Bytes()
+}
+
+// pdfEscape escapes string delimiters and replaces anything outside
+// printable ASCII, which the standard Courier encoding cannot show reliably.
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 32 || r > 126:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,116 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+	FormatPDF  = "pdf"
+)
+
+type Line struct {
+	PostedAt       time.Time       `json:"posted_at"`
+	TransactionID  string          `json:"transaction_id"`
+	ReferenceID    string          `json:"reference_id"`
+	Description    string          `json:"description"`
+	Direction      string          `json:"direction"`
+	Amount         decimal.Decimal `json:"amount"`
+	RunningBalance decimal.Decimal `json:"running_balance"`
+}
+
+// Statement covers [PeriodStart, PeriodEnd). ClosingBalance equals
+// OpeningBalance + TotalCredits - TotalDebits and the RunningBalance of the
+// last line.
+type Statement struct {
+	AccountID      string          `json:"account_id"`
+	Currency       string          `json:"currency"`
+	Period         string          `json:"period"`
+	PeriodStart    time.Time       `json:"period_start"`
+	PeriodEnd      time.Time       `json:"period_end"`
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+	TotalCredits   decimal.Decimal `json:"total_credits"`
+	TotalDebits    decimal.Decimal `json:"total_debits"`
+	ClosingBalance decimal.Decimal `json:"closing_balance"`
+	Lines          []Line          `json:"lines"`
+	GeneratedAt    time.Time       `json:"generated_at"`
+}
+
+func IsSupportedFormat(format string) bool {
+	switch format {
+	case FormatCSV, FormatJSON, FormatPDF:
+		return true
+	}
+	return false
+}
+
+func ContentType(format string) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv"
+	case FormatJSON:
+		return "application/json"
+	case FormatPDF:
+		return "application/pdf"
+	}
+	return "application/octet-stream"
+}
+
+func Filename(s *Statement, format string) string {
+	return fmt.Sprintf("statement-%s-%s.%s", s.AccountID, s.Period, format)
+}
+
+func Render(s *Statement, format string) ([]byte, error) {
+	switch format {
+	case FormatCSV:
+		return renderCSV(s)
+	case FormatJSON:
+		return json.MarshalIndent(s, "", "  ")
+	case FormatPDF:
+		return renderPDF(s), nil
+	}
+	return nil, fmt.Errorf("unsupported statement format %q", format)
+}
+
+func renderCSV(s *Statement) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	records := [][]string{
+		{"account_id", s.AccountID},
+		{"period", s.Period},
+		{"currency", s.Currency},
+		{"opening_balance", s.OpeningBalance.StringFixed(2)},
+		{},
+		{"posted_at", "transaction_id", "reference_id", "description", "direction", "amount", "running_balance"},
+	}
+	for _, l := range s.Lines {
+		records = append(records, []string{
+			l.PostedAt.Format(time.RFC3339),
+			l.TransactionID,
+			l.ReferenceID,
+			l.Description,
+			l.Direction,
+			l.Amount.StringFixed(2),
+			l.RunningBalance.StringFixed(2),
+		})
+	}
+	records = append(records,
+		[]string{},
+		[]string{"total_credits", s.TotalCredits.StringFixed(2)},
+		[]string{"total_debits", s.TotalDebits.StringFixed(2)},
+		[]string{"closing_balance", s.ClosingBalance.StringFixed(2)},
+	)
+
+	if err := w.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("failed to write csv statement: %w", err)
+	}
+	return buf.Bytes(), nil
+}
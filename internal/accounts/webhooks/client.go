@@ -0,0 +1,121 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+
+	// maxResponseBody is how much of an endpoint's answer is read before
+	// the connection is dropped. None of it is kept.
+	maxResponseBody = 1024
+)
+
+// Sign returns the signature header of payload sent at timestamp:
+//
+//	t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<payload>" keyed by secret>
+//
+// Receivers recompute v1 with their secret and should reject timestamps
+// too far in the past to stop replayed requests.
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Request is one attempt to deliver an event.
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID string
+	Event      string
+	Payload    []byte
+}
+
+// Result is what the endpoint answered. StatusCode is zero when it could
+// not be reached. The response body is deliberately not kept: it would let
+// an endpoint read back whatever it made the worker fetch.
+type Result struct {
+	StatusCode int
+	Duration   time.Duration
+}
+
+// Client posts signed events to partner endpoints. Any 2xx response counts
+// as delivered. It only ever connects to public addresses, goes through no
+// proxy and follows no redirects.
+type Client struct {
+	client *http.Client
+}
+
+func NewClient() *Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: dialControl,
+	}
+	return &Client{
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+			Transport: &http.Transport{
+				Proxy:               nil,
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: 5 * time.Second,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (c *Client) Send(ctx context.Context, req Request) (*Result, error) {
+	if err := CheckURL(ctx, req.URL); err != nil {
+		return &Result{}, fmt.Errorf("refusing to call %s: %w", req.URL, err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Payload))
+	if err != nil {
+		return &Result{}, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	now := time.Now()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "grit-demo-wallet-webhooks")
+	httpReq.Header.Set(EventHeader, req.Event)
+	httpReq.Header.Set(DeliveryHeader, req.DeliveryID)
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, now, req.Payload))
+
+	resp, err := c.client.Do(httpReq)
+	result := &Result{Duration: time.Since(now)}
+	if err != nil {
+		return result, fmt.Errorf("failed to reach %s: %w", req.URL, err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+	result.StatusCode = resp.StatusCode
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return result, fmt.Errorf("%s responded %s", req.URL, resp.Status)
+	}
+	return result, nil
+}
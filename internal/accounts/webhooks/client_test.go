@@ -0,0 +1,17 @@
+package webhooks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/accounts/webhooks"
+)
+
+func TestSign(t *testing.T) {
+	got := webhooks.Sign("whsec_test", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), []byte(`{"id":"evt_1"}`))
+
+	want := "t=1767225600,v1=45b40331de0325606dc5400202ade162460fbe48daf9401adfdcd0d7b4f35470"
+	if got != want {
+		t.Errorf("Sign = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,58 @@
+// Package webhooks defines the events partners can subscribe to and signs
+// and sends them to partner endpoints.
+package webhooks
+
+import "time"
+
+const (
+	EventTransactionPosted = "transaction.posted"
+	EventAccountCreated    = "account.created"
+)
+
+// events are the events that are actually sent. Nothing freezes accounts
+// or captures holds yet, so account.frozen and hold.captured cannot be
+// subscribed to until something does.
+var events = map[string]bool{
+	EventTransactionPosted: true,
+	EventAccountCreated:    true,
+}
+
+func IsEvent(event string) bool {
+	return events[event]
+}
+
+// Envelope is the JSON body of every webhook. ID stays the same across
+// retries and replays so receivers can drop duplicates.
+type Envelope struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// TransactionPosted is the data of a transaction.posted event: every leg
+// of a transaction posted to the ledger.
+type TransactionPosted struct {
+	TransactionID string  `json:"transaction_id"`
+	ReferenceID   string  `json:"reference_id"`
+	Description   string  `json:"description"`
+	Entries       []Entry `json:"entries"`
+}
+
+type Entry struct {
+	AccountID string `json:"account_id"`
+	Amount    string `json:"amount"`
+	Direction string `json:"direction"`
+}
+
+// AccountCreated is the data of an account.created event, sent for new
+// users and for every wallet they open later.
+type AccountCreated struct {
+	AccountID   string    `json:"account_id"`
+	UserID      string    `json:"user_id,omitempty"`
+	AccountType string    `json:"account_type"`
+	Name        string    `json:"name"`
+	Currency    string    `json:"currency"`
+	IsDefault   bool      `json:"is_default"`
+	CreatedAt   time.Time `json:"created_at"`
+}
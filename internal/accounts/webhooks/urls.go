@@ -0,0 +1,126 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+)
+
+// Endpoints are called from inside the cluster, so they must be public
+// https URLs: nothing that resolves to a loopback, private, link-local or
+// otherwise internal address, and no bare or internal service host names.
+
+var errForbiddenAddress = errors.New("address is not public")
+
+// blockedPrefixes are non-public ranges beyond those netip.Addr's own
+// checks cover.
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT
+	netip.MustParsePrefix("169.254.0.0/16"),  // link-local, cloud metadata
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // documentation
+	netip.MustParsePrefix("192.88.99.0/24"),  // 6to4 relay
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // documentation
+	netip.MustParsePrefix("203.0.113.0/24"),  // documentation
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64, may map to private IPv4
+	netip.MustParsePrefix("100::/64"),        // discard
+	netip.MustParsePrefix("2001::/32"),       // Teredo
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation
+	netip.MustParsePrefix("2002::/16"),       // 6to4, may embed private IPv4
+	netip.MustParsePrefix("fd00:ec2::/32"),   // EC2 metadata
+	netip.MustParsePrefix("fec0::/10"),       // deprecated site-local
+}
+
+// internalSuffixes are host name suffixes that only resolve inside a
+// private network.
+var internalSuffixes = []string{".local", ".localhost", ".internal", ".intranet", ".lan", ".home.arpa", ".svc", ".cluster.local"}
+
+// IsPublicAddress reports whether ip may be called.
+func IsPublicAddress(ip net.IP) bool {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return false
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckURL returns why rawURL cannot be a webhook endpoint, or nil. It
+// resolves the host and requires every address it has to be public.
+func CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("must be an https URL")
+	}
+	if u.User != nil {
+		return fmt.Errorf("must not carry credentials")
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" {
+		return fmt.Errorf("has no host")
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicAddress(ip) {
+			return fmt.Errorf("%s: %w", host, errForbiddenAddress)
+		}
+		return nil
+	}
+
+	if !strings.Contains(host, ".") || host == "localhost" {
+		return fmt.Errorf("%s is not a public host name", host)
+	}
+	for _, suffix := range internalSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return fmt.Errorf("%s is not a public host name", host)
+		}
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("cannot resolve %s: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if !IsPublicAddress(addr.IP) {
+			return fmt.Errorf("%s resolves to %s: %w", host, addr.IP, errForbiddenAddress)
+		}
+	}
+	return nil
+}
+
+// dialControl refuses connections to anything but public addresses. It
+// runs on the address actually dialled, after DNS resolution, so a host
+// that changed its records since it was registered cannot reach inside.
+func dialControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !IsPublicAddress(ip) {
+		return fmt.Errorf("%s: %w", host, errForbiddenAddress)
+	}
+	return nil
+}
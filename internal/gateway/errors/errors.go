@@ -2,16 +2,72 @@ package errors
 
 import (
 	"net/http"
+	"strings"
+
+	"github.com/ChotongW/grit_demo_wallet/pkg/requestid"
+	"github.com/ChotongW/grit_demo_wallet/pkg/rpcerror"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	ProblemContentType = "application/problem+json"
+	problemTypePrefix  = "urn:grit-demo-wallet:problem:"
+)
+
+// Problem is an RFC 7807 problem details object.
+type Problem struct {
+	Type            string           `json:"type"`
+	Title           string           `json:"title"`
+	Status          int              `json:"status"`
+	Detail          string           `json:"detail,omitempty"`
+	Instance        string           `json:"instance,omitempty"`
+	Reason          string           `json:"reason,omitempty"`
+	Domain          string           `json:"domain,omitempty"`
+	RequestID       string           `json:"request_id,omitempty"`
+	FieldViolations []FieldViolation `json:"field_violations,omitempty"`
+}
+
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// ProblemType turns a reason code such as INSUFFICIENT_BALANCE into the
+// problem type URI urn:grit-demo-wallet:problem:insufficient-balance.
+func ProblemType(reason string) string {
+	if reason == "" {
+		return "about:blank"
+	}
+	return problemTypePrefix + strings.ReplaceAll(strings.ToLower(reason), "_", "-")
+}
+
+func WriteProblem(c *gin.Context, p Problem) {
+	if p.Type == "" {
+		p.Type = ProblemType(p.Reason)
+	}
+	if p.Title == "" {
+		p.Title = http.StatusText(p.Status)
+	}
+	if p.Instance == "" {
+		p.Instance = c.Request.URL.Path
+	}
+	if p.RequestID == "" {
+		p.RequestID = requestid.FromContext(c.Request.Context())
+	}
+
+	c.Header("Content-Type", ProblemContentType)
+	c.AbortWithStatusJSON(p.Status, p)
+}
+
 func HandleBindingError(c *gin.Context, err error) {
-	c.JSON(http.StatusBadRequest, gin.H{
-		"error":   "Invalid request body",
-		"details": err.Error(),
+	WriteProblem(c, Problem{
+		Status: http.StatusBadRequest,
+		Title:  "Invalid request body",
+		Detail: err.Error(),
+		Reason: "INVALID_REQUEST_BODY",
 	})
 }
 
@@ -22,39 +78,63 @@ func HandleServiceError(c *gin.Context, err error) {
 
 	st, ok := status.FromError(err)
 	if !ok {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		WriteProblem(c, Problem{
+			Status: http.StatusInternalServerError,
+			Title:  "Internal Server Error",
+		})
 		return
 	}
 
+	p := Problem{
+		Status: http.StatusInternalServerError,
+		Title:  "Internal Server Error",
+		Detail: st.Message(),
+	}
+
 	switch st.Code() {
 	case codes.InvalidArgument:
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request parameters"})
+		p.Status, p.Title = http.StatusBadRequest, "Invalid request parameters"
 
 	case codes.NotFound:
-		c.JSON(http.StatusNotFound, gin.H{"error": "Resource not found"})
+		p.Status, p.Title = http.StatusNotFound, "Resource not found"
 
 	case codes.AlreadyExists:
-		c.JSON(http.StatusConflict, gin.H{"error": "Resource already exists"})
+		p.Status, p.Title = http.StatusConflict, "Resource already exists"
 
 	case codes.PermissionDenied:
-		c.JSON(http.StatusForbidden, gin.H{"error": "Permission denied"})
+		p.Status, p.Title = http.StatusForbidden, "Permission denied"
 
 	case codes.Unauthenticated:
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		p.Status, p.Title = http.StatusUnauthorized, "Authentication required"
 
 	case codes.FailedPrecondition:
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Precondition failed"})
+		p.Status, p.Title = http.StatusBadRequest, "Precondition failed"
 
 	case codes.Unavailable:
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Service temporarily unavailable, please try again later"})
+		p.Status, p.Title = http.StatusServiceUnavailable, "Service temporarily unavailable, please try again later"
+		p.Detail = ""
 
 	case codes.DeadlineExceeded:
-		c.JSON(http.StatusGatewayTimeout, gin.H{"error": "Request timed out"})
-
-	case codes.Internal, codes.Unknown, codes.DataLoss:
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		p.Status, p.Title = http.StatusGatewayTimeout, "Request timed out"
+		p.Detail = ""
 
 	default:
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		// Internal, Unknown, DataLoss and anything unexpected: never leak
+		// backend messages to clients.
+		p.Detail = ""
+	}
+
+	info, violations := rpcerror.Details(st)
+	if info != nil {
+		p.Reason = info.GetReason()
+		p.Domain = info.GetDomain()
+	}
+	for _, v := range violations {
+		p.FieldViolations = append(p.FieldViolations, FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
 	}
+
+	WriteProblem(c, p)
 }
@@ -1,10 +1,14 @@
 package handlers
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"io"
 	"strconv"
 
 	gwerrors "github.com/ChotongW/grit_demo_wallet/internal/gateway/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/gateway/middleware"
 	pb "github.com/ChotongW/grit_demo_wallet/pb/accounts"
 	"github.com/ChotongW/grit_demo_wallet/pkg/requestid"
 
@@ -39,9 +43,9 @@ func (h *AccountsHandler) loggerWithRequestID(c *gin.Context) *logrus.Entry {
 //	@Produce		json
 //	@Param			request	body		object{email=string,initial_balance=string,referrer_account_id=string}	true	"Account creation request"
 //	@Success		200		{object}	object{success=bool,account_id=string,message=string,account=object}
-//	@Failure		400		{object}	object{error=string}
-//	@Failure		500		{object}	object{error=string}
-//	@Failure		500		{object}	object{error=string}
+//	@Failure		400		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
 //	@Security		ApiKeyAuth
 //	@Router			/accounts [post]
 func (h *AccountsHandler) CreateAccount(c *gin.Context) {
@@ -87,8 +91,8 @@ func (h *AccountsHandler) CreateAccount(c *gin.Context) {
 //	@Produce		json
 //	@Param			account_id	path		string	true	"Account ID"
 //	@Success		200			{object}	object{account=object}
-//	@Failure		404			{object}	object{error=string}
-//	@Failure		404			{object}	object{error=string}
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
 //	@Security		ApiKeyAuth
 //	@Router			/accounts/{account_id} [get]
 func (h *AccountsHandler) GetAccount(c *gin.Context) {
@@ -124,8 +128,8 @@ func (h *AccountsHandler) GetAccount(c *gin.Context) {
 //	@Produce		json
 //	@Param			account_id	path		string	true	"Account ID"
 //	@Success		200			{object}	object{account_id=string,balance=string,currency=string}
-//	@Failure		404			{object}	object{error=string}
-//	@Failure		404			{object}	object{error=string}
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
 //	@Security		ApiKeyAuth
 //	@Router			/accounts/{account_id}/balance [get]
 func (h *AccountsHandler) GetBalance(c *gin.Context) {
@@ -155,6 +159,417 @@ func (h *AccountsHandler) GetBalance(c *gin.Context) {
 	})
 }
 
+// OpenWallet godoc
+//
+//	@Summary		Open a wallet
+//	@Description	Open another wallet, e.g. for savings, for an existing user. Wallet names are unique per user; the currency defaults to USD.
+//	@Tags			Accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		string	true	"User ID"
+//	@Param			request	body		object{name=string,currency=string}	true	"Wallet"
+//	@Success		200		{object}	object{account=object}
+//	@Failure		400		{object}	gwerrors.Problem
+//	@Failure		404		{object}	gwerrors.Problem
+//	@Failure		409		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/users/{user_id}/accounts [post]
+func (h *AccountsHandler) OpenWallet(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	userID := c.Param("user_id")
+
+	var req struct {
+		Name     string `json:"name" binding:"required"`
+		Currency string `json:"currency"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.OpenWallet(c.Request.Context(), &pb.OpenWalletRequest{
+		UserId:   userID,
+		Name:     req.Name,
+		Currency: req.Currency,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to open wallet: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("wallet opened: user=%s, account=%s", userID, resp.Account.AccountId)
+	c.JSON(200, gin.H{
+		"account": resp.Account,
+	})
+}
+
+// ListAccountsForUser godoc
+//
+//	@Summary		List a user's wallets
+//	@Description	List every wallet of a user with its balance, the default wallet first
+//	@Tags			Accounts
+//	@Produce		json
+//	@Param			user_id	path		string	true	"User ID"
+//	@Success		200		{object}	object{accounts=[]object}
+//	@Failure		404		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/users/{user_id}/accounts [get]
+func (h *AccountsHandler) ListAccountsForUser(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	userID := c.Param("user_id")
+
+	resp, err := h.client.ListAccountsForUser(c.Request.Context(), &pb.ListAccountsForUserRequest{
+		UserId: userID,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to list accounts for user: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("listed accounts for user: %s", userID)
+	c.JSON(200, gin.H{
+		"accounts": resp.Accounts,
+	})
+}
+
+// SetDefaultWallet godoc
+//
+//	@Summary		Set the default wallet
+//	@Description	Choose which of a user's wallets receives money sent to their email
+//	@Tags			Accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		string	true	"User ID"
+//	@Param			request	body		object{account_id=string}	true	"Wallet"
+//	@Success		200		{object}	object{account=object}
+//	@Failure		400		{object}	gwerrors.Problem
+//	@Failure		404		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/users/{user_id}/default-account [put]
+func (h *AccountsHandler) SetDefaultWallet(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	userID := c.Param("user_id")
+
+	var req struct {
+		AccountID string `json:"account_id" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.SetDefaultWallet(c.Request.Context(), &pb.SetDefaultWalletRequest{
+		UserId:    userID,
+		AccountId: req.AccountID,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to set default wallet: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("default wallet set: user=%s, account=%s", userID, req.AccountID)
+	c.JSON(200, gin.H{
+		"account": resp.Account,
+	})
+}
+
+// GetUser godoc
+//
+//	@Summary		Get a user
+//	@Description	Retrieve a user's email and profile
+//	@Tags			Users
+//	@Produce		json
+//	@Param			user_id	path		string	true	"User ID"
+//	@Success		200		{object}	object{user=object}
+//	@Failure		404		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/users/{user_id} [get]
+func (h *AccountsHandler) GetUser(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	userID := c.Param("user_id")
+
+	resp, err := h.client.GetUser(c.Request.Context(), &pb.GetUserRequest{
+		UserId: userID,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to get user: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("retrieved user: %s", userID)
+	c.JSON(200, gin.H{
+		"user": resp.User,
+	})
+}
+
+// UpdateProfile godoc
+//
+//	@Summary		Update a user's profile
+//	@Description	Change the fields present in the body; an empty string clears a field. Phone numbers are E.164 and locales are language tags such as en or th-TH.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		string	true	"User ID"
+//	@Param			request	body		object{name=string,phone=string,address=string,locale=string}	true	"Profile fields"
+//	@Success		200		{object}	object{user=object}
+//	@Failure		400		{object}	gwerrors.Problem
+//	@Failure		404		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/users/{user_id} [patch]
+func (h *AccountsHandler) UpdateProfile(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	userID := c.Param("user_id")
+
+	var req struct {
+		Name    *string `json:"name"`
+		Phone   *string `json:"phone"`
+		Address *string `json:"address"`
+		Locale  *string `json:"locale"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.UpdateProfile(c.Request.Context(), &pb.UpdateProfileRequest{
+		UserId:  userID,
+		Name:    req.Name,
+		Phone:   req.Phone,
+		Address: req.Address,
+		Locale:  req.Locale,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to update profile: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("profile updated: user=%s", userID)
+	c.JSON(200, gin.H{
+		"user": resp.User,
+	})
+}
+
+// RequestEmailChange godoc
+//
+//	@Summary		Request an email change
+//	@Description	Send a verification token to the new address. The email changes once the token is confirmed.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		string	true	"User ID"
+//	@Param			request	body		object{new_email=string}	true	"New email"
+//	@Success		202		{object}	object{expires_at=string}
+//	@Failure		400		{object}	gwerrors.Problem
+//	@Failure		404		{object}	gwerrors.Problem
+//	@Failure		409		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/users/{user_id}/email-change [post]
+func (h *AccountsHandler) RequestEmailChange(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	userID := c.Param("user_id")
+
+	var req struct {
+		NewEmail string `json:"new_email" binding:"required,email"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.RequestEmailChange(c.Request.Context(), &pb.RequestEmailChangeRequest{
+		UserId:   userID,
+		NewEmail: req.NewEmail,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to request email change: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("email change requested: user=%s", userID)
+	c.JSON(202, gin.H{
+		"expires_at": resp.ExpiresAt,
+	})
+}
+
+// ConfirmEmailChange godoc
+//
+//	@Summary		Confirm an email change
+//	@Description	Complete an email change with the token sent to the new address
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		object{token=string}	true	"Verification token"
+//	@Success		200		{object}	object{user=object}
+//	@Failure		400		{object}	gwerrors.Problem
+//	@Failure		409		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/users/email-change/confirm [post]
+func (h *AccountsHandler) ConfirmEmailChange(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+
+	var req struct {
+		Token string `json:"token" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.ConfirmEmailChange(c.Request.Context(), &pb.ConfirmEmailChangeRequest{
+		Token: req.Token,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to confirm email change: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("email change confirmed: user=%s", resp.User.UserId)
+	c.JSON(200, gin.H{
+		"user": resp.User,
+	})
+}
+
+// GetNotificationPreferences godoc
+//
+//	@Summary		Get notification preferences
+//	@Description	Show which channels a user is notified on and their low balance alert. Users who never set preferences get email only.
+//	@Tags			Notifications
+//	@Produce		json
+//	@Param			user_id	path		string	true	"User ID"
+//	@Success		200		{object}	object{preferences=object}
+//	@Failure		404		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/users/{user_id}/notification-preferences [get]
+func (h *AccountsHandler) GetNotificationPreferences(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	userID := c.Param("user_id")
+
+	resp, err := h.client.GetNotificationPreferences(c.Request.Context(), &pb.GetNotificationPreferencesRequest{
+		UserId: userID,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to get notification preferences: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"preferences": resp.Preferences,
+	})
+}
+
+// SetNotificationPreferences godoc
+//
+//	@Summary		Set notification preferences
+//	@Description	Replace a user's notification preferences. Channels are EMAIL, SMS (needs a phone number on the profile) and PUSH (needs push_token). Omitting low_balance_threshold turns the low balance alert off.
+//	@Tags			Notifications
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		string	true	"User ID"
+//	@Param			request	body		object{channels=[]string,push_token=string,low_balance_threshold=string}	true	"Preferences"
+//	@Success		200		{object}	object{preferences=object}
+//	@Failure		400		{object}	gwerrors.Problem
+//	@Failure		404		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/users/{user_id}/notification-preferences [put]
+func (h *AccountsHandler) SetNotificationPreferences(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	userID := c.Param("user_id")
+
+	var req struct {
+		Channels            []string `json:"channels"`
+		PushToken           *string  `json:"push_token"`
+		LowBalanceThreshold *string  `json:"low_balance_threshold"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.SetNotificationPreferences(c.Request.Context(), &pb.SetNotificationPreferencesRequest{
+		UserId:              userID,
+		Channels:            req.Channels,
+		PushToken:           req.PushToken,
+		LowBalanceThreshold: req.LowBalanceThreshold,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to set notification preferences: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("notification preferences set: user=%s", userID)
+	c.JSON(200, gin.H{
+		"preferences": resp.Preferences,
+	})
+}
+
+// ListNotifications godoc
+//
+//	@Summary		List notifications
+//	@Description	List the notifications sent or queued for a user, newest first
+//	@Tags			Notifications
+//	@Produce		json
+//	@Param			user_id		path		string	true	"User ID"
+//	@Param			page		query		int		false	"Page number (default: 1)"
+//	@Param			page_size	query		int		false	"Page size (default: 20, max: 100)"
+//	@Success		200			{object}	object{notifications=array,total_count=int,page=int,page_size=int}
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/users/{user_id}/notifications [get]
+func (h *AccountsHandler) ListNotifications(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	userID := c.Param("user_id")
+
+	page, _ := strconv.Atoi(c.Query("page"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size"))
+
+	resp, err := h.client.ListNotifications(c.Request.Context(), &pb.ListNotificationsRequest{
+		UserId:   userID,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to list notifications: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"notifications": resp.Notifications,
+		"total_count":   resp.TotalCount,
+		"page":          resp.Page,
+		"page_size":     resp.PageSize,
+	})
+}
+
 // Deposit godoc
 //
 //	@Summary		Deposit funds
@@ -164,9 +579,9 @@ func (h *AccountsHandler) GetBalance(c *gin.Context) {
 //	@Produce		json
 //	@Param			request		body		object{account_id=string,amount=string,description=string}	true	"Deposit request"
 //	@Success		200			{object}	object{success=bool,transaction_id=string,new_balance=string,message=string}
-//	@Failure		400			{object}	object{error=string}
-//	@Failure		500			{object}	object{error=string}
-//	@Failure		500			{object}	object{error=string}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
 //	@Security		ApiKeyAuth
 //	@Router			/accounts/deposit [post]
 func (h *AccountsHandler) Deposit(c *gin.Context) {
@@ -207,15 +622,15 @@ func (h *AccountsHandler) Deposit(c *gin.Context) {
 // Withdraw godoc
 //
 //	@Summary		Withdraw funds
-//	@Description	Withdraw funds from an account to PSP
+//	@Description	Withdraw funds from an account to PSP. Any fee is charged on top of the amount; the response carries the fee breakdown.
 //	@Tags			Wallet
 //	@Accept			json
 //	@Produce		json
 //	@Param			request		body		object{account_id=string,amount=string,description=string}	true	"Withdrawal request"
-//	@Success		200			{object}	object{success=bool,transaction_id=string,new_balance=string,message=string}
-//	@Failure		400			{object}	object{error=string}
-//	@Failure		500			{object}	object{error=string}
-//	@Failure		500			{object}	object{error=string}
+//	@Success		200			{object}	object{success=bool,transaction_id=string,new_balance=string,message=string,fee=object}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
 //	@Security		ApiKeyAuth
 //	@Router			/accounts/withdraw [post]
 func (h *AccountsHandler) Withdraw(c *gin.Context) {
@@ -250,21 +665,23 @@ func (h *AccountsHandler) Withdraw(c *gin.Context) {
 		"transaction_id": resp.TransactionId,
 		"new_balance":    resp.NewBalance,
 		"message":        resp.Message,
+		"fee":            resp.Fee,
 	})
 }
 
 // Transfer godoc
 //
 //	@Summary		Transfer funds
-//	@Description	Transfer funds from one account to another
+//	@Description	Transfer funds from one account to another, named either by to_account_id or by recipient (an email or @handle). Any fee is paid by the sender on top of the amount; the response carries the fee breakdown. Transfers between wallets of the same user are free.
 //	@Tags			Wallet
 //	@Accept			json
 //	@Produce		json
-//	@Param			request	body		object{from_account_id=string,to_account_id=string,amount=string,description=string}	true	"Transfer request"
-//	@Success		200		{object}	object{success=bool,transaction_id=string,new_balance=string,message=string}
-//	@Failure		400		{object}	object{error=string}
-//	@Failure		500		{object}	object{error=string}
-//	@Failure		500		{object}	object{error=string}
+//	@Param			request	body		object{from_account_id=string,to_account_id=string,recipient=string,amount=string,description=string}	true	"Transfer request"
+//	@Success		200		{object}	object{success=bool,transaction_id=string,new_balance=string,message=string,fee=object,recipient=object}
+//	@Failure		400		{object}	gwerrors.Problem
+//	@Failure		404		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
 //	@Security		ApiKeyAuth
 //	@Router			/transfers [post]
 func (h *AccountsHandler) Transfer(c *gin.Context) {
@@ -272,7 +689,8 @@ func (h *AccountsHandler) Transfer(c *gin.Context) {
 
 	var req struct {
 		FromAccountID string `json:"from_account_id" binding:"required"`
-		ToAccountID   string `json:"to_account_id" binding:"required"`
+		ToAccountID   string `json:"to_account_id" binding:"required_without=Recipient"`
+		Recipient     string `json:"recipient"`
 		Amount        string `json:"amount" binding:"required"`
 		Description   string `json:"description"`
 	}
@@ -285,6 +703,7 @@ func (h *AccountsHandler) Transfer(c *gin.Context) {
 	resp, err := h.client.Transfer(c.Request.Context(), &pb.TransferRequest{
 		FromAccountId: req.FromAccountID,
 		ToAccountId:   req.ToAccountID,
+		Recipient:     req.Recipient,
 		Amount:        req.Amount,
 		Description:   req.Description,
 	})
@@ -295,66 +714,1240 @@ func (h *AccountsHandler) Transfer(c *gin.Context) {
 		return
 	}
 
-	logger.Infof("transfer successful: from=%s, to=%s, amount=%s", req.FromAccountID, req.ToAccountID, req.Amount)
-	c.JSON(200, gin.H{
+	logger.Infof("transfer successful: from=%s, to=%s%s, amount=%s", req.FromAccountID, req.ToAccountID, req.Recipient, req.Amount)
+	body := gin.H{
 		"success":        resp.Success,
 		"transaction_id": resp.TransactionId,
 		"new_balance":    resp.NewBalance,
 		"message":        resp.Message,
-	})
+		"fee":            resp.Fee,
+	}
+	if resp.Recipient != nil {
+		body["recipient"] = resp.Recipient
+	}
+	c.JSON(200, body)
 }
 
-// GetTransactionHistory godoc
+// LookupRecipient godoc
 //
-//	@Summary		Get transaction history
-//	@Description	Retrieve paginated transaction history for an account
-//	@Tags			Accounts
+//	@Summary		Look up a recipient
+//	@Description	Resolve an email or @handle to a recipient before transferring. Only a display name and masked email are returned so the sender can confirm who they are paying.
+//	@Tags			Wallet
 //	@Produce		json
-//	@Param			account_id	path		string	true	"Account ID"
-//	@Param			page		query		int		false	"Page number (default: 1)"
-//	@Param			page_size	query		int		false	"Page size (default: 20, max: 100)"
-//	@Success		200			{object}	object{transactions=array,total_count=int,page=int,page_size=int,total_pages=int}
-//	@Failure		500			{object}	object{error=string}
-//	@Failure		500			{object}	object{error=string}
+//	@Param			recipient	query		string	true	"Email or @handle"
+//	@Success		200			{object}	object{recipient=object{type=string,display_name=string,masked_email=string,handle=string}}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
 //	@Security		ApiKeyAuth
-//	@Router			/accounts/{account_id}/transactions [get]
-func (h *AccountsHandler) GetTransactionHistory(c *gin.Context) {
+//	@Router			/recipients/lookup [get]
+func (h *AccountsHandler) LookupRecipient(c *gin.Context) {
 	logger := h.loggerWithRequestID(c)
-	accountID := c.Param("account_id")
-
-	page := 1
-	pageSize := 20
 
-	if p := c.Query("page"); p != "" {
-		if parsed, err := strconv.Atoi(p); err == nil {
-			page = parsed
-		}
+	var query struct {
+		Recipient string `form:"recipient" binding:"required"`
 	}
-
-	if ps := c.Query("page_size"); ps != "" {
-		if parsed, err := strconv.Atoi(ps); err == nil && parsed > 0 && parsed <= 100 {
-			pageSize = parsed
-		}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
 	}
 
-	resp, err := h.client.GetTransactionHistory(c.Request.Context(), &pb.GetTransactionHistoryRequest{
-		AccountId: accountID,
-		Page:      int32(page),
-		PageSize:  int32(pageSize),
+	resp, err := h.client.LookupRecipient(c.Request.Context(), &pb.LookupRecipientRequest{
+		Recipient: query.Recipient,
 	})
 
 	if err != nil {
-		logger.Errorf("failed to get transaction history: %v", err)
+		logger.Errorf("failed to look up recipient: %v", err)
 		gwerrors.HandleServiceError(c, err)
 		return
 	}
 
-	logger.Infof("retrieved transaction history: account=%s", accountID)
+	logger.Infof("looked up recipient: type=%s", resp.Recipient.Type)
 	c.JSON(200, gin.H{
-		"transactions": resp.Transactions,
-		"total_count":  resp.TotalCount,
-		"page":         resp.Page,
-		"page_size":    resp.PageSize,
-		"total_pages":  resp.TotalPages,
+		"recipient": resp.Recipient,
 	})
 }
+
+// SetHandle godoc
+//
+//	@Summary		Set an account handle
+//	@Description	Set the handle other users can send money to. Handles are 3 to 30 letters, digits, dots or underscores, start with a letter and are case-insensitive. An empty handle removes it.
+//	@Tags			Accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			account_id	path		string	true	"Account ID"
+//	@Param			request		body		object{handle=string}	true	"Handle"
+//	@Success		200			{object}	object{account=object}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		409			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/handle [put]
+func (h *AccountsHandler) SetHandle(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	accountID := c.Param("account_id")
+
+	var req struct {
+		Handle string `json:"handle"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.SetHandle(c.Request.Context(), &pb.SetHandleRequest{
+		AccountId: accountID,
+		Handle:    req.Handle,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to set handle: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("handle set: account=%s", accountID)
+	c.JSON(200, gin.H{
+		"account": resp.Account,
+	})
+}
+
+// QuoteFee godoc
+//
+//	@Summary		Quote a fee
+//	@Description	Show the fee an account would pay for a withdrawal or transfer before confirming it
+//	@Tags			Wallet
+//	@Produce		json
+//	@Param			account_id	path		string	true	"Paying account ID"
+//	@Param			operation	query		string	true	"withdraw or transfer"
+//	@Param			amount		query		string	true	"Amount to withdraw or transfer"
+//	@Success		200			{object}	object{fee=object}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/fees/quote [get]
+func (h *AccountsHandler) QuoteFee(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	accountID := c.Param("account_id")
+
+	var query struct {
+		Operation string `form:"operation" binding:"required"`
+		Amount    string `form:"amount" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.QuoteFee(c.Request.Context(), &pb.QuoteFeeRequest{
+		AccountId: accountID,
+		Operation: query.Operation,
+		Amount:    query.Amount,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to quote fee: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("quoted fee: account=%s, operation=%s, amount=%s", accountID, query.Operation, query.Amount)
+	c.JSON(200, gin.H{
+		"fee": resp.Fee,
+	})
+}
+
+// GetTransactionHistory godoc
+//
+//	@Summary		Get transaction history
+//	@Description	Retrieve transaction history for an account. Pass a cursor parameter (empty for the first page) to use keyset pagination; otherwise page/page_size pagination is used.
+//	@Tags			Accounts
+//	@Produce		json
+//	@Param			account_id			path		string	true	"Account ID"
+//	@Param			page				query		int		false	"Page number (default: 1)"
+//	@Param			page_size			query		int		false	"Page size (default: 20, max: 100)"
+//	@Param			cursor				query		string	false	"Opaque cursor from next_cursor; empty for the first page"
+//	@Param			from				query		string	false	"Only entries at or after this RFC 3339 time"
+//	@Param			to					query		string	false	"Only entries before this RFC 3339 time"
+//	@Param			direction			query		string	false	"DEBIT or CREDIT"
+//	@Param			min_amount			query		string	false	"Minimum entry amount"
+//	@Param			max_amount			query		string	false	"Maximum entry amount"
+//	@Param			reference_prefix	query		string	false	"Reference ID prefix"
+//	@Param			search				query		string	false	"Case-insensitive description search"
+//	@Success		200					{object}	object{transactions=array,total_count=int,page=int,page_size=int,total_pages=int,next_cursor=string,has_more=bool}
+//	@Failure		400					{object}	gwerrors.Problem
+//	@Failure		500					{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/transactions [get]
+func (h *AccountsHandler) GetTransactionHistory(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	accountID := c.Param("account_id")
+
+	page := 1
+	pageSize := 20
+
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil {
+			page = parsed
+		}
+	}
+
+	if ps := c.Query("page_size"); ps != "" {
+		if parsed, err := strconv.Atoi(ps); err == nil && parsed > 0 && parsed <= 100 {
+			pageSize = parsed
+		}
+	}
+
+	cursor, cursorMode := c.GetQuery("cursor")
+
+	resp, err := h.client.GetTransactionHistory(c.Request.Context(), &pb.GetTransactionHistoryRequest{
+		AccountId:       accountID,
+		Page:            int32(page),
+		PageSize:        int32(pageSize),
+		Cursor:          cursor,
+		UseCursor:       cursorMode,
+		From:            c.Query("from"),
+		To:              c.Query("to"),
+		Direction:       c.Query("direction"),
+		MinAmount:       c.Query("min_amount"),
+		MaxAmount:       c.Query("max_amount"),
+		ReferencePrefix: c.Query("reference_prefix"),
+		Search:          c.Query("search"),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to get transaction history: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("retrieved transaction history: account=%s", accountID)
+	if cursorMode {
+		c.JSON(200, gin.H{
+			"transactions": resp.Transactions,
+			"page_size":    resp.PageSize,
+			"next_cursor":  resp.NextCursor,
+			"has_more":     resp.HasMore,
+		})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"transactions": resp.Transactions,
+		"total_count":  resp.TotalCount,
+		"page":         resp.Page,
+		"page_size":    resp.PageSize,
+		"total_pages":  resp.TotalPages,
+		"has_more":     resp.HasMore,
+	})
+}
+
+// GetTransaction godoc
+//
+//	@Summary		Get transaction details
+//	@Description	Retrieve every leg of a transaction together with its counterparties (emails masked) and reversal links. The transaction must involve the given account.
+//	@Tags			Wallet
+//	@Produce		json
+//	@Param			transaction_id	path		string	true	"Transaction ID"
+//	@Param			account_id		query		string	true	"Account the caller owns; must be a party to the transaction"
+//	@Success		200				{object}	object{transaction=object}
+//	@Failure		400				{object}	gwerrors.Problem
+//	@Failure		404				{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/transactions/{transaction_id} [get]
+func (h *AccountsHandler) GetTransaction(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	transactionID := c.Param("transaction_id")
+
+	var query struct {
+		AccountID string `form:"account_id" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.GetTransaction(c.Request.Context(), &pb.GetTransactionRequest{
+		TransactionId: transactionID,
+		AccountId:     query.AccountID,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to get transaction: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("retrieved transaction: %s", transactionID)
+	c.JSON(200, gin.H{
+		"transaction": resp.Transaction,
+	})
+}
+
+// GenerateStatement godoc
+//
+//	@Summary		Download account statement
+//	@Description	Download the monthly statement for an account with opening balance, every entry with its running balance, totals and closing balance
+//	@Tags			Accounts
+//	@Produce		text/csv
+//	@Produce		json
+//	@Produce		application/pdf
+//	@Param			account_id	path		string	true	"Account ID"
+//	@Param			period		path		string	true	"Calendar month (YYYY-MM)"
+//	@Param			format		query		string	false	"csv, json or pdf (default: pdf)"
+//	@Success		200			{file}		file
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/statements/{period} [get]
+func (h *AccountsHandler) GenerateStatement(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	accountID := c.Param("account_id")
+	period := c.Param("period")
+
+	stream, err := h.client.GenerateStatement(c.Request.Context(), &pb.GenerateStatementRequest{
+		AccountId: accountID,
+		Period:    period,
+		Format:    c.Query("format"),
+	})
+	if err != nil {
+		logger.Errorf("failed to generate statement: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	// Errors are only reported as problems until the first chunk arrives;
+	// after that the headers are already on the wire.
+	first, err := stream.Recv()
+	if err != nil {
+		logger.Errorf("failed to generate statement: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", first.ContentType)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", first.Filename))
+	c.Status(200)
+
+	chunk := first
+	for {
+		if _, err := c.Writer.Write(chunk.Data); err != nil {
+			logger.Errorf("failed to write statement: %v", err)
+			return
+		}
+		c.Writer.Flush()
+
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			logger.Errorf("statement stream interrupted: %v", err)
+			return
+		}
+	}
+
+	logger.Infof("streamed statement: account=%s, period=%s", accountID, period)
+}
+
+// CreateScheduledTransfer godoc
+//
+//	@Summary		Schedule a transfer
+//	@Description	Schedule a one-off or recurring transfer from an account. Leave schedule empty for a one-off transfer at start_at, or pass a cron expression ("0 9 * * FRI") or an RRULE ("FREQ=WEEKLY;BYDAY=FR"); times are UTC. on_insufficient_funds is RETRY (default, up to max_retries times every retry_interval_seconds) or SKIP.
+//	@Tags			Scheduled Transfers
+//	@Accept			json
+//	@Produce		json
+//	@Param			account_id	path		string	true	"Paying account ID"
+//	@Param			request		body		object{to_account_id=string,amount=string,description=string,schedule_kind=string,schedule=string,start_at=string,end_at=string,max_occurrences=int,on_insufficient_funds=string,max_retries=int,retry_interval_seconds=int}	true	"Scheduled transfer"
+//	@Success		201			{object}	object{scheduled_transfer=object}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/scheduled-transfers [post]
+func (h *AccountsHandler) CreateScheduledTransfer(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	accountID := c.Param("account_id")
+
+	var req struct {
+		ToAccountID          string `json:"to_account_id" binding:"required"`
+		Amount               string `json:"amount" binding:"required"`
+		Description          string `json:"description"`
+		ScheduleKind         string `json:"schedule_kind"`
+		Schedule             string `json:"schedule"`
+		StartAt              string `json:"start_at"`
+		EndAt                string `json:"end_at"`
+		MaxOccurrences       int32  `json:"max_occurrences"`
+		OnInsufficientFunds  string `json:"on_insufficient_funds"`
+		MaxRetries           *int32 `json:"max_retries"`
+		RetryIntervalSeconds int32  `json:"retry_interval_seconds"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.CreateScheduledTransfer(c.Request.Context(), &pb.CreateScheduledTransferRequest{
+		FromAccountId:        accountID,
+		ToAccountId:          req.ToAccountID,
+		Amount:               req.Amount,
+		Description:          req.Description,
+		ScheduleKind:         req.ScheduleKind,
+		Schedule:             req.Schedule,
+		StartAt:              req.StartAt,
+		EndAt:                req.EndAt,
+		MaxOccurrences:       req.MaxOccurrences,
+		OnInsufficientFunds:  req.OnInsufficientFunds,
+		MaxRetries:           req.MaxRetries,
+		RetryIntervalSeconds: req.RetryIntervalSeconds,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to create scheduled transfer: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("scheduled transfer created: id=%s, from=%s", resp.ScheduledTransfer.Id, accountID)
+	c.JSON(201, gin.H{
+		"scheduled_transfer": resp.ScheduledTransfer,
+	})
+}
+
+// ListScheduledTransfers godoc
+//
+//	@Summary		List scheduled transfers
+//	@Description	List the scheduled transfers paid from an account, newest first
+//	@Tags			Scheduled Transfers
+//	@Produce		json
+//	@Param			account_id	path		string	true	"Paying account ID"
+//	@Param			status		query		string	false	"ACTIVE, PAUSED, CANCELLED or COMPLETED"
+//	@Success		200			{object}	object{scheduled_transfers=array}
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/scheduled-transfers [get]
+func (h *AccountsHandler) ListScheduledTransfers(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	accountID := c.Param("account_id")
+
+	resp, err := h.client.ListScheduledTransfers(c.Request.Context(), &pb.ListScheduledTransfersRequest{
+		AccountId: accountID,
+		Status:    c.Query("status"),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to list scheduled transfers: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"scheduled_transfers": resp.ScheduledTransfers,
+	})
+}
+
+// PauseScheduledTransfer godoc
+//
+//	@Summary		Pause a scheduled transfer
+//	@Description	Stop executing an active scheduled transfer until it is resumed
+//	@Tags			Scheduled Transfers
+//	@Produce		json
+//	@Param			account_id		path		string	true	"Paying account ID"
+//	@Param			schedule_id		path		string	true	"Scheduled transfer ID"
+//	@Success		200				{object}	object{scheduled_transfer=object}
+//	@Failure		404				{object}	gwerrors.Problem
+//	@Failure		400				{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/scheduled-transfers/{schedule_id}/pause [post]
+func (h *AccountsHandler) PauseScheduledTransfer(c *gin.Context) {
+	h.scheduledTransferAction(c, "pause", h.client.PauseScheduledTransfer)
+}
+
+// ResumeScheduledTransfer godoc
+//
+//	@Summary		Resume a scheduled transfer
+//	@Description	Reactivate a paused scheduled transfer. Occurrences missed while paused are not made up.
+//	@Tags			Scheduled Transfers
+//	@Produce		json
+//	@Param			account_id		path		string	true	"Paying account ID"
+//	@Param			schedule_id		path		string	true	"Scheduled transfer ID"
+//	@Success		200				{object}	object{scheduled_transfer=object}
+//	@Failure		404				{object}	gwerrors.Problem
+//	@Failure		400				{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/scheduled-transfers/{schedule_id}/resume [post]
+func (h *AccountsHandler) ResumeScheduledTransfer(c *gin.Context) {
+	h.scheduledTransferAction(c, "resume", h.client.ResumeScheduledTransfer)
+}
+
+// CancelScheduledTransfer godoc
+//
+//	@Summary		Cancel a scheduled transfer
+//	@Description	Permanently stop an active or paused scheduled transfer
+//	@Tags			Scheduled Transfers
+//	@Produce		json
+//	@Param			account_id		path		string	true	"Paying account ID"
+//	@Param			schedule_id		path		string	true	"Scheduled transfer ID"
+//	@Success		200				{object}	object{scheduled_transfer=object}
+//	@Failure		404				{object}	gwerrors.Problem
+//	@Failure		400				{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/scheduled-transfers/{schedule_id} [delete]
+func (h *AccountsHandler) CancelScheduledTransfer(c *gin.Context) {
+	h.scheduledTransferAction(c, "cancel", h.client.CancelScheduledTransfer)
+}
+
+func (h *AccountsHandler) scheduledTransferAction(
+	c *gin.Context,
+	action string,
+	call func(ctx context.Context, in *pb.ScheduledTransferActionRequest, opts ...grpc.CallOption) (*pb.ScheduledTransferActionResponse, error),
+) {
+	logger := h.loggerWithRequestID(c)
+
+	resp, err := call(c.Request.Context(), &pb.ScheduledTransferActionRequest{
+		AccountId:           c.Param("account_id"),
+		ScheduledTransferId: c.Param("schedule_id"),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to %s scheduled transfer: %v", action, err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("scheduled transfer %s: id=%s", action, resp.ScheduledTransfer.Id)
+	c.JSON(200, gin.H{
+		"scheduled_transfer": resp.ScheduledTransfer,
+	})
+}
+
+// CreatePaymentRequest godoc
+//
+//	@Summary		Request money
+//	@Description	Ask another account to pay this account. The request expires after expires_at (RFC 3339, default 7 days, at most 30).
+//	@Tags			Payment Requests
+//	@Accept			json
+//	@Produce		json
+//	@Param			account_id	path		string	true	"Requesting account ID"
+//	@Param			request		body		object{payer_account_id=string,amount=string,memo=string,expires_at=string}	true	"Payment request"
+//	@Success		201			{object}	object{payment_request=object}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/payment-requests [post]
+func (h *AccountsHandler) CreatePaymentRequest(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	accountID := c.Param("account_id")
+
+	var req struct {
+		PayerAccountID string `json:"payer_account_id" binding:"required"`
+		Amount         string `json:"amount" binding:"required"`
+		Memo           string `json:"memo"`
+		ExpiresAt      string `json:"expires_at"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.CreatePaymentRequest(c.Request.Context(), &pb.CreatePaymentRequestRequest{
+		RequesterAccountId: accountID,
+		PayerAccountId:     req.PayerAccountID,
+		Amount:             req.Amount,
+		Memo:               req.Memo,
+		ExpiresAt:          req.ExpiresAt,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to create payment request: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("payment request created: id=%s, requester=%s", resp.PaymentRequest.Id, accountID)
+	c.JSON(201, gin.H{
+		"payment_request": resp.PaymentRequest,
+	})
+}
+
+// ListPaymentRequests godoc
+//
+//	@Summary		List payment requests
+//	@Description	List the requests an account has to pay (incoming) or has made (outgoing), newest first
+//	@Tags			Payment Requests
+//	@Produce		json
+//	@Param			account_id	path		string	true	"Account ID"
+//	@Param			direction	query		string	false	"incoming (default) or outgoing"
+//	@Param			status		query		string	false	"PENDING, ACCEPTED, DECLINED, CANCELLED or EXPIRED"
+//	@Param			page		query		int		false	"Page number (default: 1)"
+//	@Param			page_size	query		int		false	"Page size (default: 20, max: 100)"
+//	@Success		200			{object}	object{payment_requests=array,total_count=int,page=int,page_size=int}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/payment-requests [get]
+func (h *AccountsHandler) ListPaymentRequests(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	accountID := c.Param("account_id")
+
+	page, _ := strconv.Atoi(c.Query("page"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size"))
+
+	resp, err := h.client.ListPaymentRequests(c.Request.Context(), &pb.ListPaymentRequestsRequest{
+		AccountId: accountID,
+		Direction: c.Query("direction"),
+		Status:    c.Query("status"),
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to list payment requests: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"payment_requests": resp.PaymentRequests,
+		"total_count":      resp.TotalCount,
+		"page":             resp.Page,
+		"page_size":        resp.PageSize,
+	})
+}
+
+// AcceptPaymentRequest godoc
+//
+//	@Summary		Pay a payment request
+//	@Description	Accept a pending request made to this account; the amount is transferred to the requester, plus any transfer fee
+//	@Tags			Payment Requests
+//	@Produce		json
+//	@Param			account_id	path		string	true	"Paying account ID"
+//	@Param			request_id	path		string	true	"Payment request ID"
+//	@Success		200			{object}	object{payment_request=object,new_balance=string,fee=object}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/payment-requests/{request_id}/accept [post]
+func (h *AccountsHandler) AcceptPaymentRequest(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+
+	resp, err := h.client.AcceptPaymentRequest(c.Request.Context(), &pb.PaymentRequestActionRequest{
+		AccountId:        c.Param("account_id"),
+		PaymentRequestId: c.Param("request_id"),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to accept payment request: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("payment request accepted: id=%s", resp.PaymentRequest.Id)
+	c.JSON(200, gin.H{
+		"payment_request": resp.PaymentRequest,
+		"new_balance":     resp.NewBalance,
+		"fee":             resp.Fee,
+	})
+}
+
+// DeclinePaymentRequest godoc
+//
+//	@Summary		Decline a payment request
+//	@Description	Turn down a pending request made to this account
+//	@Tags			Payment Requests
+//	@Produce		json
+//	@Param			account_id	path		string	true	"Paying account ID"
+//	@Param			request_id	path		string	true	"Payment request ID"
+//	@Success		200			{object}	object{payment_request=object}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/payment-requests/{request_id}/decline [post]
+func (h *AccountsHandler) DeclinePaymentRequest(c *gin.Context) {
+	h.paymentRequestAction(c, "decline", h.client.DeclinePaymentRequest)
+}
+
+// CancelPaymentRequest godoc
+//
+//	@Summary		Cancel a payment request
+//	@Description	Withdraw a pending request this account made
+//	@Tags			Payment Requests
+//	@Produce		json
+//	@Param			account_id	path		string	true	"Requesting account ID"
+//	@Param			request_id	path		string	true	"Payment request ID"
+//	@Success		200			{object}	object{payment_request=object}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/accounts/{account_id}/payment-requests/{request_id}/cancel [post]
+func (h *AccountsHandler) CancelPaymentRequest(c *gin.Context) {
+	h.paymentRequestAction(c, "cancel", h.client.CancelPaymentRequest)
+}
+
+func (h *AccountsHandler) paymentRequestAction(
+	c *gin.Context,
+	action string,
+	call func(ctx context.Context, in *pb.PaymentRequestActionRequest, opts ...grpc.CallOption) (*pb.PaymentRequestActionResponse, error),
+) {
+	logger := h.loggerWithRequestID(c)
+
+	resp, err := call(c.Request.Context(), &pb.PaymentRequestActionRequest{
+		AccountId:        c.Param("account_id"),
+		PaymentRequestId: c.Param("request_id"),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to %s payment request: %v", action, err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("payment request %s: id=%s", action, resp.PaymentRequest.Id)
+	c.JSON(200, gin.H{
+		"payment_request": resp.PaymentRequest,
+	})
+}
+
+// RegisterWebhook godoc
+//
+//	@Summary		Register a webhook
+//	@Description	Register an endpoint to be called back on events instead of polling. Events are transaction.posted and account.created. The URL must be a public https URL. Every call is a JSON POST signed in the X-Webhook-Signature header as t=<unix time>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed by the secret>. The secret is only returned here. Failed calls are retried with exponential backoff and end up in the dead-letter list.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		object{url=string,events=[]string,description=string}	true	"Webhook"
+//	@Success		201		{object}	object{webhook=object,secret=string}
+//	@Failure		400		{object}	gwerrors.Problem
+//	@Failure		500		{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/webhooks [post]
+func (h *AccountsHandler) RegisterWebhook(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+
+	var req struct {
+		URL         string   `json:"url" binding:"required"`
+		Events      []string `json:"events" binding:"required"`
+		Description string   `json:"description"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.RegisterWebhook(c.Request.Context(), &pb.RegisterWebhookRequest{
+		ApiKeyId:    middleware.APIKeyID(c),
+		Url:         req.URL,
+		Events:      req.Events,
+		Description: req.Description,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to register webhook: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("webhook registered: id=%s", resp.Webhook.Id)
+	c.JSON(201, gin.H{
+		"webhook": resp.Webhook,
+		"secret":  resp.Secret,
+	})
+}
+
+// ListWebhooks godoc
+//
+//	@Summary		List webhooks
+//	@Description	List the webhooks registered with the calling API key
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Success		200	{object}	object{webhooks=array}
+//	@Failure		500	{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/webhooks [get]
+func (h *AccountsHandler) ListWebhooks(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+
+	resp, err := h.client.ListWebhooks(c.Request.Context(), &pb.ListWebhooksRequest{
+		ApiKeyId: middleware.APIKeyID(c),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to list webhooks: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"webhooks": resp.Webhooks,
+	})
+}
+
+// DeleteWebhook godoc
+//
+//	@Summary		Delete a webhook
+//	@Description	Stop calling a webhook. Its pending deliveries are dropped to the dead-letter list; its delivery logs are kept.
+//	@Tags			Webhooks
+//	@Param			webhook_id	path	string	true	"Webhook ID"
+//	@Success		204
+//	@Failure		404	{object}	gwerrors.Problem
+//	@Failure		500	{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/webhooks/{webhook_id} [delete]
+func (h *AccountsHandler) DeleteWebhook(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	webhookID := c.Param("webhook_id")
+
+	_, err := h.client.DeleteWebhook(c.Request.Context(), &pb.DeleteWebhookRequest{
+		ApiKeyId:  middleware.APIKeyID(c),
+		WebhookId: webhookID,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to delete webhook: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("webhook deleted: id=%s", webhookID)
+	c.Status(204)
+}
+
+// ListWebhookDeliveries godoc
+//
+//	@Summary		List webhook deliveries
+//	@Description	Delivery logs of a webhook, newest first
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			webhook_id	path		string	true	"Webhook ID"
+//	@Param			status		query		string	false	"PENDING, DELIVERED or DEAD"
+//	@Param			page		query		int		false	"Page number (default: 1)"
+//	@Param			page_size	query		int		false	"Page size (default: 20, max: 100)"
+//	@Success		200			{object}	object{deliveries=array,total_count=int,page=int,page_size=int}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/webhooks/{webhook_id}/deliveries [get]
+func (h *AccountsHandler) ListWebhookDeliveries(c *gin.Context) {
+	h.listWebhookDeliveries(c, c.Param("webhook_id"), c.Query("status"))
+}
+
+// ListDeadLetters godoc
+//
+//	@Summary		List dead-lettered webhooks
+//	@Description	Deliveries to any webhook of the calling API key that were given up on after their last retry, newest first. Replay them once the endpoint is fixed.
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			page		query		int	false	"Page number (default: 1)"
+//	@Param			page_size	query		int	false	"Page size (default: 20, max: 100)"
+//	@Success		200			{object}	object{deliveries=array,total_count=int,page=int,page_size=int}
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/webhooks/dead-letters [get]
+func (h *AccountsHandler) ListDeadLetters(c *gin.Context) {
+	h.listWebhookDeliveries(c, "", "DEAD")
+}
+
+func (h *AccountsHandler) listWebhookDeliveries(c *gin.Context, webhookID, status string) {
+	logger := h.loggerWithRequestID(c)
+
+	page, _ := strconv.Atoi(c.Query("page"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size"))
+
+	resp, err := h.client.ListWebhookDeliveries(c.Request.Context(), &pb.ListWebhookDeliveriesRequest{
+		ApiKeyId:  middleware.APIKeyID(c),
+		WebhookId: webhookID,
+		Status:    status,
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to list webhook deliveries: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"deliveries":  resp.Deliveries,
+		"total_count": resp.TotalCount,
+		"page":        resp.Page,
+		"page_size":   resp.PageSize,
+	})
+}
+
+// GetWebhookDelivery godoc
+//
+//	@Summary		Get a webhook delivery
+//	@Description	A delivery with the payload sent and every attempt: status code, error, response body and duration
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			delivery_id	path		string	true	"Delivery ID"
+//	@Success		200			{object}	object{delivery=object,attempts=array}
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/webhooks/deliveries/{delivery_id} [get]
+func (h *AccountsHandler) GetWebhookDelivery(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+
+	resp, err := h.client.GetWebhookDelivery(c.Request.Context(), &pb.GetWebhookDeliveryRequest{
+		ApiKeyId:   middleware.APIKeyID(c),
+		DeliveryId: c.Param("delivery_id"),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to get webhook delivery: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"delivery": resp.Delivery,
+		"attempts": resp.Attempts,
+	})
+}
+
+// ReplayWebhookDelivery godoc
+//
+//	@Summary		Replay a webhook delivery
+//	@Description	Send the event of a delivery to its webhook again as a new delivery, whether the original was delivered or dead-lettered. The event id is unchanged so receivers can drop duplicates.
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			delivery_id	path		string	true	"Delivery ID"
+//	@Success		202			{object}	object{delivery=object}
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/webhooks/deliveries/{delivery_id}/replay [post]
+func (h *AccountsHandler) ReplayWebhookDelivery(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+	deliveryID := c.Param("delivery_id")
+
+	resp, err := h.client.ReplayWebhookDelivery(c.Request.Context(), &pb.ReplayWebhookDeliveryRequest{
+		ApiKeyId:   middleware.APIKeyID(c),
+		DeliveryId: deliveryID,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to replay webhook delivery: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("webhook delivery replayed: id=%s, replay=%s", deliveryID, resp.Delivery.Id)
+	c.JSON(202, gin.H{
+		"delivery": resp.Delivery,
+	})
+}
+
+// maxPayoutUpload is how much of an uploaded payout file is read. The
+// accounts service rejects files over its own limit, which is lower.
+const maxPayoutUpload = 4 << 20
+
+// FundDisbursementPool godoc
+//
+//	@Summary		Fund the payout pool
+//	@Description	Move money from the INSTITUTION_MAIN account into the DISBURSEMENT pool that payouts are paid from. A batch can only be approved while the pool holds enough to pay it. Needs the accounts service's admin key.
+//	@Tags			Payouts
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Admin-Key	header		string										true	"Accounts service admin key"
+//	@Param			request		body		object{amount=string,description=string}	true	"Funding request"
+//	@Success		200			{object}	object{transaction_id=string,account_id=string,balance=string}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		403			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/payouts/funding [post]
+func (h *AccountsHandler) FundDisbursementPool(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+
+	var req struct {
+		Amount      string `json:"amount" binding:"required" example:"10000.00"`
+		Description string `json:"description"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.FundDisbursementPool(middleware.AdminContext(c), &pb.FundDisbursementPoolRequest{
+		Amount:      req.Amount,
+		Description: req.Description,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to fund disbursement pool: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("disbursement pool funded: account=%s, amount=%s", resp.AccountId, req.Amount)
+	c.JSON(200, gin.H{
+		"transaction_id": resp.TransactionId,
+		"account_id":     resp.AccountId,
+		"balance":        resp.Balance,
+	})
+}
+
+// CreatePayoutBatch godoc
+//
+//	@Summary		Upload a payout file
+//	@Description	Upload a CSV of payments from the DISBURSEMENT account. The header row names the columns recipient (account id, email or handle), amount and, optionally, description. Every line is validated; the batch then waits for approval with its totals as a preview. Lines that fail validation are listed with status INVALID and are never paid.
+//	@Tags			Payouts
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			file		formData	file	true	"Payout CSV"
+//	@Param			X-User-ID	header		string	true	"User uploading the batch, who may not approve it"
+//	@Success		201			{object}	object{payout=object}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/payouts [post]
+func (h *AccountsHandler) CreatePayoutBatch(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxPayoutUpload))
+	if err != nil {
+		gwerrors.HandleBindingError(c, err)
+		return
+	}
+
+	resp, err := h.client.CreatePayoutBatch(c.Request.Context(), &pb.CreatePayoutBatchRequest{
+		UserId:   c.GetHeader(middleware.UserIDHeader),
+		Filename: header.Filename,
+		Content:  content,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to create payout batch: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("payout batch uploaded: id=%s, rows=%d, valid=%d", resp.Batch.Id, resp.Batch.TotalRows, resp.Batch.ValidRows)
+	c.JSON(201, gin.H{
+		"payout": resp.Batch,
+	})
+}
+
+// ListPayoutBatches godoc
+//
+//	@Summary		List payout batches
+//	@Description	Payout batches, newest first, with their totals and progress
+//	@Tags			Payouts
+//	@Produce		json
+//	@Param			status		query		string	false	"PENDING_APPROVAL, APPROVED, COMPLETED or CANCELLED"
+//	@Param			page		query		int		false	"Page number (default: 1)"
+//	@Param			page_size	query		int		false	"Page size (default: 20, max: 100)"
+//	@Success		200			{object}	object{payouts=array,total_count=int,page=int,page_size=int}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/payouts [get]
+func (h *AccountsHandler) ListPayoutBatches(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+
+	page, _ := strconv.Atoi(c.Query("page"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size"))
+
+	resp, err := h.client.ListPayoutBatches(c.Request.Context(), &pb.ListPayoutBatchesRequest{
+		Status:   c.Query("status"),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to list payout batches: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"payouts":     resp.Batches,
+		"total_count": resp.TotalCount,
+		"page":        resp.Page,
+		"page_size":   resp.PageSize,
+	})
+}
+
+// GetPayoutBatch godoc
+//
+//	@Summary		Get a payout batch
+//	@Description	A payout batch with its totals: rows, valid rows and the amount to pay before approval, and paid and failed rows once it runs
+//	@Tags			Payouts
+//	@Produce		json
+//	@Param			payout_id	path		string	true	"Payout batch ID"
+//	@Success		200			{object}	object{payout=object}
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/payouts/{payout_id} [get]
+func (h *AccountsHandler) GetPayoutBatch(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+
+	resp, err := h.client.GetPayoutBatch(c.Request.Context(), &pb.GetPayoutBatchRequest{
+		PayoutId: c.Param("payout_id"),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to get payout batch: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"payout": resp.Batch,
+	})
+}
+
+// ListPayoutRows godoc
+//
+//	@Summary		List the rows of a payout batch
+//	@Description	The lines of a payout file in file order with their resolved account and status; filter on INVALID to review a batch before approving it
+//	@Tags			Payouts
+//	@Produce		json
+//	@Param			payout_id	path		string	true	"Payout batch ID"
+//	@Param			status		query		string	false	"VALID, INVALID, SUBMITTED, PAID or FAILED"
+//	@Param			page		query		int		false	"Page number (default: 1)"
+//	@Param			page_size	query		int		false	"Page size (default: 20, max: 100)"
+//	@Success		200			{object}	object{rows=array,total_count=int,page=int,page_size=int}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Failure		500			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/payouts/{payout_id}/rows [get]
+func (h *AccountsHandler) ListPayoutRows(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+
+	page, _ := strconv.Atoi(c.Query("page"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size"))
+
+	resp, err := h.client.ListPayoutRows(c.Request.Context(), &pb.ListPayoutRowsRequest{
+		PayoutId: c.Param("payout_id"),
+		Status:   c.Query("status"),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to list payout rows: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"rows":        resp.Rows,
+		"total_count": resp.TotalCount,
+		"page":        resp.Page,
+		"page_size":   resp.PageSize,
+	})
+}
+
+// ApprovePayoutBatch godoc
+//
+//	@Summary		Approve a payout batch
+//	@Description	Queue a batch awaiting approval to be paid. Its valid rows are paid from the DISBURSEMENT account in chunks by a background worker; INVALID rows are skipped. Approval needs the accounts service's admin key, and the approving user must not be the one who uploaded the batch.
+//	@Tags			Payouts
+//	@Produce		json
+//	@Param			payout_id	path		string	true	"Payout batch ID"
+//	@Param			X-User-ID	header		string	true	"User approving the batch"
+//	@Param			X-Admin-Key	header		string	true	"Accounts service admin key"
+//	@Success		200			{object}	object{payout=object}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		403			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/payouts/{payout_id}/approve [post]
+func (h *AccountsHandler) ApprovePayoutBatch(c *gin.Context) {
+	h.payoutBatchAction(c, "approve", h.client.ApprovePayoutBatch)
+}
+
+// CancelPayoutBatch godoc
+//
+//	@Summary		Cancel a payout batch
+//	@Description	Discard a batch that has not been approved
+//	@Tags			Payouts
+//	@Produce		json
+//	@Param			payout_id	path		string	true	"Payout batch ID"
+//	@Success		200			{object}	object{payout=object}
+//	@Failure		400			{object}	gwerrors.Problem
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/payouts/{payout_id}/cancel [post]
+func (h *AccountsHandler) CancelPayoutBatch(c *gin.Context) {
+	h.payoutBatchAction(c, "cancel", h.client.CancelPayoutBatch)
+}
+
+func (h *AccountsHandler) payoutBatchAction(
+	c *gin.Context,
+	action string,
+	call func(ctx context.Context, in *pb.PayoutBatchActionRequest, opts ...grpc.CallOption) (*pb.PayoutBatchActionResponse, error),
+) {
+	logger := h.loggerWithRequestID(c)
+
+	resp, err := call(middleware.AdminContext(c), &pb.PayoutBatchActionRequest{
+		PayoutId: c.Param("payout_id"),
+		UserId:   c.GetHeader(middleware.UserIDHeader),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to %s payout batch: %v", action, err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	logger.Infof("payout batch %s: id=%s", action, resp.Batch.Id)
+	c.JSON(200, gin.H{
+		"payout": resp.Batch,
+	})
+}
+
+// ExportPayoutResults godoc
+//
+//	@Summary		Download payout results
+//	@Description	Download every line of a payout batch as CSV with its account, amount, status, transaction id and error
+//	@Tags			Payouts
+//	@Produce		text/csv
+//	@Param			payout_id	path		string	true	"Payout batch ID"
+//	@Success		200			{file}		file
+//	@Failure		404			{object}	gwerrors.Problem
+//	@Security		ApiKeyAuth
+//	@Router			/payouts/{payout_id}/results [get]
+func (h *AccountsHandler) ExportPayoutResults(c *gin.Context) {
+	logger := h.loggerWithRequestID(c)
+
+	resp, err := h.client.ExportPayoutResults(c.Request.Context(), &pb.ExportPayoutResultsRequest{
+		PayoutId: c.Param("payout_id"),
+	})
+
+	if err != nil {
+		logger.Errorf("failed to export payout results: %v", err)
+		gwerrors.HandleServiceError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.Filename))
+	c.Data(200, resp.ContentType, resp.Data)
+}
@@ -1,12 +1,31 @@
 package middleware
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
 	"strings"
 
+	gwerrors "github.com/ChotongW/grit_demo_wallet/internal/gateway/errors"
+	"github.com/ChotongW/grit_demo_wallet/pkg/admin"
+	"github.com/ChotongW/grit_demo_wallet/pkg/audit"
+
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/metadata"
 )
 
+const apiKeyIDKey = "api_key_id"
+
+// UserIDHeader optionally names the end user a partner is acting for. It
+// is not verified, so the audit log records it as claimed next to the API
+// key that is.
+const UserIDHeader = "X-User-ID"
+
+// AdminKeyHeader carries an operator's admin key for the admin operations
+// the gateway exposes. The gateway only passes it on; the service checks it.
+const AdminKeyHeader = "X-Admin-Key"
+
 func AuthMiddleware(apiKey string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if strings.HasSuffix(c.Request.URL.Path, "/health") {
@@ -16,17 +35,58 @@ func AuthMiddleware(apiKey string) gin.HandlerFunc {
 
 		clientAPIKey := c.GetHeader("X-API-KEY")
 		if clientAPIKey == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
-			c.Abort()
+			gwerrors.WriteProblem(c, gwerrors.Problem{
+				Status: http.StatusUnauthorized,
+				Title:  "Authentication required",
+				Reason: "UNAUTHORIZED",
+			})
 			return
 		}
 
 		if clientAPIKey != apiKey {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
-			c.Abort()
+			gwerrors.WriteProblem(c, gwerrors.Problem{
+				Status: http.StatusUnauthorized,
+				Title:  "Authentication required",
+				Reason: "UNAUTHORIZED",
+			})
 			return
 		}
 
+		keyID := KeyID(clientAPIKey)
+		c.Set(apiKeyIDKey, keyID)
+
+		ctx := audit.ToContext(c.Request.Context(), audit.Caller{
+			ActorType:     audit.ActorAPIKey,
+			ActorID:       keyID,
+			ClaimedUserID: c.GetHeader(UserIDHeader),
+			SourceIP:      c.RemoteIP(),
+			ClaimedIP:     c.ClientIP(),
+		})
+		c.Request = c.Request.WithContext(ctx)
+
 		c.Next()
 	}
 }
+
+// KeyID identifies an API key without revealing it, so that what a
+// partner registers can be stored against their key.
+func KeyID(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return "key_" + hex.EncodeToString(sum[:8])
+}
+
+// APIKeyID returns the KeyID of the API key the request was authenticated
+// with.
+func APIKeyID(c *gin.Context) string {
+	return c.GetString(apiKeyIDKey)
+}
+
+// AdminContext returns the request's context with the key from
+// AdminKeyHeader, if any, passed on to the services it calls.
+func AdminContext(c *gin.Context) context.Context {
+	ctx := c.Request.Context()
+	if key := c.GetHeader(AdminKeyHeader); key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, admin.MetadataKey, key)
+	}
+	return ctx
+}
@@ -13,6 +13,7 @@ import (
 	_ "github.com/ChotongW/grit_demo_wallet/docs" // Import for swagger docs
 	"github.com/ChotongW/grit_demo_wallet/internal/gateway/handlers"
 	"github.com/ChotongW/grit_demo_wallet/internal/gateway/middleware"
+	"github.com/ChotongW/grit_demo_wallet/pkg/audit"
 	"github.com/ChotongW/grit_demo_wallet/pkg/requestid"
 
 	"github.com/gin-gonic/gin"
@@ -29,10 +30,14 @@ func NewRouter(config *gateway.ServiceConfig, logger *logrus.Logger) {
 
 	r.Use(requestid.GinMiddleware())
 
+	if config.AuditKey == "" {
+		logger.Warn("no AUDIT_KEY configured, the services audit every call as UNKNOWN")
+	}
+
 	subledgerConn, err := grpc.NewClient(
 		config.SubledgerService,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(requestid.UnaryClientInterceptor()),
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), audit.UnaryClientInterceptor("gateway", []byte(config.AuditKey))),
 		grpc.WithStreamInterceptor(requestid.StreamClientInterceptor()),
 	)
 	if err != nil {
@@ -43,7 +48,7 @@ func NewRouter(config *gateway.ServiceConfig, logger *logrus.Logger) {
 	accountConn, err := grpc.NewClient(
 		config.AccountsService,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(requestid.UnaryClientInterceptor()),
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), audit.UnaryClientInterceptor("gateway", []byte(config.AuditKey))),
 		grpc.WithStreamInterceptor(requestid.StreamClientInterceptor()),
 	)
 	if err != nil {
@@ -71,10 +76,50 @@ func NewRouter(config *gateway.ServiceConfig, logger *logrus.Logger) {
 	apiV1.POST("/accounts", accountsHandlers.CreateAccount)
 	apiV1.GET("/accounts/:account_id", accountsHandlers.GetAccount)
 	apiV1.GET("/accounts/:account_id/balance", accountsHandlers.GetBalance)
+	apiV1.PUT("/accounts/:account_id/handle", accountsHandlers.SetHandle)
+	apiV1.POST("/users/:user_id/accounts", accountsHandlers.OpenWallet)
+	apiV1.GET("/users/:user_id/accounts", accountsHandlers.ListAccountsForUser)
+	apiV1.PUT("/users/:user_id/default-account", accountsHandlers.SetDefaultWallet)
+	apiV1.GET("/users/:user_id", accountsHandlers.GetUser)
+	apiV1.PATCH("/users/:user_id", accountsHandlers.UpdateProfile)
+	apiV1.POST("/users/:user_id/email-change", accountsHandlers.RequestEmailChange)
+	apiV1.POST("/users/email-change/confirm", accountsHandlers.ConfirmEmailChange)
+	apiV1.GET("/users/:user_id/notification-preferences", accountsHandlers.GetNotificationPreferences)
+	apiV1.PUT("/users/:user_id/notification-preferences", accountsHandlers.SetNotificationPreferences)
+	apiV1.GET("/users/:user_id/notifications", accountsHandlers.ListNotifications)
+	apiV1.POST("/webhooks", accountsHandlers.RegisterWebhook)
+	apiV1.GET("/webhooks", accountsHandlers.ListWebhooks)
+	apiV1.DELETE("/webhooks/:webhook_id", accountsHandlers.DeleteWebhook)
+	apiV1.GET("/webhooks/:webhook_id/deliveries", accountsHandlers.ListWebhookDeliveries)
+	apiV1.GET("/webhooks/dead-letters", accountsHandlers.ListDeadLetters)
+	apiV1.GET("/webhooks/deliveries/:delivery_id", accountsHandlers.GetWebhookDelivery)
+	apiV1.POST("/webhooks/deliveries/:delivery_id/replay", accountsHandlers.ReplayWebhookDelivery)
 	apiV1.POST("/accounts/deposit", accountsHandlers.Deposit)
 	apiV1.POST("/accounts/withdraw", accountsHandlers.Withdraw)
 	apiV1.POST("/transfers", accountsHandlers.Transfer)
+	apiV1.GET("/recipients/lookup", accountsHandlers.LookupRecipient)
+	apiV1.GET("/accounts/:account_id/fees/quote", accountsHandlers.QuoteFee)
+	apiV1.POST("/accounts/:account_id/scheduled-transfers", accountsHandlers.CreateScheduledTransfer)
+	apiV1.GET("/accounts/:account_id/scheduled-transfers", accountsHandlers.ListScheduledTransfers)
+	apiV1.POST("/accounts/:account_id/scheduled-transfers/:schedule_id/pause", accountsHandlers.PauseScheduledTransfer)
+	apiV1.POST("/accounts/:account_id/scheduled-transfers/:schedule_id/resume", accountsHandlers.ResumeScheduledTransfer)
+	apiV1.DELETE("/accounts/:account_id/scheduled-transfers/:schedule_id", accountsHandlers.CancelScheduledTransfer)
+	apiV1.POST("/accounts/:account_id/payment-requests", accountsHandlers.CreatePaymentRequest)
+	apiV1.GET("/accounts/:account_id/payment-requests", accountsHandlers.ListPaymentRequests)
+	apiV1.POST("/accounts/:account_id/payment-requests/:request_id/accept", accountsHandlers.AcceptPaymentRequest)
+	apiV1.POST("/accounts/:account_id/payment-requests/:request_id/decline", accountsHandlers.DeclinePaymentRequest)
+	apiV1.POST("/accounts/:account_id/payment-requests/:request_id/cancel", accountsHandlers.CancelPaymentRequest)
 	apiV1.GET("/accounts/:account_id/transactions", accountsHandlers.GetTransactionHistory)
+	apiV1.GET("/accounts/:account_id/statements/:period", accountsHandlers.GenerateStatement)
+	apiV1.GET("/transactions/:transaction_id", accountsHandlers.GetTransaction)
+	apiV1.POST("/payouts", accountsHandlers.CreatePayoutBatch)
+	apiV1.POST("/payouts/funding", accountsHandlers.FundDisbursementPool)
+	apiV1.GET("/payouts", accountsHandlers.ListPayoutBatches)
+	apiV1.GET("/payouts/:payout_id", accountsHandlers.GetPayoutBatch)
+	apiV1.GET("/payouts/:payout_id/rows", accountsHandlers.ListPayoutRows)
+	apiV1.POST("/payouts/:payout_id/approve", accountsHandlers.ApprovePayoutBatch)
+	apiV1.POST("/payouts/:payout_id/cancel", accountsHandlers.CancelPayoutBatch)
+	apiV1.GET("/payouts/:payout_id/results", accountsHandlers.ExportPayoutResults)
 
 	HttpServer := http.Server{
 		Addr:              fmt.Sprintf(":%d", config.HttpPort),
@@ -0,0 +1,62 @@
+package errors
+
+import "github.com/ChotongW/grit_demo_wallet/pkg/apperror"
+
+// Domain is the google.rpc.ErrorInfo domain for errors raised by the subledger service.
+const Domain = "subledger.grit-demo-wallet"
+
+const (
+	ReasonNotEnoughEntries      = "NOT_ENOUGH_ENTRIES"
+	ReasonInvalidDirection      = "INVALID_DIRECTION"
+	ReasonInvalidAmount         = "INVALID_AMOUNT"
+	ReasonAmountMustBePositive  = "AMOUNT_MUST_BE_POSITIVE"
+	ReasonUnbalancedTransaction = "UNBALANCED_TRANSACTION"
+	ReasonBalanceNotFound       = "BALANCE_NOT_FOUND"
+	ReasonTransactionNotFound   = "TRANSACTION_NOT_FOUND"
+	ReasonInvalidCursor         = "INVALID_CURSOR"
+	ReasonInvalidFilter         = "INVALID_FILTER"
+	ReasonUnknownLedgerAccount  = "UNKNOWN_LEDGER_ACCOUNT"
+	ReasonLedgerAccountExists   = "LEDGER_ACCOUNT_ALREADY_EXISTS"
+	ReasonLedgerAccountNotFound = "LEDGER_ACCOUNT_NOT_FOUND"
+	ReasonInvalidAccountClass   = "INVALID_ACCOUNT_CLASS"
+	ReasonInvalidParentAccount  = "INVALID_PARENT_ACCOUNT"
+	ReasonInvalidLedgerAccount  = "INVALID_LEDGER_ACCOUNT"
+	ReasonInvalidEffectiveDate  = "INVALID_EFFECTIVE_DATE"
+	ReasonInvalidPeriod         = "INVALID_PERIOD"
+	ReasonPeriodClosed          = "PERIOD_CLOSED"
+	ReasonPeriodNotEnded        = "PERIOD_NOT_ENDED"
+	ReasonPeriodOutOfOrder      = "PERIOD_OUT_OF_ORDER"
+	ReasonPeriodNotClosed       = "PERIOD_NOT_CLOSED"
+	ReasonInvalidBatch          = "INVALID_BATCH"
+	ReasonBatchAborted          = "BATCH_ABORTED"
+	ReasonInvalidJournal        = "INVALID_JOURNAL"
+	ReasonDuplicateReference    = "DUPLICATE_REFERENCE"
+)
+
+var (
+	ErrNotEnoughEntries      = apperror.Invalid(ReasonNotEnoughEntries, "entries", "at least 2 entries required for double-entry accounting")
+	ErrInvalidDirection      = apperror.Invalid(ReasonInvalidDirection, "entries.direction", "invalid direction")
+	ErrInvalidAmount         = apperror.Invalid(ReasonInvalidAmount, "entries.amount", "invalid amount")
+	ErrAmountMustBePositive  = apperror.Invalid(ReasonAmountMustBePositive, "entries.amount", "entry amount must be positive")
+	ErrUnbalancedTransaction = apperror.Invalid(ReasonUnbalancedTransaction, "entries", "debits must equal credits")
+	ErrBalanceNotFound       = apperror.New(apperror.KindNotFound, ReasonBalanceNotFound, "balance not found")
+	ErrTransactionNotFound   = apperror.New(apperror.KindNotFound, ReasonTransactionNotFound, "transaction not found")
+	ErrInvalidCursor         = apperror.Invalid(ReasonInvalidCursor, "cursor", "invalid cursor")
+	ErrInvalidFilter         = apperror.Invalid(ReasonInvalidFilter, "", "invalid filter")
+	ErrUnknownLedgerAccount  = apperror.Invalid(ReasonUnknownLedgerAccount, "entries.account_id", "unknown ledger account")
+	ErrLedgerAccountExists   = apperror.New(apperror.KindAlreadyExists, ReasonLedgerAccountExists, "ledger account already exists")
+	ErrLedgerAccountNotFound = apperror.New(apperror.KindNotFound, ReasonLedgerAccountNotFound, "ledger account not found")
+	ErrInvalidAccountClass   = apperror.Invalid(ReasonInvalidAccountClass, "class", "invalid account class")
+	ErrInvalidParentAccount  = apperror.Invalid(ReasonInvalidParentAccount, "parent_account_id", "invalid parent account")
+	ErrInvalidLedgerAccount  = apperror.Invalid(ReasonInvalidLedgerAccount, "", "invalid ledger account")
+	ErrInvalidEffectiveDate  = apperror.Invalid(ReasonInvalidEffectiveDate, "effective_date", "invalid effective date")
+	ErrInvalidPeriod         = apperror.Invalid(ReasonInvalidPeriod, "period", "period must be YYYY-MM")
+	ErrPeriodClosed          = apperror.New(apperror.KindFailedPrecondition, ReasonPeriodClosed, "accounting period is closed")
+	ErrPeriodNotEnded        = apperror.New(apperror.KindFailedPrecondition, ReasonPeriodNotEnded, "accounting period has not ended")
+	ErrPeriodOutOfOrder      = apperror.New(apperror.KindFailedPrecondition, ReasonPeriodOutOfOrder, "earlier accounting periods must be closed first")
+	ErrPeriodNotClosed       = apperror.New(apperror.KindNotFound, ReasonPeriodNotClosed, "accounting period is not closed")
+	ErrInvalidBatch          = apperror.Invalid(ReasonInvalidBatch, "transactions", "invalid batch")
+	ErrBatchAborted          = apperror.New(apperror.KindFailedPrecondition, ReasonBatchAborted, "not posted: another transaction in the all-or-nothing batch was rejected")
+	ErrInvalidJournal        = apperror.Invalid(ReasonInvalidJournal, "data", "invalid journal")
+	ErrDuplicateReference    = apperror.New(apperror.KindAlreadyExists, ReasonDuplicateReference, "reference already posted")
+)
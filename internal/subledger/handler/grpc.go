@@ -1,34 +1,48 @@
 package handler
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
 
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/journal"
 	"github.com/ChotongW/grit_demo_wallet/internal/subledger/repository"
 	"github.com/ChotongW/grit_demo_wallet/internal/subledger/service"
 	pb "github.com/ChotongW/grit_demo_wallet/pb/subledger"
+	"github.com/ChotongW/grit_demo_wallet/pkg/admin"
+	"github.com/ChotongW/grit_demo_wallet/pkg/apperror"
+	"github.com/ChotongW/grit_demo_wallet/pkg/audit"
 	"github.com/ChotongW/grit_demo_wallet/pkg/requestid"
 
 	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type GRPCHandler struct {
 	pb.UnimplementedSubledgerServiceServer
-	service *service.Service
-	logger  logrus.FieldLogger
+	service  *service.Service
+	auditLog audit.Store
+	adminKey string
+	logger   logrus.FieldLogger
 }
 
-func NewGRPCHandler(svc *service.Service, logger *logrus.Logger) *GRPCHandler {
+func NewGRPCHandler(svc *service.Service, auditLog audit.Store, adminKey string, logger *logrus.Logger) *GRPCHandler {
 	newLogger := logger.WithFields(
 		logrus.Fields{
 			"package": "handler",
 		},
 	)
 	return &GRPCHandler{
-		service: svc,
-		logger:  newLogger,
+		service:  svc,
+		auditLog: auditLog,
+		adminKey: adminKey,
+		logger:   newLogger,
 	}
 }
 
@@ -40,45 +54,183 @@ func (h *GRPCHandler) loggerWithRequestID(ctx context.Context) logrus.FieldLogge
 	return h.logger
 }
 
+func (h *GRPCHandler) mapError(err error) error {
+	return apperror.ToStatus(subledgerErrors.Domain, err)
+}
+
 func (h *GRPCHandler) CreateTransaction(ctx context.Context, req *pb.CreateTransactionRequest) (*pb.CreateTransactionResponse, error) {
 	logger := h.loggerWithRequestID(ctx)
 
-	entries := make([]repository.TransactionEntry, 0, len(req.Entries))
-	for _, e := range req.Entries {
+	txn, err := toNewTransaction(req)
+	if err != nil {
+		logger.Errorf("invalid transaction: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Debugf("request body: %+v", req)
+	txnID, err := h.service.CreateTransaction(ctx, txn.ReferenceID, txn.Description, txn.EffectiveDate, txn.Entries)
+	if err != nil {
+		logger.Errorf("failed to create transaction: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("created transaction: %s (reference %s)", txnID, req.ReferenceId)
+	return &pb.CreateTransactionResponse{
+		Success:       true,
+		TransactionId: txnID,
+	}, nil
+}
+
+func toNewTransaction(req *pb.CreateTransactionRequest) (repository.NewTransaction, error) {
+	txn := repository.NewTransaction{
+		ReferenceID: req.ReferenceId,
+		Description: req.Description,
+		Entries:     make([]repository.TransactionEntry, 0, len(req.Entries)),
+	}
+
+	for i, e := range req.Entries {
 		amount, err := decimal.NewFromString(e.Amount)
 		if err != nil {
-			logger.Errorf("invalid amount: %v", err)
-			return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+			return txn, fmt.Errorf("%w: entries[%d]: %v", subledgerErrors.ErrInvalidAmount, i, err)
 		}
 
-		entries = append(entries, repository.TransactionEntry{
+		txn.Entries = append(txn.Entries, repository.TransactionEntry{
 			AccountID: e.AccountId,
 			Amount:    amount,
 			Direction: e.Direction,
 		})
 	}
 
-	logger.Debugf("request body: %+v", req)
-	err := h.service.CreateTransaction(ctx, req.ReferenceId, req.Description, entries)
+	if req.EffectiveDate != "" {
+		day, err := time.Parse("2006-01-02", req.EffectiveDate)
+		if err != nil {
+			return txn, fmt.Errorf("%w: %v", subledgerErrors.ErrInvalidEffectiveDate, err)
+		}
+		txn.EffectiveDate = day
+	}
+
+	return txn, nil
+}
+
+func (h *GRPCHandler) CreateTransactionBatch(ctx context.Context, req *pb.CreateTransactionBatchRequest) (*pb.CreateTransactionBatchResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	mode, err := service.ParseBatchMode(req.Mode)
 	if err != nil {
-		logger.Errorf("failed to create transaction: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to create transaction: %v", err)
+		return nil, h.mapError(err)
 	}
 
-	logger.Infof("created transaction: %s", req.ReferenceId)
-	return &pb.CreateTransactionResponse{
-		Success:       true,
-		TransactionId: req.ReferenceId,
-	}, nil
+	resp := &pb.CreateTransactionBatchResponse{Mode: mode}
+	if err := h.postBatch(ctx, resp, req.Transactions); err != nil {
+		logger.Errorf("failed to post batch of %d transactions: %v", len(req.Transactions), err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("posted %d of %d batched transactions (%s)", resp.Posted, len(req.Transactions), mode)
+	return resp, nil
+}
+
+func (h *GRPCHandler) CreateTransactionStream(stream pb.SubledgerService_CreateTransactionStreamServer) error {
+	ctx := stream.Context()
+	logger := h.loggerWithRequestID(ctx)
+
+	var resp *pb.CreateTransactionBatchResponse
+	var pending []*pb.CreateTransactionRequest
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			mode, err := service.ParseBatchMode(chunk.Mode)
+			if err != nil {
+				return h.mapError(err)
+			}
+			resp = &pb.CreateTransactionBatchResponse{Mode: mode}
+		}
+
+		if resp.Mode == service.BatchAtomic {
+			pending = append(pending, chunk.Transactions...)
+			continue
+		}
+		if len(chunk.Transactions) == 0 {
+			continue
+		}
+		if err := h.postBatch(ctx, resp, chunk.Transactions); err != nil {
+			logger.Errorf("failed to post streamed chunk after %d transactions: %v", len(resp.Results), err)
+			return h.mapError(err)
+		}
+	}
+
+	if resp == nil {
+		resp = &pb.CreateTransactionBatchResponse{Mode: service.BatchAtomic}
+	}
+	if len(pending) > 0 {
+		if err := h.postBatch(ctx, resp, pending); err != nil {
+			logger.Errorf("failed to post streamed batch of %d transactions: %v", len(pending), err)
+			return h.mapError(err)
+		}
+	}
+
+	logger.Infof("posted %d of %d streamed transactions (%s)", resp.Posted, len(resp.Results), resp.Mode)
+	return stream.SendAndClose(resp)
+}
+
+// postBatch posts reqs in resp.Mode and appends their results to resp,
+// numbering them on from the results already there.
+func (h *GRPCHandler) postBatch(ctx context.Context, resp *pb.CreateTransactionBatchResponse, reqs []*pb.CreateTransactionRequest) error {
+	items := make([]service.BatchItem, len(reqs))
+	for i, req := range reqs {
+		items[i].Transaction, items[i].Err = toNewTransaction(req)
+	}
+
+	results, err := h.service.CreateTransactions(ctx, items, resp.Mode)
+	if err != nil {
+		return err
+	}
+
+	offset := len(resp.Results)
+	for i, result := range results {
+		item := &pb.BatchItemResult{
+			Index:         int32(offset + i),
+			ReferenceId:   reqs[i].ReferenceId,
+			Success:       result.Err == nil,
+			TransactionId: result.TransactionID,
+		}
+		if result.Err != nil {
+			item.Reason = apperror.ReasonOf(result.Err)
+			item.Error = result.Err.Error()
+			resp.Rejected++
+		} else {
+			resp.Posted++
+		}
+		resp.Results = append(resp.Results, item)
+	}
+
+	return nil
 }
 
 func (h *GRPCHandler) GetBalance(ctx context.Context, req *pb.GetBalanceRequest) (*pb.GetBalanceResponse, error) {
 	logger := h.loggerWithRequestID(ctx)
 
-	balance, err := h.service.GetBalance(ctx, req.AccountId)
+	var balance decimal.Decimal
+	var err error
+	if req.AsOf != "" {
+		asOf, parseErr := time.Parse(time.RFC3339, req.AsOf)
+		if parseErr != nil {
+			return nil, h.mapError(fmt.Errorf("%w: as_of: %v", subledgerErrors.ErrInvalidFilter, parseErr))
+		}
+		balance, err = h.service.GetBalanceAt(ctx, req.AccountId, asOf)
+	} else {
+		balance, err = h.service.GetBalance(ctx, req.AccountId)
+	}
 	if err != nil {
 		logger.Errorf("failed to get balance: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to get balance: %v", err)
+		return nil, h.mapError(err)
 	}
 
 	logger.Infof("retrieved balance for account %s", req.AccountId)
@@ -88,3 +240,570 @@ func (h *GRPCHandler) GetBalance(ctx context.Context, req *pb.GetBalanceRequest)
 		Amount:    balance.String(),
 	}, nil
 }
+
+func (h *GRPCHandler) GetBalances(ctx context.Context, req *pb.GetBalancesRequest) (*pb.GetBalancesResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	var asOf *time.Time
+	if req.AsOf != "" {
+		t, err := time.Parse(time.RFC3339, req.AsOf)
+		if err != nil {
+			return nil, h.mapError(fmt.Errorf("%w: as_of: %v", subledgerErrors.ErrInvalidFilter, err))
+		}
+		asOf = &t
+	}
+
+	balances, err := h.service.GetBalances(ctx, req.AccountIds, asOf)
+	if err != nil {
+		logger.Errorf("failed to get balances: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	resp := &pb.GetBalancesResponse{Balances: make([]*pb.AccountBalance, len(req.AccountIds))}
+	for i, accountID := range req.AccountIds {
+		resp.Balances[i] = &pb.AccountBalance{AccountId: accountID, Amount: balances[accountID].String()}
+	}
+
+	logger.Infof("retrieved balances for %d accounts", len(req.AccountIds))
+	return resp, nil
+}
+
+func (h *GRPCHandler) GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.GetTransactionResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	txn, reversedBy, err := h.service.GetTransaction(ctx, req.TransactionId)
+	if err != nil {
+		logger.Errorf("failed to get transaction: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	entries := make([]*pb.LedgerEntry, len(txn.Entries))
+	for i, e := range txn.Entries {
+		entries[i] = &pb.LedgerEntry{
+			Id:        e.ID,
+			AccountId: e.AccountID,
+			Amount:    e.Amount.String(),
+			Direction: e.Direction,
+		}
+	}
+
+	logger.Infof("retrieved transaction %s", req.TransactionId)
+	return &pb.GetTransactionResponse{
+		TransactionId:            txn.TransactionID,
+		ReferenceId:              txn.ReferenceID,
+		Description:              txn.Description,
+		CreatedAt:                txn.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		EffectiveDate:            txn.EffectiveDate.Format("2006-01-02"),
+		Entries:                  entries,
+		ReversesTransactionId:    txn.ReversesTransactionID(),
+		ReversedByTransactionIds: reversedBy,
+	}, nil
+}
+
+func (h *GRPCHandler) ListEntries(ctx context.Context, req *pb.ListEntriesRequest) (*pb.ListEntriesResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	filter, err := entryFilterFromRequest(req)
+	if err != nil {
+		logger.Errorf("invalid entry filter: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	resp := &pb.ListEntriesResponse{}
+	var entries []repository.LedgerEntry
+
+	if req.UseCursor || req.Cursor != "" {
+		var next string
+		entries, next, err = h.service.ListEntries(ctx, filter, req.Cursor, int(req.PageSize), req.Ascending)
+		if err != nil {
+			logger.Errorf("failed to list entries: %v", err)
+			return nil, h.mapError(err)
+		}
+		resp.NextCursor = next
+		resp.HasMore = next != ""
+	} else {
+		page := int(req.Page)
+		if page < 1 {
+			page = 1
+		}
+		pageSize := int(req.PageSize)
+		if pageSize < 1 || pageSize > 100 {
+			pageSize = 20
+		}
+
+		var total int
+		entries, total, err = h.service.ListEntriesPage(ctx, filter, page, pageSize)
+		if err != nil {
+			logger.Errorf("failed to list entries: %v", err)
+			return nil, h.mapError(err)
+		}
+		resp.TotalCount = int32(total)
+		resp.HasMore = page*pageSize < total
+	}
+
+	resp.Entries = make([]*pb.LedgerEntry, len(entries))
+	for i, e := range entries {
+		resp.Entries[i] = toProtoEntry(e)
+	}
+
+	logger.Infof("listed %d entries", len(entries))
+	return resp, nil
+}
+
+func (h *GRPCHandler) ExportEntries(req *pb.ExportEntriesRequest, stream pb.SubledgerService_ExportEntriesServer) error {
+	ctx := stream.Context()
+	logger := h.loggerWithRequestID(ctx)
+
+	filter, err := entryFilterFromRequest(&pb.ListEntriesRequest{
+		AccountId:       req.AccountId,
+		TransactionId:   req.TransactionId,
+		ReferenceId:     req.ReferenceId,
+		From:            req.From,
+		To:              req.To,
+		Direction:       req.Direction,
+		MinAmount:       req.MinAmount,
+		MaxAmount:       req.MaxAmount,
+		ReferencePrefix: req.ReferencePrefix,
+		Search:          req.Search,
+	})
+	if err != nil {
+		logger.Errorf("invalid entry filter: %v", err)
+		return h.mapError(err)
+	}
+
+	count, err := h.service.ExportEntries(ctx, filter, !req.Descending, func(e repository.LedgerEntry) error {
+		return stream.Send(toProtoEntry(e))
+	})
+	if err != nil {
+		logger.Errorf("failed to export entries after %d rows: %v", count, err)
+		return h.mapError(err)
+	}
+
+	logger.Infof("exported %d entries", count)
+	return nil
+}
+
+func toProtoEntry(e repository.LedgerEntry) *pb.LedgerEntry {
+	return &pb.LedgerEntry{
+		Id:                     e.ID,
+		AccountId:              e.AccountID,
+		Amount:                 e.Amount.String(),
+		Direction:              e.Direction,
+		TransactionId:          e.TransactionID,
+		ReferenceId:            e.ReferenceID,
+		Description:            e.Description,
+		CreatedAt:              e.CreatedAt.Format(time.RFC3339Nano),
+		CounterpartyAccountIds: e.CounterpartyAccountIDs,
+		EffectiveDate:          e.EffectiveDate.Format("2006-01-02"),
+	}
+}
+
+func entryFilterFromRequest(req *pb.ListEntriesRequest) (repository.EntryFilter, error) {
+	filter := repository.EntryFilter{
+		AccountID:       req.AccountId,
+		TransactionID:   req.TransactionId,
+		ReferenceID:     req.ReferenceId,
+		ReferencePrefix: req.ReferencePrefix,
+		Search:          req.Search,
+	}
+
+	if req.From != "" {
+		from, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return filter, fmt.Errorf("%w: from: %v", subledgerErrors.ErrInvalidFilter, err)
+		}
+		filter.From = &from
+	}
+	if req.To != "" {
+		to, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return filter, fmt.Errorf("%w: to: %v", subledgerErrors.ErrInvalidFilter, err)
+		}
+		filter.To = &to
+	}
+
+	switch req.Direction {
+	case "", service.DEBIT, service.CREDIT:
+		filter.Direction = req.Direction
+	default:
+		return filter, fmt.Errorf("%w: %s", subledgerErrors.ErrInvalidDirection, req.Direction)
+	}
+
+	if req.MinAmount != "" {
+		amount, err := decimal.NewFromString(req.MinAmount)
+		if err != nil {
+			return filter, fmt.Errorf("%w: min_amount: %v", subledgerErrors.ErrInvalidAmount, err)
+		}
+		filter.MinAmount = &amount
+	}
+	if req.MaxAmount != "" {
+		amount, err := decimal.NewFromString(req.MaxAmount)
+		if err != nil {
+			return filter, fmt.Errorf("%w: max_amount: %v", subledgerErrors.ErrInvalidAmount, err)
+		}
+		filter.MaxAmount = &amount
+	}
+
+	return filter, nil
+}
+
+func (h *GRPCHandler) CreateLedgerAccount(ctx context.Context, req *pb.CreateLedgerAccountRequest) (*pb.CreateLedgerAccountResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	account := repository.LedgerAccount{
+		AccountID: req.AccountId,
+		Name:      req.Name,
+		Class:     strings.ToUpper(req.Class),
+	}
+	if req.Code != "" {
+		account.Code = &req.Code
+	}
+	if req.ParentAccountId != "" {
+		account.ParentAccountID = &req.ParentAccountId
+	}
+
+	created, err := h.service.CreateLedgerAccount(ctx, account)
+	if err != nil {
+		logger.Errorf("failed to create ledger account: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	return &pb.CreateLedgerAccountResponse{
+		Account: toProtoLedgerAccount(*created),
+	}, nil
+}
+
+func (h *GRPCHandler) GetLedgerAccount(ctx context.Context, req *pb.GetLedgerAccountRequest) (*pb.GetLedgerAccountResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	account, err := h.service.GetLedgerAccount(ctx, req.AccountId)
+	if err != nil {
+		logger.Errorf("failed to get ledger account: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	return &pb.GetLedgerAccountResponse{Account: toProtoLedgerAccount(*account)}, nil
+}
+
+func (h *GRPCHandler) ListLedgerAccounts(ctx context.Context, req *pb.ListLedgerAccountsRequest) (*pb.ListLedgerAccountsResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	accounts, total, err := h.service.ListLedgerAccounts(ctx, strings.ToUpper(req.Class), req.ParentAccountId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		logger.Errorf("failed to list ledger accounts: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	resp := &pb.ListLedgerAccountsResponse{
+		Accounts:   make([]*pb.LedgerAccount, len(accounts)),
+		TotalCount: int32(total),
+	}
+	for i, account := range accounts {
+		resp.Accounts[i] = toProtoLedgerAccount(account)
+	}
+
+	logger.Infof("listed %d ledger accounts", len(accounts))
+	return resp, nil
+}
+
+func toProtoLedgerAccount(account repository.LedgerAccount) *pb.LedgerAccount {
+	protoAccount := &pb.LedgerAccount{
+		AccountId:     account.AccountID,
+		Name:          account.Name,
+		Class:         account.Class,
+		NormalBalance: account.NormalBalance,
+		CreatedAt:     account.CreatedAt.Format(time.RFC3339),
+	}
+	if account.Code != nil {
+		protoAccount.Code = *account.Code
+	}
+	if account.ParentAccountID != nil {
+		protoAccount.ParentAccountId = *account.ParentAccountID
+	}
+	return protoAccount
+}
+
+func (h *GRPCHandler) VerifyLedgerIntegrity(ctx context.Context, req *pb.VerifyLedgerIntegrityRequest) (*pb.VerifyLedgerIntegrityResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	report, err := h.service.VerifyLedgerIntegrity(ctx)
+	if err != nil {
+		logger.Errorf("failed to verify ledger integrity: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	resp := &pb.VerifyLedgerIntegrityResponse{
+		Intact:             report.Break == nil,
+		LinksChecked:       report.LinksChecked,
+		CheckpointsChecked: int32(report.CheckpointsChecked),
+		HeadSeq:            report.HeadSeq,
+		HeadHash:           report.HeadHash,
+	}
+	if brk := report.Break; brk != nil {
+		resp.FirstBreak = &pb.ChainBreak{
+			Seq:           brk.Seq,
+			TransactionId: brk.TransactionID,
+			Reason:        brk.Reason,
+			Expected:      brk.Expected,
+			Actual:        brk.Actual,
+		}
+		logger.Warnf("ledger chain broken at %d (%s): %s", brk.Seq, brk.TransactionID, brk.Reason)
+	} else {
+		logger.Infof("ledger chain intact through %d links", report.LinksChecked)
+	}
+
+	return resp, nil
+}
+
+func (h *GRPCHandler) ClosePeriod(ctx context.Context, req *pb.ClosePeriodRequest) (*pb.ClosePeriodResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	period, balances, err := h.service.ClosePeriod(ctx, req.Period)
+	if err != nil {
+		logger.Errorf("failed to close period %s: %v", req.Period, err)
+		return nil, h.mapError(err)
+	}
+
+	logger.Infof("closed period %s", period.Period)
+	return &pb.ClosePeriodResponse{
+		Period:   toProtoPeriod(*period),
+		Balances: toProtoClosingBalances(balances),
+	}, nil
+}
+
+func (h *GRPCHandler) ListAccountingPeriods(ctx context.Context, req *pb.ListAccountingPeriodsRequest) (*pb.ListAccountingPeriodsResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	periods, err := h.service.ListAccountingPeriods(ctx, req.Status)
+	if err != nil {
+		logger.Errorf("failed to list accounting periods: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	resp := &pb.ListAccountingPeriodsResponse{
+		Periods: make([]*pb.AccountingPeriod, len(periods)),
+	}
+	for i, period := range periods {
+		resp.Periods[i] = toProtoPeriod(period)
+	}
+	return resp, nil
+}
+
+func (h *GRPCHandler) GetClosingBalances(ctx context.Context, req *pb.GetClosingBalancesRequest) (*pb.GetClosingBalancesResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	period, balances, err := h.service.GetClosingBalances(ctx, req.Period)
+	if err != nil {
+		logger.Errorf("failed to get closing balances of %s: %v", req.Period, err)
+		return nil, h.mapError(err)
+	}
+
+	return &pb.GetClosingBalancesResponse{
+		Period:   toProtoPeriod(*period),
+		Balances: toProtoClosingBalances(balances),
+	}, nil
+}
+
+func toProtoPeriod(period repository.AccountingPeriod) *pb.AccountingPeriod {
+	protoPeriod := &pb.AccountingPeriod{
+		Period:   period.Period,
+		StartsOn: period.StartsOn.Format("2006-01-02"),
+		EndsOn:   period.EndsOn.Format("2006-01-02"),
+		Status:   period.Status,
+	}
+	if period.ClosedAt != nil {
+		protoPeriod.ClosedAt = period.ClosedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if period.ChainSeq != nil {
+		protoPeriod.ChainSeq = *period.ChainSeq
+	}
+	return protoPeriod
+}
+
+func toProtoClosingBalances(balances []repository.ClosingBalance) []*pb.ClosingBalance {
+	protoBalances := make([]*pb.ClosingBalance, len(balances))
+	for i, b := range balances {
+		protoBalances[i] = &pb.ClosingBalance{AccountId: b.AccountID, Amount: b.Amount.String()}
+	}
+	return protoBalances
+}
+
+func (h *GRPCHandler) ListAuditLog(ctx context.Context, req *pb.ListAuditLogRequest) (*pb.ListAuditLogResponse, error) {
+	logger := h.loggerWithRequestID(ctx)
+
+	if err := admin.Require(ctx, h.adminKey); err != nil {
+		logger.Warnf("refused to list audit log: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	filter, err := audit.ParseFilter(req.Action, req.ActorId, req.Target, req.From, req.To)
+	if err != nil {
+		return nil, h.mapError(err)
+	}
+
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	entries, total, err := h.auditLog.List(ctx, filter, page, pageSize)
+	if err != nil {
+		logger.Errorf("failed to list audit log: %v", err)
+		return nil, h.mapError(err)
+	}
+
+	pbEntries := make([]*pb.AuditEntry, len(entries))
+	for i, e := range entries {
+		pbEntries[i] = &pb.AuditEntry{
+			Id:            e.ID,
+			OccurredAt:    e.OccurredAt.Format("2006-01-02T15:04:05Z07:00"),
+			Service:       e.Service,
+			Action:        e.Action,
+			ActorType:     e.ActorType,
+			ActorId:       e.ActorID,
+			ClaimedUserId: e.ClaimedUserID,
+			Target:        e.Target,
+			Request:       string(e.Request),
+			Before:        string(e.Before),
+			After:         string(e.After),
+			Status:        e.Status,
+			Error:         e.Error,
+			RequestId:     e.RequestID,
+			SourceIp:      e.SourceIP,
+			ClaimedIp:     e.ClaimedIP,
+		}
+	}
+
+	return &pb.ListAuditLogResponse{
+		Entries:    pbEntries,
+		TotalCount: int32(total),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	}, nil
+}
+
+const (
+	journalChunkSize = 32 * 1024
+	// maxJournalSize caps an imported journal, which is held in memory.
+	maxJournalSize = 64 << 20
+)
+
+func (h *GRPCHandler) ImportJournal(stream pb.SubledgerService_ImportJournalServer) error {
+	ctx := stream.Context()
+	logger := h.loggerWithRequestID(ctx)
+
+	var first *pb.ImportJournalRequest
+	var data bytes.Buffer
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if first == nil {
+			first = chunk
+		}
+		if data.Len()+len(chunk.Data) > maxJournalSize {
+			return h.mapError(fmt.Errorf("%w: larger than %d bytes", subledgerErrors.ErrInvalidJournal, maxJournalSize))
+		}
+		data.Write(chunk.Data)
+	}
+	if first == nil {
+		return h.mapError(fmt.Errorf("%w: empty stream", subledgerErrors.ErrInvalidJournal))
+	}
+
+	report, err := h.service.ImportJournal(ctx, &data, first.Format, first.DryRun)
+	if err != nil {
+		logger.Errorf("failed to import journal: %v", err)
+		return h.mapError(err)
+	}
+
+	resp := &pb.ImportJournalResponse{
+		DryRun:       report.DryRun,
+		Lines:        int32(report.Lines),
+		Transactions: int32(report.Transactions),
+		Issues:       make([]*pb.JournalIssue, len(report.Issues)),
+		Posted:       make([]*pb.ImportedTransaction, len(report.Posted)),
+	}
+	for i, issue := range report.Issues {
+		resp.Issues[i] = &pb.JournalIssue{
+			Line:        int32(issue.Line),
+			ReferenceId: issue.ReferenceID,
+			Reason:      apperror.ReasonOf(issue.Err),
+			Error:       issue.Err.Error(),
+		}
+	}
+	for i, posted := range report.Posted {
+		resp.Posted[i] = &pb.ImportedTransaction{ReferenceId: posted.ReferenceID, TransactionId: posted.TransactionID}
+	}
+
+	logger.Infof("imported journal: lines=%d, transactions=%d, issues=%d, posted=%d, dry_run=%t",
+		report.Lines, report.Transactions, len(report.Issues), len(report.Posted), report.DryRun)
+	return stream.SendAndClose(resp)
+}
+
+func (h *GRPCHandler) ExportJournal(req *pb.ExportJournalRequest, stream pb.SubledgerService_ExportJournalServer) error {
+	ctx := stream.Context()
+	logger := h.loggerWithRequestID(ctx)
+
+	kind := strings.ToLower(strings.TrimSpace(req.Kind))
+	if kind == "" {
+		kind = journal.KindEntries
+	}
+	format := strings.ToLower(strings.TrimSpace(req.Format))
+	if format == "" {
+		format = journal.FormatCSV
+	}
+
+	cw := &journalChunkWriter{
+		stream: stream,
+		first: &pb.JournalChunk{
+			ContentType: journal.ContentType(format),
+			Filename:    journal.Filename(kind, strings.TrimSpace(req.Period), format),
+		},
+	}
+	w := bufio.NewWriterSize(cw, journalChunkSize)
+
+	count, err := h.service.ExportJournal(ctx, w, kind, req.Period, format)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil && cw.first != nil {
+		// Nothing was written; the metadata still goes out.
+		err = stream.Send(cw.first)
+	}
+	if err != nil {
+		logger.Errorf("failed to export %s journal of %s after %d rows: %v", kind, req.Period, count, err)
+		return h.mapError(err)
+	}
+
+	logger.Infof("exported %s journal of %s: %d rows", kind, req.Period, count)
+	return nil
+}
+
+// journalChunkWriter sends what is written to it as JournalChunks, the
+// first of them carrying the content type and filename.
+type journalChunkWriter struct {
+	stream pb.SubledgerService_ExportJournalServer
+	first  *pb.JournalChunk
+}
+
+func (w *journalChunkWriter) Write(p []byte) (int, error) {
+	chunk := w.first
+	if chunk == nil {
+		chunk = &pb.JournalChunk{}
+	}
+	w.first = nil
+
+	chunk.Data = append([]byte(nil), p...)
+	if err := w.stream.Send(chunk); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,307 @@
+// Package journal reads and writes ledger journals as CSV or JSON Lines:
+// one row per entry, the entries of a transaction sharing a reference_id.
+package journal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+// What an export holds: the entries effective in a period, or the balance
+// of every account over it.
+const (
+	KindEntries  = "entries"
+	KindBalances = "balances"
+)
+
+// maxLineSize caps a single JSON Lines record.
+const maxLineSize = 1 << 20
+
+var (
+	entryColumns   = []string{"reference_id", "effective_date", "description", "account_id", "direction", "amount", "transaction_id", "created_at"}
+	balanceColumns = []string{"account_id", "normal_balance", "opening_balance", "debits", "credits", "closing_balance"}
+	// requiredColumns must be present in an imported CSV; effective_date
+	// and description may be left out.
+	requiredColumns = []string{"reference_id", "account_id", "direction", "amount"}
+)
+
+// Line is one entry of a journal. Number is its line in the file it was
+// read from. TransactionID and CreatedAt are only written on export; on
+// import the entries of a reference must all carry the same transaction_id,
+// if any, so that two transactions cannot share one.
+type Line struct {
+	Number        int    `json:"-"`
+	ReferenceID   string `json:"reference_id"`
+	EffectiveDate string `json:"effective_date"`
+	Description   string `json:"description"`
+	AccountID     string `json:"account_id"`
+	Direction     string `json:"direction"`
+	Amount        string `json:"amount"`
+	TransactionID string `json:"transaction_id,omitempty"`
+	CreatedAt     string `json:"created_at,omitempty"`
+}
+
+// Balance is the movement of one account over a period. Closing is Opening
+// moved by the period's debits and credits in the account's normal
+// direction.
+type Balance struct {
+	AccountID     string
+	NormalBalance string
+	Opening       decimal.Decimal
+	Debits        decimal.Decimal
+	Credits       decimal.Decimal
+	Closing       decimal.Decimal
+}
+
+func IsSupportedFormat(format string) bool {
+	return format == FormatCSV || format == FormatJSONL
+}
+
+func IsSupportedKind(kind string) bool {
+	return kind == KindEntries || kind == KindBalances
+}
+
+// FormatOf returns the format a file name's extension implies, or "".
+func FormatOf(name string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return FormatCSV
+	case ".jsonl", ".ndjson":
+		return FormatJSONL
+	}
+	return ""
+}
+
+func ContentType(format string) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv"
+	case FormatJSONL:
+		return "application/x-ndjson"
+	}
+	return "application/octet-stream"
+}
+
+func Filename(kind, period, format string) string {
+	return fmt.Sprintf("journal-%s-%s.%s", kind, period, format)
+}
+
+// Read parses every line of a journal. It fails on a file it cannot parse
+// at all; the values of its fields are left for the caller to check.
+func Read(r io.Reader, format string) ([]Line, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(r)
+	case FormatJSONL:
+		return readJSONL(r)
+	}
+	return nil, fmt.Errorf("unsupported journal format %q", format)
+}
+
+func readCSV(r io.Reader) ([]Line, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := index[name]; !ok {
+			return nil, fmt.Errorf("header has no %s column", name)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := index[name]
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var lines []Line
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return lines, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		number, _ := cr.FieldPos(0)
+		lines = append(lines, Line{
+			Number:        number,
+			ReferenceID:   field(record, "reference_id"),
+			EffectiveDate: field(record, "effective_date"),
+			Description:   field(record, "description"),
+			AccountID:     field(record, "account_id"),
+			Direction:     field(record, "direction"),
+			Amount:        field(record, "amount"),
+			TransactionID: field(record, "transaction_id"),
+		})
+	}
+}
+
+// jsonLine accepts an amount written either as a JSON number or a string.
+type jsonLine struct {
+	ReferenceID   string          `json:"reference_id"`
+	EffectiveDate string          `json:"effective_date"`
+	Description   string          `json:"description"`
+	AccountID     string          `json:"account_id"`
+	Direction     string          `json:"direction"`
+	Amount        json.RawMessage `json:"amount"`
+	TransactionID string          `json:"transaction_id"`
+}
+
+func readJSONL(r io.Reader) ([]Line, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+
+	var lines []Line
+	for number := 1; scanner.Scan(); number++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if number == 1 {
+			raw = bytes.TrimPrefix(raw, []byte("\ufeff"))
+		}
+		if len(raw) == 0 {
+			continue
+		}
+
+		var l jsonLine
+		if err := json.Unmarshal(raw, &l); err != nil {
+			return nil, fmt.Errorf("line %d: %v", number, err)
+		}
+
+		amount := string(l.Amount)
+		if unquoted, err := strconv.Unquote(amount); err == nil {
+			amount = unquoted
+		}
+
+		lines = append(lines, Line{
+			Number:        number,
+			ReferenceID:   strings.TrimSpace(l.ReferenceID),
+			EffectiveDate: strings.TrimSpace(l.EffectiveDate),
+			Description:   l.Description,
+			AccountID:     strings.TrimSpace(l.AccountID),
+			Direction:     strings.TrimSpace(l.Direction),
+			Amount:        strings.TrimSpace(amount),
+			TransactionID: strings.TrimSpace(l.TransactionID),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// Writer writes journal rows one at a time, starting a CSV with its header.
+// Flush must be called once the last row is written.
+type Writer struct {
+	columns []string
+	csv     *csv.Writer
+	json    *json.Encoder
+	started bool
+}
+
+// NewEntryWriter returns a Writer of Lines to w in format, which must be
+// supported.
+func NewEntryWriter(w io.Writer, format string) *Writer {
+	return newWriter(w, format, entryColumns)
+}
+
+// NewBalanceWriter returns a Writer of Balances to w in format, which must
+// be supported.
+func NewBalanceWriter(w io.Writer, format string) *Writer {
+	return newWriter(w, format, balanceColumns)
+}
+
+func newWriter(w io.Writer, format string, columns []string) *Writer {
+	if format == FormatJSONL {
+		return &Writer{columns: columns, json: json.NewEncoder(w)}
+	}
+	return &Writer{columns: columns, csv: csv.NewWriter(w)}
+}
+
+func (w *Writer) WriteLine(l Line) error {
+	return w.write(l, []string{l.ReferenceID, l.EffectiveDate, l.Description, l.AccountID, l.Direction, l.Amount, l.TransactionID, l.CreatedAt})
+}
+
+func (w *Writer) WriteBalance(b Balance) error {
+	record := []string{
+		b.AccountID,
+		b.NormalBalance,
+		b.Opening.StringFixed(2),
+		b.Debits.StringFixed(2),
+		b.Credits.StringFixed(2),
+		b.Closing.StringFixed(2),
+	}
+	return w.write(jsonBalance{record[0], record[1], record[2], record[3], record[4], record[5]}, record)
+}
+
+type jsonBalance struct {
+	AccountID      string `json:"account_id"`
+	NormalBalance  string `json:"normal_balance"`
+	OpeningBalance string `json:"opening_balance"`
+	Debits         string `json:"debits"`
+	Credits        string `json:"credits"`
+	ClosingBalance string `json:"closing_balance"`
+}
+
+func (w *Writer) write(value interface{}, record []string) error {
+	if w.json != nil {
+		return w.json.Encode(value)
+	}
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	return w.csv.Write(record)
+}
+
+func (w *Writer) writeHeader() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+	return w.csv.Write(w.columns)
+}
+
+// Flush writes any buffered rows, and the header of a CSV with none.
+func (w *Writer) Flush() error {
+	if w.csv == nil {
+		return nil
+	}
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
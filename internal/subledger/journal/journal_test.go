@@ -0,0 +1,177 @@
+package journal_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/journal"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestReadCSV(t *testing.T) {
+	input := "\ufeffReference_ID, Account_ID,direction,amount,description,transaction_id\n" +
+		"ref-1,1001,DEBIT,10.00,\"rent, March\",\n" +
+		"ref-1,2001,CREDIT,10.00,\"two\nlines\",\n" +
+		"\n" +
+		"ref-2,1001,debit, 5 ,,txn-2\n"
+
+	lines, err := journal.Read(strings.NewReader(input), journal.FormatCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []journal.Line{
+		{Number: 2, ReferenceID: "ref-1", AccountID: "1001", Direction: "DEBIT", Amount: "10.00", Description: "rent, March"},
+		{Number: 3, ReferenceID: "ref-1", AccountID: "2001", Direction: "CREDIT", Amount: "10.00", Description: "two\nlines"},
+		{Number: 6, ReferenceID: "ref-2", AccountID: "1001", Direction: "debit", Amount: "5", TransactionID: "txn-2"},
+	}
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("Read =\n%+v\nwant\n%+v", lines, want)
+	}
+}
+
+func TestReadJSONL(t *testing.T) {
+	input := "\ufeff{\"reference_id\":\"ref-1\",\"account_id\":\"1001\",\"direction\":\"DEBIT\",\"amount\":10.5,\"effective_date\":\"2026-01-31\"}\n" +
+		"\n" +
+		"  {\"reference_id\":\" ref-1 \",\"account_id\":\"2001\",\"direction\":\"CREDIT\",\"amount\":\"10.50\",\"description\":\" kept \"}  \n" +
+		"{\"reference_id\":\"ref-2\",\"account_id\":\"1001\",\"direction\":\"DEBIT\",\"amount\":\"1\",\"transaction_id\":\"txn-2\",\"unknown\":true}\n"
+
+	lines, err := journal.Read(strings.NewReader(input), journal.FormatJSONL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []journal.Line{
+		{Number: 1, ReferenceID: "ref-1", AccountID: "1001", Direction: "DEBIT", Amount: "10.5", EffectiveDate: "2026-01-31"},
+		{Number: 3, ReferenceID: "ref-1", AccountID: "2001", Direction: "CREDIT", Amount: "10.50", Description: " kept "},
+		{Number: 4, ReferenceID: "ref-2", AccountID: "1001", Direction: "DEBIT", Amount: "1", TransactionID: "txn-2"},
+	}
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("Read =\n%+v\nwant\n%+v", lines, want)
+	}
+}
+
+func TestReadFails(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		input  string
+		want   string
+	}{
+		{"missing column", journal.FormatCSV, "reference_id,account_id,amount\nref-1,1001,10\n", "no direction column"},
+		{"ragged row", journal.FormatCSV, "reference_id,account_id,direction,amount\nref-1,1001,DEBIT\n", "wrong number of fields"},
+		{"bad json", journal.FormatJSONL, "{\"reference_id\":\"ref-1\"}\n{oops}\n", "line 2"},
+		{"unsupported format", "xlsx", "", "unsupported journal format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := journal.Read(strings.NewReader(tt.input), tt.format)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Read error = %v, want one containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadEmpty(t *testing.T) {
+	for _, format := range []string{journal.FormatCSV, journal.FormatJSONL} {
+		lines, err := journal.Read(strings.NewReader(""), format)
+		if err != nil || len(lines) != 0 {
+			t.Errorf("Read(%s) of nothing = %v, %v, want no lines", format, lines, err)
+		}
+	}
+}
+
+func TestEntryWriterRoundTrip(t *testing.T) {
+	written := []journal.Line{
+		{ReferenceID: "ref-1", EffectiveDate: "2026-01-31", Description: "rent, \"March\"", AccountID: "1001", Direction: "DEBIT", Amount: "10.00", TransactionID: "txn-1", CreatedAt: "2026-01-31T10:00:00Z"},
+		{ReferenceID: "ref-1", EffectiveDate: "2026-01-31", Description: "rent, \"March\"", AccountID: "2001", Direction: "CREDIT", Amount: "10.00", TransactionID: "txn-1", CreatedAt: "2026-01-31T10:00:00Z"},
+	}
+
+	for _, format := range []string{journal.FormatCSV, journal.FormatJSONL} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := journal.NewEntryWriter(&buf, format)
+			for _, l := range written {
+				if err := w.WriteLine(l); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatal(err)
+			}
+
+			read, err := journal.Read(&buf, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(read) != len(written) {
+				t.Fatalf("read %d lines, wrote %d", len(read), len(written))
+			}
+			for i, l := range read {
+				want := written[i]
+				// CreatedAt is only written; the line number comes from the file.
+				want.CreatedAt = ""
+				want.Number = l.Number
+				if l != want {
+					t.Errorf("line %d = %+v, want %+v", i, l, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBalanceWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := journal.NewBalanceWriter(&buf, journal.FormatCSV)
+	err := w.WriteBalance(journal.Balance{
+		AccountID:     "1001",
+		NormalBalance: "CREDIT",
+		Opening:       decimal.RequireFromString("100"),
+		Debits:        decimal.RequireFromString("20.5"),
+		Credits:       decimal.RequireFromString("0"),
+		Closing:       decimal.RequireFromString("79.5"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "account_id,normal_balance,opening_balance,debits,credits,closing_balance\n1001,CREDIT,100.00,20.50,0.00,79.50\n"
+	if buf.String() != want {
+		t.Errorf("wrote %q, want %q", buf.String(), want)
+	}
+}
+
+func TestEmptyCSVHasHeader(t *testing.T) {
+	var buf bytes.Buffer
+	if err := journal.NewEntryWriter(&buf, journal.FormatCSV).Flush(); err != nil {
+		t.Fatal(err)
+	}
+	want := "reference_id,effective_date,description,account_id,direction,amount,transaction_id,created_at\n"
+	if buf.String() != want {
+		t.Errorf("wrote %q, want %q", buf.String(), want)
+	}
+}
+
+func TestFormatOf(t *testing.T) {
+	tests := map[string]string{
+		"journal.csv":    journal.FormatCSV,
+		"JOURNAL.CSV":    journal.FormatCSV,
+		"journal.jsonl":  journal.FormatJSONL,
+		"journal.ndjson": journal.FormatJSONL,
+		"journal.json":   "",
+		"journal":        "",
+	}
+	for name, want := range tests {
+		if got := journal.FormatOf(name); got != want {
+			t.Errorf("FormatOf(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
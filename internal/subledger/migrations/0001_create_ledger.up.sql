@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id VARCHAR(36) PRIMARY KEY,
+    transaction_id VARCHAR(36) NOT NULL,
+    account_id VARCHAR(50) NOT NULL,
+    amount NUMERIC(20, 2) NOT NULL,
+    direction VARCHAR(10) NOT NULL CHECK (direction IN ('DEBIT', 'CREDIT')),
+    reference_id VARCHAR(255),
+    description TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT unq_ledger_tx_account UNIQUE (transaction_id, account_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_account_id ON ledger_entries(account_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_transaction_id ON ledger_entries(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_created_at ON ledger_entries(created_at);
+CREATE INDEX IF NOT EXISTS idx_ledger_reference_id ON ledger_entries(reference_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_account_created_id ON ledger_entries(account_id, created_at DESC, id DESC);
+
+-- balances used to reference accounts(account_id), but accounts belong to
+-- the accounts service and are migrated separately, so the subledger cannot
+-- depend on that table existing first.
+CREATE TABLE IF NOT EXISTS balances (
+    account_id VARCHAR(50) PRIMARY KEY,
+    amount NUMERIC(20, 2) NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+) WITH (fillfactor = 70);
+
+ALTER TABLE balances DROP CONSTRAINT IF EXISTS fk_account;
+
+INSERT INTO balances (account_id, amount, updated_at)
+VALUES ('1001', 10000.00, NOW()),
+       ('1002', 0.00, NOW()),
+       ('1003', 0.00, NOW()),
+       ('1004', 0.00, NOW())
+ON CONFLICT (account_id) DO NOTHING;
@@ -0,0 +1,7 @@
+UPDATE balances b
+SET amount = -b.amount
+FROM ledger_accounts la
+WHERE la.account_id = b.account_id
+  AND la.normal_balance = 'DEBIT';
+
+DROP TABLE IF EXISTS ledger_accounts;
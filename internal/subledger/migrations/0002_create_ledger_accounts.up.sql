@@ -0,0 +1,40 @@
+-- Chart of accounts. Every account that can be posted to must be listed
+-- here; normal_balance decides which direction increases its balance.
+CREATE TABLE IF NOT EXISTS ledger_accounts (
+    account_id VARCHAR(50) PRIMARY KEY,
+    code VARCHAR(50) UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    class VARCHAR(20) NOT NULL CHECK (class IN ('ASSET', 'LIABILITY', 'EQUITY', 'REVENUE', 'EXPENSE')),
+    normal_balance VARCHAR(10) NOT NULL CHECK (normal_balance IN ('DEBIT', 'CREDIT')),
+    parent_account_id VARCHAR(50) REFERENCES ledger_accounts(account_id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_accounts_parent ON ledger_accounts(parent_account_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_accounts_class ON ledger_accounts(class);
+
+INSERT INTO ledger_accounts (account_id, code, name, class, normal_balance)
+VALUES ('1001', '3100', 'Referral Funding Pool', 'EQUITY', 'CREDIT'),
+       ('1002', '1100', 'Institution Main Account', 'ASSET', 'DEBIT'),
+       ('1003', '1200', 'Institution Disbursement Account', 'ASSET', 'DEBIT'),
+       ('1004', '1300', 'PSP Account', 'ASSET', 'DEBIT')
+ON CONFLICT (account_id) DO NOTHING;
+
+-- Everything already posted to that is not a system account is a customer
+-- wallet, which the institution owes to the customer.
+INSERT INTO ledger_accounts (account_id, name, class, normal_balance)
+SELECT DISTINCT account_id, 'Customer Wallet', 'LIABILITY', 'CREDIT'
+FROM (
+    SELECT account_id FROM balances
+    UNION
+    SELECT account_id FROM ledger_entries
+) posted
+ON CONFLICT (account_id) DO NOTHING;
+
+-- balances.amount used to be credits minus debits for every account; restate
+-- debit-normal accounts as debits minus credits.
+UPDATE balances b
+SET amount = -b.amount
+FROM ledger_accounts la
+WHERE la.account_id = b.account_id
+  AND la.normal_balance = 'DEBIT';
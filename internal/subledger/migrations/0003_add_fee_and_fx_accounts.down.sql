@@ -0,0 +1,3 @@
+DELETE FROM ledger_accounts
+WHERE account_id IN ('1005', '1006')
+  AND NOT EXISTS (SELECT 1 FROM ledger_entries le WHERE le.account_id = ledger_accounts.account_id);
@@ -0,0 +1,4 @@
+INSERT INTO ledger_accounts (account_id, code, name, class, normal_balance)
+VALUES ('1005', '4100', 'Fee Income', 'REVENUE', 'CREDIT'),
+       ('1006', '1400', 'FX Clearing', 'ASSET', 'DEBIT')
+ON CONFLICT (account_id) DO NOTHING;
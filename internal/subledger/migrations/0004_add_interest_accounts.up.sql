@@ -0,0 +1,4 @@
+INSERT INTO ledger_accounts (account_id, code, name, class, normal_balance)
+VALUES ('1007', '5100', 'Interest Expense', 'EXPENSE', 'DEBIT'),
+       ('1008', '2100', 'Interest Payable', 'LIABILITY', 'CREDIT')
+ON CONFLICT (account_id) DO NOTHING;
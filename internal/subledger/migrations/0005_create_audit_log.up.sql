@@ -0,0 +1,43 @@
+-- Append-only record of every mutating gRPC call: who made it, from where,
+-- what it targeted and the state before and after. Written by the shared
+-- interceptor in pkg/audit. The end user and client address a caller names
+-- are its claims, not something the service checked; source_ip is the
+-- address the call came from.
+CREATE TABLE IF NOT EXISTS audit_log (
+    id VARCHAR(36) PRIMARY KEY,
+    occurred_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    service VARCHAR(20) NOT NULL,
+    action VARCHAR(100) NOT NULL,
+    actor_type VARCHAR(10) NOT NULL CHECK (actor_type IN ('API_KEY', 'SERVICE', 'UNKNOWN')),
+    actor_id VARCHAR(64) NOT NULL,
+    claimed_user_id VARCHAR(64),
+    target TEXT NOT NULL DEFAULT '',
+    request JSONB,
+    before JSONB,
+    after JSONB,
+    status VARCHAR(30) NOT NULL,
+    error TEXT,
+    request_id VARCHAR(64) NOT NULL DEFAULT '',
+    source_ip VARCHAR(64) NOT NULL DEFAULT '',
+    claimed_ip VARCHAR(64) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_occurred ON audit_log(occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_actor ON audit_log(actor_id, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_target ON audit_log(target, occurred_at DESC);
+
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_log_append_only ON audit_log;
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+
+DROP TRIGGER IF EXISTS audit_log_no_truncate ON audit_log;
+CREATE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS ledger_checkpoints;
+DROP TABLE IF EXISTS ledger_chain;
+DROP FUNCTION IF EXISTS ledger_chain_append_only();
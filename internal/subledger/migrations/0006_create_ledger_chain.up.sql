@@ -0,0 +1,46 @@
+-- Hash chain over posted transactions. Each link stores the hash of its
+-- transaction's canonical content chained to the hash of the link before,
+-- so editing, adding or removing any ledger entry breaks every later link.
+-- Links are appended under an advisory lock in the posting transaction.
+CREATE TABLE IF NOT EXISTS ledger_chain (
+    seq BIGSERIAL PRIMARY KEY,
+    transaction_id VARCHAR(36) NOT NULL UNIQUE,
+    prev_hash CHAR(64) NOT NULL,
+    hash CHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Signed checkpoints of the chain head, also exported to a file outside the
+-- database, so a chain recomputed after an edit no longer matches them.
+CREATE TABLE IF NOT EXISTS ledger_checkpoints (
+    seq BIGINT PRIMARY KEY REFERENCES ledger_chain(seq),
+    hash CHAR(64) NOT NULL,
+    signature TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE OR REPLACE FUNCTION ledger_chain_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION '% is append-only', TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS ledger_chain_append_only ON ledger_chain;
+CREATE TRIGGER ledger_chain_append_only
+    BEFORE UPDATE OR DELETE ON ledger_chain
+    FOR EACH ROW EXECUTE FUNCTION ledger_chain_append_only();
+
+DROP TRIGGER IF EXISTS ledger_checkpoints_append_only ON ledger_checkpoints;
+CREATE TRIGGER ledger_checkpoints_append_only
+    BEFORE UPDATE OR DELETE ON ledger_checkpoints
+    FOR EACH ROW EXECUTE FUNCTION ledger_chain_append_only();
+
+DROP TRIGGER IF EXISTS ledger_chain_no_truncate ON ledger_chain;
+CREATE TRIGGER ledger_chain_no_truncate
+    BEFORE TRUNCATE ON ledger_chain
+    FOR EACH STATEMENT EXECUTE FUNCTION ledger_chain_append_only();
+
+DROP TRIGGER IF EXISTS ledger_checkpoints_no_truncate ON ledger_checkpoints;
+CREATE TRIGGER ledger_checkpoints_no_truncate
+    BEFORE TRUNCATE ON ledger_checkpoints
+    FOR EACH STATEMENT EXECUTE FUNCTION ledger_chain_append_only();
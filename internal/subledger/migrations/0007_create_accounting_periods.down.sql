@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS ledger_entries_closed_period ON ledger_entries;
+DROP FUNCTION IF EXISTS ledger_entries_closed_period();
+DROP TABLE IF EXISTS period_closing_balances;
+DROP TABLE IF EXISTS accounting_periods;
+DROP FUNCTION IF EXISTS accounting_periods_closed();
+ALTER TABLE ledger_chain DROP COLUMN IF EXISTS version;
+DROP INDEX IF EXISTS idx_ledger_account_effective;
+ALTER TABLE ledger_entries DROP COLUMN IF EXISTS effective_date;
@@ -0,0 +1,89 @@
+-- effective_date is the day a posting counts towards, which may be earlier
+-- than the day it was recorded (created_at). Existing entries count on the
+-- day they were recorded.
+ALTER TABLE ledger_entries ADD COLUMN IF NOT EXISTS effective_date DATE;
+UPDATE ledger_entries SET effective_date = created_at::date WHERE effective_date IS NULL;
+ALTER TABLE ledger_entries ALTER COLUMN effective_date SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_ledger_account_effective ON ledger_entries(account_id, effective_date);
+
+-- Links chained before effective dates existed hash without them.
+ALTER TABLE ledger_chain ADD COLUMN IF NOT EXISTS version SMALLINT NOT NULL DEFAULT 1;
+
+-- Monthly accounting periods. A period is opened by its first posting and
+-- closed once, in order; nothing can be posted with an effective date on or
+-- before the end of the latest closed period.
+CREATE TABLE IF NOT EXISTS accounting_periods (
+    period CHAR(7) PRIMARY KEY,
+    starts_on DATE NOT NULL,
+    ends_on DATE NOT NULL,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('OPEN', 'CLOSED')),
+    closed_at TIMESTAMP,
+    chain_seq BIGINT,
+    CHECK (starts_on < ends_on)
+);
+
+INSERT INTO accounting_periods (period, starts_on, ends_on, status)
+SELECT DISTINCT to_char(effective_date, 'YYYY-MM'),
+       date_trunc('month', effective_date)::date,
+       (date_trunc('month', effective_date) + INTERVAL '1 month')::date,
+       'OPEN'
+FROM ledger_entries
+ON CONFLICT (period) DO NOTHING;
+
+-- Balance of every account at the end of a closed period, by effective date.
+CREATE TABLE IF NOT EXISTS period_closing_balances (
+    period CHAR(7) NOT NULL REFERENCES accounting_periods(period),
+    account_id VARCHAR(50) NOT NULL,
+    amount NUMERIC(20, 2) NOT NULL,
+    PRIMARY KEY (period, account_id)
+);
+
+CREATE OR REPLACE FUNCTION accounting_periods_closed() RETURNS trigger AS $$
+BEGIN
+    IF OLD.status = 'CLOSED' THEN
+        RAISE EXCEPTION 'accounting period % is closed', OLD.period;
+    END IF;
+    IF TG_OP = 'DELETE' THEN
+        RETURN OLD;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS accounting_periods_closed ON accounting_periods;
+CREATE TRIGGER accounting_periods_closed
+    BEFORE UPDATE OR DELETE ON accounting_periods
+    FOR EACH ROW EXECUTE FUNCTION accounting_periods_closed();
+
+DROP TRIGGER IF EXISTS period_closing_balances_append_only ON period_closing_balances;
+CREATE TRIGGER period_closing_balances_append_only
+    BEFORE UPDATE OR DELETE ON period_closing_balances
+    FOR EACH ROW EXECUTE FUNCTION ledger_chain_append_only();
+
+-- Entries effective in a closed period can be neither added, edited nor
+-- removed, whoever connects to the database.
+CREATE OR REPLACE FUNCTION ledger_entries_closed_period() RETURNS trigger AS $$
+DECLARE
+    closed_through DATE;
+BEGIN
+    SELECT MAX(ends_on) INTO closed_through FROM accounting_periods WHERE status = 'CLOSED';
+    IF closed_through IS NOT NULL THEN
+        IF TG_OP <> 'INSERT' AND OLD.effective_date < closed_through THEN
+            RAISE EXCEPTION 'ledger entry % is in a closed accounting period', OLD.id;
+        END IF;
+        IF TG_OP <> 'DELETE' AND NEW.effective_date < closed_through THEN
+            RAISE EXCEPTION 'effective date % is in a closed accounting period', NEW.effective_date;
+        END IF;
+    END IF;
+    IF TG_OP = 'DELETE' THEN
+        RETURN OLD;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS ledger_entries_closed_period ON ledger_entries;
+CREATE TRIGGER ledger_entries_closed_period
+    BEFORE INSERT OR UPDATE OR DELETE ON ledger_entries
+    FOR EACH ROW EXECUTE FUNCTION ledger_entries_closed_period();
@@ -0,0 +1,10 @@
+UPDATE balances b
+SET amount = -b.amount
+FROM ledger_accounts la
+WHERE la.account_id = b.account_id
+  AND la.account_id = '1003'
+  AND la.normal_balance = 'CREDIT';
+
+UPDATE ledger_accounts
+SET code = '1200', name = 'Institution Disbursement Account', class = 'ASSET', normal_balance = 'DEBIT'
+WHERE account_id = '1003' AND normal_balance = 'CREDIT';
@@ -0,0 +1,15 @@
+-- The disbursement account funds bulk payouts to customer wallets, which
+-- debit it, so it is a funding pool like the referral pool rather than an
+-- asset: its balance is what is left to pay out. Its balance is restated
+-- for the new normal balance; closing balances of periods already closed
+-- keep the old sign, as they are append-only.
+UPDATE balances b
+SET amount = -b.amount
+FROM ledger_accounts la
+WHERE la.account_id = b.account_id
+  AND la.account_id = '1003'
+  AND la.normal_balance = 'DEBIT';
+
+UPDATE ledger_accounts
+SET code = '3200', name = 'Disbursement Funding Pool', class = 'EQUITY', normal_balance = 'CREDIT'
+WHERE account_id = '1003' AND normal_balance = 'DEBIT';
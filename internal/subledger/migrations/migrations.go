@@ -0,0 +1,14 @@
+// Package migrations embeds the subledger schema migrations.
+package migrations
+
+import "embed"
+
+const Set = "subledger"
+
+// LegacyTables are the tables the service kept in the public schema before
+// it had one of its own. They are moved on the first start against the new
+// schema; see database.Migrator.AdoptLegacyTables.
+var LegacyTables = []string{"ledger_entries", "balances"}
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,312 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+// NewTransaction is a transaction to post, effective on EffectiveDate, a
+// UTC midnight.
+type NewTransaction struct {
+	ReferenceID   string
+	Description   string
+	EffectiveDate time.Time
+	Entries       []TransactionEntry
+}
+
+// PostResult is the outcome of posting one NewTransaction: the id it was
+// posted as, or why it was not.
+type PostResult struct {
+	TransactionID string
+	Err           error
+}
+
+// CreateTransactions posts txns in one database transaction, copying their
+// entries and chain links in with COPY and applying their balance changes
+// in a single statement. A transaction is rejected if it posts to an
+// account missing from the chart of accounts or into a closed period, or
+// carries a reference already posted. If atomic, one rejection aborts the
+// rest with ErrBatchAborted; otherwise the rest are posted.
+func (r *Repository) CreateTransactions(ctx context.Context, txns []NewTransaction, atomic bool) ([]PostResult, error) {
+	if len(txns) == 0 {
+		return []PostResult{}, nil
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Taken before the period check, and held until commit, so that no
+	// period can close under this batch.
+	if err := lockChain(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	normalBalances, err := r.normalBalances(ctx, tx, txns)
+	if err != nil {
+		return nil, err
+	}
+	through, err := closedThrough(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	posted, err := postedReferences(ctx, tx, txns)
+	if err != nil {
+		return nil, err
+	}
+
+	results, rejected := checkBatch(txns, normalBalances, through, posted, atomic)
+	if rejected == len(txns) {
+		return results, nil
+	}
+
+	prevHash, err := chainHead(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Truncated to what the column stores, so the chain hash computed here
+	// matches the one recomputed from the database.
+	timestamp := time.Now().Truncate(time.Microsecond)
+
+	var entryRows, linkRows [][]interface{}
+	deltas := make(map[string]decimal.Decimal)
+	periods := make(map[string]AccountingPeriod)
+
+	for i, txn := range txns {
+		if results[i].Err != nil {
+			continue
+		}
+
+		trxID := uuid.New().String()
+		link := &Transaction{
+			TransactionID: trxID,
+			ReferenceID:   txn.ReferenceID,
+			Description:   txn.Description,
+			CreatedAt:     timestamp,
+			EffectiveDate: txn.EffectiveDate,
+		}
+
+		for _, entry := range txn.Entries {
+			entryID := uuid.New().String()
+			entryRows = append(entryRows, []interface{}{
+				entryID,
+				trxID,
+				entry.AccountID,
+				entry.Amount,
+				entry.Direction,
+				txn.ReferenceID,
+				txn.Description,
+				timestamp,
+				txn.EffectiveDate,
+			})
+			link.Entries = append(link.Entries, LedgerEntry{
+				ID:        entryID,
+				AccountID: entry.AccountID,
+				Amount:    entry.Amount,
+				Direction: entry.Direction,
+			})
+
+			amount := entry.Amount
+			if entry.Direction != normalBalances[entry.AccountID] {
+				amount = amount.Neg()
+			}
+			deltas[entry.AccountID] = deltas[entry.AccountID].Add(amount)
+		}
+
+		hash := ChainHash(ChainVersion, prevHash, link)
+		linkRows = append(linkRows, []interface{}{int16(ChainVersion), trxID, prevHash, hash, timestamp})
+		prevHash = hash
+
+		period := PeriodOf(txn.EffectiveDate)
+		periods[period.Period] = period
+
+		results[i].TransactionID = trxID
+	}
+
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"ledger_entries"},
+		[]string{"id", "transaction_id", "account_id", "amount", "direction", "reference_id", "description", "created_at", "effective_date"},
+		pgx.CopyFromRows(entryRows),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert ledger entries: %w", err)
+	}
+
+	if err := applyBalanceDeltas(ctx, tx, deltas, timestamp); err != nil {
+		return nil, err
+	}
+	if err := openPeriods(ctx, tx, periods); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"ledger_chain"},
+		[]string{"version", "transaction_id", "prev_hash", "hash", "created_at"},
+		pgx.CopyFromRows(linkRows),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to append to ledger chain: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return results, nil
+}
+
+// checkBatch returns the outcome of each of txns that cannot be posted,
+// given the normal balances of the known accounts, the day the ledger is
+// closed through and the references already posted, and how many that is.
+// A reference may be posted once, so of two transactions in txns carrying
+// it the second is rejected. If atomic, every other transaction is aborted
+// once one is rejected.
+func checkBatch(txns []NewTransaction, normalBalances map[string]string, through *time.Time, posted map[string]bool, atomic bool) ([]PostResult, int) {
+	results := make([]PostResult, len(txns))
+	seen := make(map[string]bool, len(txns))
+
+	rejected := 0
+	for i, txn := range txns {
+		err := checkPostable(txn, normalBalances, through)
+		if err == nil && txn.ReferenceID != "" {
+			if posted[txn.ReferenceID] || seen[txn.ReferenceID] {
+				err = fmt.Errorf("%w: %s", subledgerErrors.ErrDuplicateReference, txn.ReferenceID)
+			}
+			seen[txn.ReferenceID] = true
+		}
+		if err != nil {
+			results[i].Err = err
+			rejected++
+		}
+	}
+
+	if rejected > 0 && atomic {
+		for i := range results {
+			if results[i].Err == nil {
+				results[i].Err = subledgerErrors.ErrBatchAborted
+			}
+		}
+		rejected = len(txns)
+	}
+	return results, rejected
+}
+
+// postedReferences returns which references of txns some posted
+// transaction already carries. tx must hold the chain lock for the answer
+// to hold until it commits.
+func postedReferences(ctx context.Context, tx pgx.Tx, txns []NewTransaction) (map[string]bool, error) {
+	refs := make([]string, 0, len(txns))
+	for _, txn := range txns {
+		if txn.ReferenceID != "" {
+			refs = append(refs, txn.ReferenceID)
+		}
+	}
+	if len(refs) == 0 {
+		return map[string]bool{}, nil
+	}
+
+	rows, err := tx.Query(ctx, `SELECT DISTINCT reference_id FROM ledger_entries WHERE reference_id = ANY($1)`, refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up references: %w", err)
+	}
+	return scanReferences(rows)
+}
+
+// scanReferences reads rows of reference_id into a set. It closes rows.
+func scanReferences(rows pgx.Rows) (map[string]bool, error) {
+	defer rows.Close()
+
+	posted := make(map[string]bool)
+	for rows.Next() {
+		var ref string
+		if err := rows.Scan(&ref); err != nil {
+			return nil, fmt.Errorf("failed to scan reference: %w", err)
+		}
+		posted[ref] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read references: %w", err)
+	}
+
+	return posted, nil
+}
+
+// checkPostable returns why txn cannot be posted, given the normal balances
+// of the known accounts and the day the ledger is closed through.
+func checkPostable(txn NewTransaction, normalBalances map[string]string, through *time.Time) error {
+	for _, entry := range txn.Entries {
+		if _, ok := normalBalances[entry.AccountID]; !ok {
+			return fmt.Errorf("%w: %s", subledgerErrors.ErrUnknownLedgerAccount, entry.AccountID)
+		}
+	}
+	if through != nil && txn.EffectiveDate.Before(*through) {
+		return fmt.Errorf("%w: %s, the ledger is closed through %s",
+			subledgerErrors.ErrPeriodClosed, txn.EffectiveDate.Format("2006-01-02"), through.AddDate(0, 0, -1).Format("2006-01-02"))
+	}
+	return nil
+}
+
+// applyBalanceDeltas adds deltas to the balances of their accounts in one
+// statement, locking the rows in account order.
+func applyBalanceDeltas(ctx context.Context, tx pgx.Tx, deltas map[string]decimal.Decimal, updatedAt time.Time) error {
+	accountIDs := make([]string, 0, len(deltas))
+	for accountID := range deltas {
+		accountIDs = append(accountIDs, accountID)
+	}
+	sort.Strings(accountIDs)
+
+	amounts := make([]string, len(accountIDs))
+	for i, accountID := range accountIDs {
+		amounts[i] = deltas[accountID].String()
+	}
+
+	_, err := tx.Exec(ctx, `
+		INSERT INTO balances (account_id, amount, updated_at)
+		SELECT d.account_id, d.amount::numeric, $3
+		FROM unnest($1::text[], $2::text[]) WITH ORDINALITY AS d(account_id, amount, n)
+		ORDER BY d.n
+		ON CONFLICT (account_id)
+		DO UPDATE SET
+			amount = balances.amount + EXCLUDED.amount,
+			updated_at = EXCLUDED.updated_at
+	`, accountIDs, amounts, updatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update balances: %w", err)
+	}
+	return nil
+}
+
+// openPeriods records periods as open unless they already exist.
+func openPeriods(ctx context.Context, tx pgx.Tx, periods map[string]AccountingPeriod) error {
+	names := make([]string, 0, len(periods))
+	startsOn := make([]time.Time, 0, len(periods))
+	endsOn := make([]time.Time, 0, len(periods))
+	for _, p := range periods {
+		names = append(names, p.Period)
+		startsOn = append(startsOn, p.StartsOn)
+		endsOn = append(endsOn, p.EndsOn)
+	}
+
+	_, err := tx.Exec(ctx, `
+		INSERT INTO accounting_periods (period, starts_on, ends_on, status)
+		SELECT p.period, p.starts_on, p.ends_on, 'OPEN'
+		FROM unnest($1::text[], $2::date[], $3::date[]) AS p(period, starts_on, ends_on)
+		ON CONFLICT (period) DO NOTHING
+	`, names, startsOn, endsOn)
+	if err != nil {
+		return fmt.Errorf("failed to open accounting periods: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+func testTransaction(ref, debit, credit string, effective time.Time) NewTransaction {
+	amount := decimal.RequireFromString("10")
+	return NewTransaction{
+		ReferenceID:   ref,
+		EffectiveDate: effective,
+		Entries: []TransactionEntry{
+			{AccountID: debit, Amount: amount, Direction: "DEBIT"},
+			{AccountID: credit, Amount: amount, Direction: "CREDIT"},
+		},
+	}
+}
+
+func TestCheckBatch(t *testing.T) {
+	normalBalances := map[string]string{"1001": "DEBIT", "2001": "CREDIT"}
+	through := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC) // January is closed
+	march := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	january := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	posted := map[string]bool{"ref-posted": true}
+
+	tests := []struct {
+		name     string
+		txns     []NewTransaction
+		atomic   bool
+		want     []error
+		rejected int
+	}{
+		{
+			name:   "all postable",
+			txns:   []NewTransaction{testTransaction("ref-1", "1001", "2001", march), testTransaction("", "1001", "2001", march), testTransaction("", "2001", "1001", march)},
+			atomic: true,
+			want:   []error{nil, nil, nil},
+		},
+		{
+			name:     "unknown account, best effort",
+			txns:     []NewTransaction{testTransaction("ref-1", "1001", "9999", march), testTransaction("ref-2", "1001", "2001", march)},
+			want:     []error{subledgerErrors.ErrUnknownLedgerAccount, nil},
+			rejected: 1,
+		},
+		{
+			name:     "unknown account, atomic",
+			txns:     []NewTransaction{testTransaction("ref-1", "1001", "9999", march), testTransaction("ref-2", "1001", "2001", march)},
+			atomic:   true,
+			want:     []error{subledgerErrors.ErrUnknownLedgerAccount, subledgerErrors.ErrBatchAborted},
+			rejected: 2,
+		},
+		{
+			name:     "closed period",
+			txns:     []NewTransaction{testTransaction("ref-1", "1001", "2001", january), testTransaction("ref-2", "1001", "2001", through)},
+			want:     []error{subledgerErrors.ErrPeriodClosed, nil},
+			rejected: 1,
+		},
+		{
+			name:     "reference already posted",
+			txns:     []NewTransaction{testTransaction("ref-posted", "1001", "2001", march), testTransaction("ref-2", "1001", "2001", march)},
+			want:     []error{subledgerErrors.ErrDuplicateReference, nil},
+			rejected: 1,
+		},
+		{
+			name:     "reference repeated in the batch",
+			txns:     []NewTransaction{testTransaction("ref-1", "1001", "2001", march), testTransaction("ref-1", "1001", "2001", march)},
+			want:     []error{nil, subledgerErrors.ErrDuplicateReference},
+			rejected: 1,
+		},
+		{
+			name:     "reference repeated in an atomic batch",
+			txns:     []NewTransaction{testTransaction("ref-1", "1001", "2001", march), testTransaction("ref-1", "1001", "2001", march)},
+			atomic:   true,
+			want:     []error{subledgerErrors.ErrBatchAborted, subledgerErrors.ErrDuplicateReference},
+			rejected: 2,
+		},
+		{
+			name:     "rejected transaction does not claim its reference",
+			txns:     []NewTransaction{testTransaction("ref-1", "1001", "9999", march), testTransaction("ref-1", "1001", "2001", march)},
+			want:     []error{subledgerErrors.ErrUnknownLedgerAccount, nil},
+			rejected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, rejected := checkBatch(tt.txns, normalBalances, &through, posted, tt.atomic)
+
+			if rejected != tt.rejected {
+				t.Errorf("rejected = %d, want %d", rejected, tt.rejected)
+			}
+			for i, want := range tt.want {
+				got := results[i].Err
+				if (want == nil) != (got == nil) || (want != nil && !errors.Is(got, want)) {
+					t.Errorf("transaction %d: %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckPostableWithoutClosedPeriods(t *testing.T) {
+	txn := testTransaction("ref-1", "1001", "2001", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err := checkPostable(txn, map[string]string{"1001": "DEBIT", "2001": "CREDIT"}, nil); err != nil {
+		t.Errorf("checkPostable = %v, want nil", err)
+	}
+}
@@ -0,0 +1,382 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// GenesisHash is the previous hash of the first link of the chain.
+var GenesisHash = strings.Repeat("0", 64)
+
+// chainLockKey is the advisory lock that serialises appends to the chain,
+// and closing accounting periods against postings.
+const chainLockKey = 0x6c6564676572
+
+// ChainVersion is the canonical form new links are hashed with. Version 1
+// links predate effective dates and do not cover them.
+const ChainVersion = 2
+
+// ChainLink ties one posted transaction into the hash chain.
+type ChainLink struct {
+	Seq           int64
+	Version       int
+	TransactionID string
+	PrevHash      string
+	Hash          string
+	CreatedAt     time.Time
+}
+
+// Checkpoint is a signed record of the chain head at Seq.
+type Checkpoint struct {
+	Seq       int64
+	Hash      string
+	Signature string
+	CreatedAt time.Time
+}
+
+type chainContent struct {
+	TransactionID string       `json:"transaction_id"`
+	ReferenceID   string       `json:"reference_id"`
+	Description   string       `json:"description"`
+	CreatedAt     string       `json:"created_at"`
+	EffectiveDate string       `json:"effective_date,omitempty"`
+	Entries       []chainEntry `json:"entries"`
+}
+
+type chainEntry struct {
+	ID        string `json:"id"`
+	AccountID string `json:"account_id"`
+	Direction string `json:"direction"`
+	Amount    string `json:"amount"`
+}
+
+// ChainHash returns the hash of the link of txn following prevHash: the
+// SHA-256 of prevHash and the canonical JSON of txn in the given version.
+// The canonical form orders entries by account, direction and id, and
+// writes amounts and created_at as they are stored, to two places and to
+// the microsecond.
+func ChainHash(version int, prevHash string, txn *Transaction) string {
+	content := chainContent{
+		TransactionID: txn.TransactionID,
+		ReferenceID:   txn.ReferenceID,
+		Description:   txn.Description,
+		CreatedAt:     txn.CreatedAt.Format("2006-01-02T15:04:05.000000"),
+		Entries:       make([]chainEntry, len(txn.Entries)),
+	}
+	if version >= 2 {
+		content.EffectiveDate = txn.EffectiveDate.Format("2006-01-02")
+	}
+	for i, e := range txn.Entries {
+		content.Entries[i] = chainEntry{
+			ID:        e.ID,
+			AccountID: e.AccountID,
+			Direction: e.Direction,
+			Amount:    e.Amount.StringFixed(2),
+		}
+	}
+	sort.Slice(content.Entries, func(i, j int) bool {
+		a, b := content.Entries[i], content.Entries[j]
+		if a.AccountID != b.AccountID {
+			return a.AccountID < b.AccountID
+		}
+		if a.Direction != b.Direction {
+			return a.Direction < b.Direction
+		}
+		return a.ID < b.ID
+	})
+
+	// Marshalling strings cannot fail.
+	raw, _ := json.Marshal(content)
+
+	h := sha256.New()
+	h.Write([]byte(prevHash))
+	h.Write([]byte("\n"))
+	h.Write(raw)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// lockChain takes the chain lock until tx ends, so postings are chained in
+// commit order.
+func lockChain(ctx context.Context, tx pgx.Tx) error {
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, chainLockKey); err != nil {
+		return fmt.Errorf("failed to lock ledger chain: %w", err)
+	}
+	return nil
+}
+
+// chainHead returns the hash of the last link of the chain, or GenesisHash.
+// tx must hold the chain lock for the head not to move.
+func chainHead(ctx context.Context, tx pgx.Tx) (string, error) {
+	prevHash := GenesisHash
+	err := tx.QueryRow(ctx, `SELECT hash FROM ledger_chain ORDER BY seq DESC LIMIT 1`).Scan(&prevHash)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("failed to read ledger chain head: %w", err)
+	}
+	return prevHash, nil
+}
+
+// ChainExistingTransactions starts the chain with every transaction posted
+// before it existed, oldest first. It does nothing once the chain has a
+// link, so transactions inserted behind its back later are not absorbed.
+func (r *Repository) ChainExistingTransactions(ctx context.Context) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockChain(ctx, tx); err != nil {
+		return 0, err
+	}
+
+	var started bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM ledger_chain)`).Scan(&started); err != nil {
+		return 0, fmt.Errorf("failed to read ledger chain: %w", err)
+	}
+	if started {
+		return 0, nil
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT transaction_id, id, account_id, amount, direction,
+		       COALESCE(reference_id, ''), COALESCE(description, ''), created_at, effective_date
+		FROM ledger_entries
+		ORDER BY created_at, transaction_id, account_id, direction, id
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read ledger entries: %w", err)
+	}
+	txns, err := scanTransactions(rows)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	prevHash := GenesisHash
+	links := make([][]interface{}, len(txns))
+	for i, txn := range txns {
+		hash := ChainHash(ChainVersion, prevHash, txn)
+		links[i] = []interface{}{int16(ChainVersion), txn.TransactionID, prevHash, hash, now}
+		prevHash = hash
+	}
+
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"ledger_chain"},
+		[]string{"version", "transaction_id", "prev_hash", "hash", "created_at"},
+		pgx.CopyFromRows(links),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to chain existing transactions: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(txns), nil
+}
+
+// scanTransactions groups rows of transaction_id, id, account_id, amount,
+// direction, reference_id, description, created_at and effective_date,
+// ordered by transaction, into transactions. It closes rows.
+func scanTransactions(rows pgx.Rows) ([]*Transaction, error) {
+	defer rows.Close()
+
+	var txns []*Transaction
+	for rows.Next() {
+		var txnID string
+		var entry LedgerEntry
+		if err := rows.Scan(
+			&txnID,
+			&entry.ID,
+			&entry.AccountID,
+			&entry.Amount,
+			&entry.Direction,
+			&entry.ReferenceID,
+			&entry.Description,
+			&entry.CreatedAt,
+			&entry.EffectiveDate,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan ledger entry: %w", err)
+		}
+		entry.TransactionID = txnID
+
+		if len(txns) == 0 || txns[len(txns)-1].TransactionID != txnID {
+			txns = append(txns, &Transaction{
+				TransactionID: txnID,
+				ReferenceID:   entry.ReferenceID,
+				Description:   entry.Description,
+				CreatedAt:     entry.CreatedAt,
+				EffectiveDate: entry.EffectiveDate,
+			})
+		}
+		last := txns[len(txns)-1]
+		last.Entries = append(last.Entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ledger entries: %w", err)
+	}
+
+	return txns, nil
+}
+
+// ListChainLinks returns up to limit links after afterSeq, in chain order.
+func (r *Repository) ListChainLinks(ctx context.Context, afterSeq int64, limit int) ([]ChainLink, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT seq, version, transaction_id, prev_hash, hash, created_at
+		FROM ledger_chain
+		WHERE seq > $1
+		ORDER BY seq
+		LIMIT $2
+	`, afterSeq, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledger chain: %w", err)
+	}
+	defer rows.Close()
+
+	var links []ChainLink
+	for rows.Next() {
+		var link ChainLink
+		if err := rows.Scan(&link.Seq, &link.Version, &link.TransactionID, &link.PrevHash, &link.Hash, &link.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan ledger chain link: %w", err)
+		}
+		links = append(links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ledger chain: %w", err)
+	}
+
+	return links, nil
+}
+
+// GetChainHead returns nil without an error when the chain is empty.
+func (r *Repository) GetChainHead(ctx context.Context) (*ChainLink, error) {
+	var link ChainLink
+	err := r.pool.QueryRow(ctx, `
+		SELECT seq, version, transaction_id, prev_hash, hash, created_at
+		FROM ledger_chain
+		ORDER BY seq DESC
+		LIMIT 1
+	`).Scan(&link.Seq, &link.Version, &link.TransactionID, &link.PrevHash, &link.Hash, &link.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read ledger chain head: %w", err)
+	}
+
+	return &link, nil
+}
+
+// GetTransactions returns the transactions with the given ids that have
+// entries, keyed by id.
+func (r *Repository) GetTransactions(ctx context.Context, transactionIDs []string) (map[string]*Transaction, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT transaction_id, id, account_id, amount, direction,
+		       COALESCE(reference_id, ''), COALESCE(description, ''), created_at, effective_date
+		FROM ledger_entries
+		WHERE transaction_id = ANY($1)
+		ORDER BY transaction_id, account_id, direction, id
+	`, transactionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	txns, err := scanTransactions(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*Transaction, len(txns))
+	for _, txn := range txns {
+		byID[txn.TransactionID] = txn
+	}
+	return byID, nil
+}
+
+// FindUnchainedTransaction returns the id of a transaction with entries but
+// no link, or "" if there is none.
+func (r *Repository) FindUnchainedTransaction(ctx context.Context) (string, error) {
+	var transactionID string
+	err := r.pool.QueryRow(ctx, `
+		SELECT le.transaction_id
+		FROM ledger_entries le
+		WHERE NOT EXISTS (SELECT 1 FROM ledger_chain c WHERE c.transaction_id = le.transaction_id)
+		ORDER BY le.created_at
+		LIMIT 1
+	`).Scan(&transactionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to look for unchained transactions: %w", err)
+	}
+
+	return transactionID, nil
+}
+
+func (r *Repository) CreateCheckpoint(ctx context.Context, cp *Checkpoint) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO ledger_checkpoints (seq, hash, signature, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (seq) DO NOTHING
+	`, cp.Seq, cp.Hash, cp.Signature, cp.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create ledger checkpoint: %w", err)
+	}
+	return nil
+}
+
+// ListCheckpoints returns every checkpoint, oldest first.
+func (r *Repository) ListCheckpoints(ctx context.Context) ([]Checkpoint, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT seq, hash, signature, created_at
+		FROM ledger_checkpoints
+		ORDER BY seq
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledger checkpoints: %w", err)
+	}
+	defer rows.Close()
+
+	var checkpoints []Checkpoint
+	for rows.Next() {
+		var cp Checkpoint
+		if err := rows.Scan(&cp.Seq, &cp.Hash, &cp.Signature, &cp.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan ledger checkpoint: %w", err)
+		}
+		checkpoints = append(checkpoints, cp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ledger checkpoints: %w", err)
+	}
+
+	return checkpoints, nil
+}
+
+// GetLatestCheckpoint returns nil without an error when there is none.
+func (r *Repository) GetLatestCheckpoint(ctx context.Context) (*Checkpoint, error) {
+	var cp Checkpoint
+	err := r.pool.QueryRow(ctx, `
+		SELECT seq, hash, signature, created_at
+		FROM ledger_checkpoints
+		ORDER BY seq DESC
+		LIMIT 1
+	`).Scan(&cp.Seq, &cp.Hash, &cp.Signature, &cp.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest ledger checkpoint: %w", err)
+	}
+
+	return &cp, nil
+}
@@ -0,0 +1,131 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+func chainTransaction() *repository.Transaction {
+	return &repository.Transaction{
+		TransactionID: "txn-1",
+		ReferenceID:   "ref-1",
+		Description:   "rent",
+		CreatedAt:     time.Date(2026, 1, 31, 10, 0, 0, 123456789, time.UTC),
+		EffectiveDate: time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		Entries: []repository.LedgerEntry{
+			{ID: "e-2", AccountID: "2001", Direction: "CREDIT", Amount: decimal.RequireFromString("10.5")},
+			{ID: "e-1", AccountID: "1001", Direction: "DEBIT", Amount: decimal.RequireFromString("10.50")},
+		},
+	}
+}
+
+func TestChainHashGolden(t *testing.T) {
+	// SHA-256 of GenesisHash, a newline and the canonical JSON of
+	// chainTransaction, computed independently of this package.
+	want := "0eab69869d1da52d3e2ea3fe5116fec61552bb48548c58aadb4a33a07a216f29"
+
+	if got := repository.ChainHash(repository.ChainVersion, repository.GenesisHash, chainTransaction()); got != want {
+		t.Errorf("ChainHash = %s, want %s", got, want)
+	}
+}
+
+func TestChainHash(t *testing.T) {
+	base := repository.ChainHash(repository.ChainVersion, repository.GenesisHash, chainTransaction())
+
+	tests := []struct {
+		name    string
+		version int
+		prev    string
+		change  func(txn *repository.Transaction)
+		same    bool
+	}{
+		{
+			name:   "entries in another order",
+			change: func(txn *repository.Transaction) { txn.Entries[0], txn.Entries[1] = txn.Entries[1], txn.Entries[0] },
+			same:   true,
+		},
+		{
+			name:   "amount written differently",
+			change: func(txn *repository.Transaction) { txn.Entries[0].Amount = decimal.RequireFromString("10.500") },
+			same:   true,
+		},
+		{
+			name:   "created_at below the microsecond",
+			change: func(txn *repository.Transaction) { txn.CreatedAt = txn.CreatedAt.Add(-789) },
+			same:   true,
+		},
+		{
+			name: "fields the chain does not cover",
+			change: func(txn *repository.Transaction) {
+				txn.Entries[0].TransactionID = "other"
+				txn.Entries[0].CounterpartyAccountIDs = []string{"1001"}
+			},
+			same: true,
+		},
+		{name: "another previous hash", prev: "f" + repository.GenesisHash[1:]},
+		{name: "another description", change: func(txn *repository.Transaction) { txn.Description = "rent, March" }},
+		{name: "another reference", change: func(txn *repository.Transaction) { txn.ReferenceID = "ref-2" }},
+		{name: "another amount", change: func(txn *repository.Transaction) { txn.Entries[0].Amount = decimal.RequireFromString("10.51") }},
+		{name: "another direction", change: func(txn *repository.Transaction) { txn.Entries[0].Direction = "DEBIT" }},
+		{name: "another account", change: func(txn *repository.Transaction) { txn.Entries[0].AccountID = "2002" }},
+		{name: "another created_at", change: func(txn *repository.Transaction) { txn.CreatedAt = txn.CreatedAt.Add(time.Microsecond) }},
+		{name: "another effective date", change: func(txn *repository.Transaction) { txn.EffectiveDate = txn.EffectiveDate.AddDate(0, 0, -1) }},
+		{name: "an entry removed", change: func(txn *repository.Transaction) { txn.Entries = txn.Entries[:1] }},
+		{name: "version 1", version: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, prev, txn := repository.ChainVersion, repository.GenesisHash, chainTransaction()
+			if tt.version != 0 {
+				version = tt.version
+			}
+			if tt.prev != "" {
+				prev = tt.prev
+			}
+			if tt.change != nil {
+				tt.change(txn)
+			}
+
+			got := repository.ChainHash(version, prev, txn)
+			if (got == base) != tt.same {
+				t.Errorf("ChainHash = %s, base %s, want same = %v", got, base, tt.same)
+			}
+		})
+	}
+}
+
+func TestChainHashVersions(t *testing.T) {
+	txn := chainTransaction()
+	moved := chainTransaction()
+	moved.EffectiveDate = moved.EffectiveDate.AddDate(0, 0, -1)
+
+	if repository.ChainHash(1, repository.GenesisHash, txn) != repository.ChainHash(1, repository.GenesisHash, moved) {
+		t.Error("version 1 covers the effective date")
+	}
+	if repository.ChainHash(2, repository.GenesisHash, txn) == repository.ChainHash(2, repository.GenesisHash, moved) {
+		t.Error("version 2 does not cover the effective date")
+	}
+}
+
+func TestChainHashOrdersEntriesOnOneAccount(t *testing.T) {
+	split := func(first, second string) *repository.Transaction {
+		txn := chainTransaction()
+		txn.Entries = []repository.LedgerEntry{
+			{ID: first, AccountID: "1001", Direction: "DEBIT", Amount: decimal.RequireFromString("5")},
+			{ID: second, AccountID: "1001", Direction: "DEBIT", Amount: decimal.RequireFromString("5")},
+			{ID: "e-3", AccountID: "2001", Direction: "CREDIT", Amount: decimal.RequireFromString("10")},
+		}
+		return txn
+	}
+
+	for version := 1; version <= repository.ChainVersion; version++ {
+		if repository.ChainHash(version, repository.GenesisHash, split("e-1", "e-2")) != repository.ChainHash(version, repository.GenesisHash, split("e-2", "e-1")) {
+			t.Errorf("version %d: entries on one account read in either order hash differently", version)
+		}
+	}
+}
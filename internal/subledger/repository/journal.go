@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+// PeriodBalance is the movement of one account over a period, by effective
+// date. Opening is signed by the account's normal balance, as balances are.
+type PeriodBalance struct {
+	AccountID     string
+	NormalBalance string
+	Opening       decimal.Decimal
+	Debits        decimal.Decimal
+	Credits       decimal.Decimal
+}
+
+// CheckTransactions returns why each of txns could not be posted right now,
+// nil for those that could, without posting anything.
+func (r *Repository) CheckTransactions(ctx context.Context, txns []NewTransaction) ([]error, error) {
+	errs := make([]error, len(txns))
+	if len(txns) == 0 {
+		return errs, nil
+	}
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	normalBalances, err := r.normalBalances(ctx, tx, txns)
+	if err != nil {
+		return nil, err
+	}
+	through, err := closedThrough(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, txn := range txns {
+		errs[i] = checkPostable(txn, normalBalances, through)
+	}
+	return errs, nil
+}
+
+// PostedReferences returns which of refs some posted transaction already
+// carries.
+func (r *Repository) PostedReferences(ctx context.Context, refs []string) (map[string]bool, error) {
+	rows, err := r.pool.Query(ctx, `SELECT DISTINCT reference_id FROM ledger_entries WHERE reference_id = ANY($1)`, refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up references: %w", err)
+	}
+	return scanReferences(rows)
+}
+
+// PeriodBalances returns the movement over period of every account posted
+// to on or before its last day, ordered by account.
+func (r *Repository) PeriodBalances(ctx context.Context, period AccountingPeriod) ([]PeriodBalance, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT le.account_id,
+		       COALESCE(la.normal_balance, 'CREDIT'),
+		       COALESCE(SUM(CASE WHEN le.effective_date < $1 THEN
+		                         CASE WHEN le.direction = COALESCE(la.normal_balance, 'CREDIT') THEN le.amount ELSE -le.amount END
+		                    END), 0),
+		       COALESCE(SUM(le.amount) FILTER (WHERE le.effective_date >= $1 AND le.direction = 'DEBIT'), 0),
+		       COALESCE(SUM(le.amount) FILTER (WHERE le.effective_date >= $1 AND le.direction = 'CREDIT'), 0)
+		FROM ledger_entries le
+		LEFT JOIN ledger_accounts la ON la.account_id = le.account_id
+		WHERE le.effective_date < $2
+		GROUP BY le.account_id, la.normal_balance
+		ORDER BY le.account_id
+	`, period.StartsOn, period.EndsOn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum balances of %s: %w", period.Period, err)
+	}
+	defer rows.Close()
+
+	var balances []PeriodBalance
+	for rows.Next() {
+		var b PeriodBalance
+		if err := rows.Scan(&b.AccountID, &b.NormalBalance, &b.Opening, &b.Debits, &b.Credits); err != nil {
+			return nil, fmt.Errorf("failed to scan period balance: %w", err)
+		}
+		balances = append(balances, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read period balances: %w", err)
+	}
+
+	return balances, nil
+}
@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	PeriodOpen   = "OPEN"
+	PeriodClosed = "CLOSED"
+)
+
+// AccountingPeriod is one calendar month of the ledger, named YYYY-MM.
+// EndsOn is the first day of the next month. ClosedAt and ChainSeq, the
+// head of the ledger chain at closing, are set once it is closed.
+type AccountingPeriod struct {
+	Period   string
+	StartsOn time.Time
+	EndsOn   time.Time
+	Status   string
+	ClosedAt *time.Time
+	ChainSeq *int64
+}
+
+type ClosingBalance struct {
+	AccountID string
+	Amount    decimal.Decimal
+}
+
+// PeriodOf returns the open period day falls in.
+func PeriodOf(day time.Time) AccountingPeriod {
+	start := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return AccountingPeriod{
+		Period:   start.Format("2006-01"),
+		StartsOn: start,
+		EndsOn:   start.AddDate(0, 1, 0),
+		Status:   PeriodOpen,
+	}
+}
+
+// closedThrough returns the day after the latest closed period, before
+// which nothing can be posted, or nil if no period is closed.
+func closedThrough(ctx context.Context, tx pgx.Tx) (*time.Time, error) {
+	var through *time.Time
+	if err := tx.QueryRow(ctx, `SELECT MAX(ends_on) FROM accounting_periods WHERE status = 'CLOSED'`).Scan(&through); err != nil {
+		return nil, fmt.Errorf("failed to read closed accounting periods: %w", err)
+	}
+	return through, nil
+}
+
+// ClosePeriod closes period and records the balance of every account at
+// its end, by effective date. Periods close in order: it fails if period or
+// a later one is already closed, or an earlier one is still open.
+func (r *Repository) ClosePeriod(ctx context.Context, period AccountingPeriod) (*AccountingPeriod, []ClosingBalance, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Postings check the period under the same lock, so none can land in
+	// the period once the balances below are taken.
+	if err := lockChain(ctx, tx); err != nil {
+		return nil, nil, err
+	}
+
+	through, err := closedThrough(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var earlier string
+	err = tx.QueryRow(ctx, `
+		SELECT period FROM accounting_periods
+		WHERE status = 'OPEN' AND period < $1
+		ORDER BY period
+		LIMIT 1
+	`, period.Period).Scan(&earlier)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, fmt.Errorf("failed to read open accounting periods: %w", err)
+	}
+
+	if err := checkClosable(period, through, earlier); err != nil {
+		return nil, nil, err
+	}
+
+	closed, err := scanPeriod(tx.QueryRow(ctx, `
+		INSERT INTO accounting_periods (period, starts_on, ends_on, status, closed_at, chain_seq)
+		VALUES ($1, $2, $3, 'CLOSED', NOW(), (SELECT MAX(seq) FROM ledger_chain))
+		ON CONFLICT (period) DO UPDATE
+		SET status = 'CLOSED', closed_at = EXCLUDED.closed_at, chain_seq = EXCLUDED.chain_seq
+		RETURNING `+periodColumns,
+		period.Period, period.StartsOn, period.EndsOn,
+	))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to close accounting period %s: %w", period.Period, err)
+	}
+
+	// The current balance less everything effective from the end of the
+	// period on, as GetBalanceAt does by created_at.
+	_, err = tx.Exec(ctx, `
+		INSERT INTO period_closing_balances (period, account_id, amount)
+		SELECT $1, b.account_id,
+		       b.amount - COALESCE((SELECT SUM(CASE WHEN le.direction = COALESCE(la.normal_balance, 'CREDIT') THEN le.amount ELSE -le.amount END)
+		                            FROM ledger_entries le
+		                            WHERE le.account_id = b.account_id AND le.effective_date >= $2), 0)
+		FROM balances b
+		LEFT JOIN ledger_accounts la ON la.account_id = b.account_id
+	`, period.Period, period.EndsOn)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to snapshot closing balances of %s: %w", period.Period, err)
+	}
+
+	balances, err := closingBalances(ctx, tx, period.Period)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.logger.Infof("closed accounting period %s with %d closing balances", closed.Period, len(balances))
+	return closed, balances, nil
+}
+
+// checkClosable returns why period cannot be closed, given the day the
+// ledger is closed through and the earliest period before it still open,
+// if there is one.
+func checkClosable(period AccountingPeriod, through *time.Time, earlierOpen string) error {
+	if through != nil && !period.EndsOn.After(*through) {
+		return fmt.Errorf("%w: %s", subledgerErrors.ErrPeriodClosed, period.Period)
+	}
+	if earlierOpen != "" {
+		return fmt.Errorf("%w: %s is still open", subledgerErrors.ErrPeriodOutOfOrder, earlierOpen)
+	}
+	return nil
+}
+
+const periodColumns = `period, starts_on, ends_on, status, closed_at, chain_seq`
+
+func scanPeriod(row pgx.Row) (*AccountingPeriod, error) {
+	var p AccountingPeriod
+	if err := row.Scan(&p.Period, &p.StartsOn, &p.EndsOn, &p.Status, &p.ClosedAt, &p.ChainSeq); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ListAccountingPeriods returns the periods with the given status, or all
+// of them, newest first.
+func (r *Repository) ListAccountingPeriods(ctx context.Context, status string) ([]AccountingPeriod, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+periodColumns+`
+		FROM accounting_periods
+		WHERE $1 = '' OR status = $1
+		ORDER BY period DESC
+	`, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounting periods: %w", err)
+	}
+	defer rows.Close()
+
+	var periods []AccountingPeriod
+	for rows.Next() {
+		p, err := scanPeriod(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan accounting period: %w", err)
+		}
+		periods = append(periods, *p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read accounting periods: %w", err)
+	}
+
+	return periods, nil
+}
+
+// GetClosingBalances returns a closed period and the balances it closed
+// with, or ErrPeriodNotClosed.
+func (r *Repository) GetClosingBalances(ctx context.Context, period string) (*AccountingPeriod, []ClosingBalance, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	p, err := scanPeriod(tx.QueryRow(ctx, `SELECT `+periodColumns+` FROM accounting_periods WHERE period = $1`, period))
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, fmt.Errorf("failed to get accounting period %s: %w", period, err)
+	}
+	if p == nil || p.Status != PeriodClosed {
+		return nil, nil, fmt.Errorf("%w: %s", subledgerErrors.ErrPeriodNotClosed, period)
+	}
+
+	balances, err := closingBalances(ctx, tx, period)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return p, balances, tx.Commit(ctx)
+}
+
+func closingBalances(ctx context.Context, tx pgx.Tx, period string) ([]ClosingBalance, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT account_id, amount
+		FROM period_closing_balances
+		WHERE period = $1
+		ORDER BY account_id
+	`, period)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get closing balances of %s: %w", period, err)
+	}
+	defer rows.Close()
+
+	var balances []ClosingBalance
+	for rows.Next() {
+		var b ClosingBalance
+		if err := rows.Scan(&b.AccountID, &b.Amount); err != nil {
+			return nil, fmt.Errorf("failed to scan closing balance: %w", err)
+		}
+		balances = append(balances, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read closing balances: %w", err)
+	}
+
+	return balances, nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
+)
+
+func TestPeriodOf(t *testing.T) {
+	tests := []struct {
+		day      time.Time
+		period   string
+		startsOn string
+		endsOn   string
+	}{
+		{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), "2026-01", "2026-01-01", "2026-02-01"},
+		{time.Date(2026, 2, 28, 23, 59, 0, 0, time.UTC), "2026-02", "2026-02-01", "2026-03-01"},
+		{time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), "2024-02", "2024-02-01", "2024-03-01"},
+		{time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), "2026-12", "2026-12-01", "2027-01-01"},
+	}
+
+	for _, tt := range tests {
+		p := PeriodOf(tt.day)
+		if p.Period != tt.period || p.StartsOn.Format("2006-01-02") != tt.startsOn || p.EndsOn.Format("2006-01-02") != tt.endsOn || p.Status != PeriodOpen {
+			t.Errorf("PeriodOf(%s) = %s %s..%s %s, want %s %s..%s OPEN", tt.day.Format("2006-01-02"),
+				p.Period, p.StartsOn.Format("2006-01-02"), p.EndsOn.Format("2006-01-02"), p.Status,
+				tt.period, tt.startsOn, tt.endsOn)
+		}
+	}
+}
+
+func TestCheckClosable(t *testing.T) {
+	day := func(year int, month time.Month, d int) *time.Time {
+		t := time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	march := PeriodOf(*day(2026, 3, 1))
+
+	tests := []struct {
+		name        string
+		through     *time.Time
+		earlierOpen string
+		want        error
+	}{
+		{"nothing closed yet", nil, "", nil},
+		{"follows the last closed period", day(2026, 3, 1), "", nil},
+		{"skips a month nothing was posted in", day(2026, 2, 1), "", nil},
+		{"already closed", day(2026, 4, 1), "", subledgerErrors.ErrPeriodClosed},
+		{"a later period is closed", day(2026, 6, 1), "", subledgerErrors.ErrPeriodClosed},
+		{"an earlier period is open", day(2026, 1, 1), "2026-02", subledgerErrors.ErrPeriodOutOfOrder},
+		{"the first period is open", nil, "2025-12", subledgerErrors.ErrPeriodOutOfOrder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkClosable(march, tt.through, tt.earlierOpen)
+			if (tt.want == nil) != (err == nil) || (tt.want != nil && !errors.Is(err, tt.want)) {
+				t.Errorf("checkClosable = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
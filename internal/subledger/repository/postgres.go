@@ -2,12 +2,15 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"sort"
 	"strings"
 	"time"
 
-	"github.com/google/uuid"
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
@@ -19,6 +22,80 @@ type TransactionEntry struct {
 	Direction string
 }
 
+type LedgerEntry struct {
+	ID            string
+	AccountID     string
+	Amount        decimal.Decimal
+	Direction     string
+	TransactionID string
+	ReferenceID   string
+	Description   string
+	CreatedAt     time.Time
+	EffectiveDate time.Time
+	// CounterpartyAccountIDs are the accounts on the opposite side of the
+	// same transaction. Only filled in by ListEntries.
+	CounterpartyAccountIDs []string
+}
+
+// EntryFilter narrows a ledger entry listing. Zero values mean "no
+// constraint".
+type EntryFilter struct {
+	AccountID       string
+	TransactionID   string
+	ReferenceID     string
+	From            *time.Time
+	To              *time.Time
+	EffectiveFrom   *time.Time
+	EffectiveTo     *time.Time
+	Direction       string
+	MinAmount       *decimal.Decimal
+	MaxAmount       *decimal.Decimal
+	ReferencePrefix string
+	Search          string
+}
+
+// EntryCursor is the keyset position of the last row of a page. Rows are
+// ordered by (created_at, id).
+type EntryCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"id"`
+}
+
+// LedgerAccount is one account of the chart of accounts. Code and
+// ParentAccountID are optional.
+type LedgerAccount struct {
+	AccountID       string
+	Code            *string
+	Name            string
+	Class           string
+	NormalBalance   string
+	ParentAccountID *string
+	CreatedAt       time.Time
+}
+
+type Transaction struct {
+	TransactionID string
+	ReferenceID   string
+	Description   string
+	CreatedAt     time.Time
+	EffectiveDate time.Time
+	Entries       []LedgerEntry
+}
+
+// ReversalReferencePrefix marks a transaction as the reversal of another:
+// its reference_id is ReversalReferencePrefix followed by the original
+// transaction id.
+const ReversalReferencePrefix = "reversal-"
+
+// ReversesTransactionID returns the id of the transaction t reverses, or ""
+// if t is not a reversal.
+func (t *Transaction) ReversesTransactionID() string {
+	if !strings.HasPrefix(t.ReferenceID, ReversalReferencePrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(t.ReferenceID, ReversalReferencePrefix)
+}
+
 type Repository struct {
 	pool   *pgxpool.Pool
 	logger logrus.FieldLogger
@@ -36,127 +113,484 @@ func NewRepository(pool *pgxpool.Pool, logger *logrus.Logger) *Repository {
 	}
 }
 
-func (r *Repository) CreateTransaction(ctx context.Context, refID string, desc string, entries []TransactionEntry) error {
-	tx, err := r.pool.Begin(ctx)
+// CreateTransaction posts entries effective on effectiveDate, a UTC
+// midnight. It fails with ErrUnknownLedgerAccount or ErrPeriodClosed if the
+// transaction cannot be posted.
+func (r *Repository) CreateTransaction(ctx context.Context, refID string, desc string, effectiveDate time.Time, entries []TransactionEntry) (string, error) {
+	if len(entries) == 0 {
+		return "", nil
+	}
+
+	results, err := r.CreateTransactions(ctx, []NewTransaction{{
+		ReferenceID:   refID,
+		Description:   desc,
+		EffectiveDate: effectiveDate,
+		Entries:       entries,
+	}}, true)
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return "", err
 	}
 
-	defer tx.Rollback(ctx)
+	return results[0].TransactionID, results[0].Err
+}
 
-	if len(entries) == 0 {
-		return nil
+// normalBalances returns the normal balance direction of every account
+// posted to by txns that is in the chart of accounts.
+func (r *Repository) normalBalances(ctx context.Context, tx pgx.Tx, txns []NewTransaction) (map[string]string, error) {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, txn := range txns {
+		for _, entry := range txn.Entries {
+			if !seen[entry.AccountID] {
+				seen[entry.AccountID] = true
+				ids = append(ids, entry.AccountID)
+			}
+		}
 	}
 
-	trxID := uuid.New().String()
-	timestamp := time.Now()
+	rows, err := tx.Query(ctx, `SELECT account_id, normal_balance FROM ledger_accounts WHERE account_id = ANY($1)`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up ledger accounts: %w", err)
+	}
+	defer rows.Close()
 
-	var ledgerArgs []interface{}
+	normalBalances := make(map[string]string, len(ids))
+	for rows.Next() {
+		var id, normalBalance string
+		if err := rows.Scan(&id, &normalBalance); err != nil {
+			return nil, fmt.Errorf("failed to scan ledger account: %w", err)
+		}
+		normalBalances[id] = normalBalance
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ledger accounts: %w", err)
+	}
 
-	balanceMap := make(map[string]decimal.Decimal)
+	return normalBalances, nil
+}
 
-	for _, entry := range entries {
-		entryID := uuid.New().String()
+func (r *Repository) GetBalance(ctx context.Context, accountID string) (decimal.Decimal, error) {
+	query := `SELECT amount FROM balances WHERE account_id = $1`
 
-		ledgerArgs = append(ledgerArgs,
-			entryID,
-			trxID,
-			entry.AccountID,
-			entry.Amount,
-			entry.Direction,
-			refID,
-			desc,
-			timestamp,
-		)
+	var amount decimal.Decimal
+	err := r.pool.QueryRow(ctx, query, accountID).Scan(&amount)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return decimal.Zero, fmt.Errorf("%w: account %s", subledgerErrors.ErrBalanceNotFound, accountID)
+		}
+		return decimal.Zero, fmt.Errorf("failed to get balance for account %s: %w", accountID, err)
+	}
+
+	return amount, nil
+}
+
+func (r *Repository) GetTransaction(ctx context.Context, transactionID string) (*Transaction, error) {
+	query := `
+		SELECT id, account_id, amount, direction,
+		       COALESCE(reference_id, ''), COALESCE(description, ''), created_at, effective_date
+		FROM ledger_entries
+		WHERE transaction_id = $1
+		ORDER BY direction DESC, account_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction %s: %w", transactionID, err)
+	}
+	defer rows.Close()
 
-		amount := entry.Amount
-		if entry.Direction == "DEBIT" {
-			amount = amount.Neg()
+	txn := &Transaction{TransactionID: transactionID}
+	for rows.Next() {
+		var entry LedgerEntry
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.AccountID,
+			&entry.Amount,
+			&entry.Direction,
+			&txn.ReferenceID,
+			&txn.Description,
+			&txn.CreatedAt,
+			&txn.EffectiveDate,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan ledger entry: %w", err)
 		}
+		txn.Entries = append(txn.Entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ledger entries: %w", err)
+	}
+
+	if len(txn.Entries) == 0 {
+		return nil, fmt.Errorf("%w: %s", subledgerErrors.ErrTransactionNotFound, transactionID)
+	}
 
-		if val, ok := balanceMap[entry.AccountID]; ok {
-			balanceMap[entry.AccountID] = val.Add(amount)
-		} else {
-			balanceMap[entry.AccountID] = amount
+	return txn, nil
+}
+
+// GetReversals returns the ids of transactions posted as reversals of
+// transactionID.
+func (r *Repository) GetReversals(ctx context.Context, transactionID string) ([]string, error) {
+	query := `
+		SELECT DISTINCT transaction_id
+		FROM ledger_entries
+		WHERE reference_id = $1
+		ORDER BY transaction_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, ReversalReferencePrefix+transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reversals of %s: %w", transactionID, err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan reversal: %w", err)
 		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// GetBalanceAt returns the balance of accountID excluding every entry posted
+// at or after asOf. The current balance and the later entries are read from
+// the same snapshot so the result is consistent.
+func (r *Repository) GetBalanceAt(ctx context.Context, accountID string, asOf time.Time) (decimal.Decimal, error) {
+	query := `
+		SELECT COALESCE((SELECT amount FROM balances WHERE account_id = $1), 0)
+		     - COALESCE((SELECT SUM(CASE WHEN le.direction = COALESCE(la.normal_balance, 'CREDIT') THEN le.amount ELSE -le.amount END)
+		                 FROM ledger_entries le
+		                 LEFT JOIN ledger_accounts la ON la.account_id = le.account_id
+		                 WHERE le.account_id = $1 AND le.created_at >= $2), 0)
+	`
+
+	var amount decimal.Decimal
+	if err := r.pool.QueryRow(ctx, query, accountID, asOf).Scan(&amount); err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get balance for account %s as of %s: %w", accountID, asOf, err)
 	}
 
-	placeholders := buildPlaceholders(1, len(entries), 8)
-	queryLedger := fmt.Sprintf(`
-			INSERT INTO ledger_entries (id, transaction_id, account_id, amount, direction, reference_id, description, created_at)
-			VALUES %s
-		`, placeholders)
+	return amount, nil
+}
 
-	_, err = tx.Exec(ctx, queryLedger, ledgerArgs...)
+// GetBalances returns the balance of each of accountIDs, just before asOf if
+// it is set, with zero for an account that has never been posted to.
+func (r *Repository) GetBalances(ctx context.Context, accountIDs []string, asOf *time.Time) (map[string]decimal.Decimal, error) {
+	query := `
+		SELECT ids.account_id,
+		       COALESCE(b.amount, 0) - COALESCE(later.amount, 0)
+		FROM unnest($1::text[]) AS ids(account_id)
+		LEFT JOIN balances b ON b.account_id = ids.account_id
+		LEFT JOIN LATERAL (
+			SELECT SUM(CASE WHEN le.direction = COALESCE(la.normal_balance, 'CREDIT') THEN le.amount ELSE -le.amount END) AS amount
+			FROM ledger_entries le
+			LEFT JOIN ledger_accounts la ON la.account_id = le.account_id
+			WHERE le.account_id = ids.account_id AND le.created_at >= $2
+		) later ON true
+	`
+
+	rows, err := r.pool.Query(ctx, query, accountIDs, asOf)
 	if err != nil {
-		return fmt.Errorf("failed to insert ledger entries: %w", err)
+		return nil, fmt.Errorf("failed to get balances: %w", err)
 	}
+	defer rows.Close()
 
-	accountIDs := make([]string, 0, len(balanceMap))
-	for accID := range balanceMap {
-		accountIDs = append(accountIDs, accID)
+	balances := make(map[string]decimal.Decimal, len(accountIDs))
+	for rows.Next() {
+		var accountID string
+		var amount decimal.Decimal
+		if err := rows.Scan(&accountID, &amount); err != nil {
+			return nil, fmt.Errorf("failed to scan balance: %w", err)
+		}
+		balances[accountID] = amount
 	}
-	sort.Strings(accountIDs)
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read balances: %w", err)
+	}
+
+	return balances, nil
+}
+
+// entryWhere builds the WHERE clause shared by the listing queries and
+// returns it together with its positional arguments.
+func entryWhere(filter EntryFilter) (string, []interface{}) {
+	var conds []string
+	var args []interface{}
 
-	var balanceArgs []interface{}
-	for _, accID := range accountIDs {
-		amount := balanceMap[accID]
-		balanceArgs = append(balanceArgs,
-			accID,
-			amount,
-			timestamp,
-		)
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
 	}
 
-	balancePlaceholders := buildPlaceholders(1, len(accountIDs), 3)
-	queryBalance := fmt.Sprintf(`
-			INSERT INTO balances (account_id, amount, updated_at)
-			VALUES %s
-			ON CONFLICT (account_id)
-			DO UPDATE SET 
-				amount = balances.amount + EXCLUDED.amount,
-				updated_at = EXCLUDED.updated_at
-		`, balancePlaceholders)
+	if filter.AccountID != "" {
+		add("account_id = $%d", filter.AccountID)
+	}
+	if filter.TransactionID != "" {
+		add("transaction_id = $%d", filter.TransactionID)
+	}
+	if filter.ReferenceID != "" {
+		add("reference_id = $%d", filter.ReferenceID)
+	}
+	if filter.From != nil {
+		add("created_at >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		add("created_at < $%d", *filter.To)
+	}
+	if filter.EffectiveFrom != nil {
+		add("effective_date >= $%d", *filter.EffectiveFrom)
+	}
+	if filter.EffectiveTo != nil {
+		add("effective_date < $%d", *filter.EffectiveTo)
+	}
+	if filter.Direction != "" {
+		add("direction = $%d", filter.Direction)
+	}
+	if filter.MinAmount != nil {
+		add("amount >= $%d", *filter.MinAmount)
+	}
+	if filter.MaxAmount != nil {
+		add("amount <= $%d", *filter.MaxAmount)
+	}
+	if filter.ReferencePrefix != "" {
+		add(`reference_id LIKE $%d ESCAPE '\'`, escapeLike(filter.ReferencePrefix)+"%")
+	}
+	if filter.Search != "" {
+		add(`description ILIKE $%d ESCAPE '\'`, "%"+escapeLike(filter.Search)+"%")
+	}
+
+	if len(conds) == 0 {
+		return "TRUE", args
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
 
-	_, err = tx.Exec(ctx, queryBalance, balanceArgs...)
+const entryColumns = `
+		SELECT id, account_id, amount, direction, transaction_id,
+		       COALESCE(reference_id, '') as reference_id,
+		       COALESCE(description, '') as description,
+		       created_at,
+		       effective_date,
+		       cp.account_ids
+		FROM ledger_entries le
+		LEFT JOIN LATERAL (
+			SELECT COALESCE(array_agg(o.account_id ORDER BY o.account_id), '{}') AS account_ids
+			FROM ledger_entries o
+			WHERE o.transaction_id = le.transaction_id
+			  AND o.direction <> le.direction
+		) cp ON true`
+
+// ListEntriesPage returns one page of entries using LIMIT/OFFSET along with
+// the total number of matching rows, newest first.
+func (r *Repository) ListEntriesPage(ctx context.Context, filter EntryFilter, page, pageSize int) ([]LedgerEntry, int, error) {
+	where, args := entryWhere(filter)
+
+	var totalCount int
+	countQuery := `SELECT COUNT(*) FROM ledger_entries WHERE ` + where
+	if err := r.pool.QueryRow(ctx, countQuery, args...).Scan(&totalCount); err != nil {
+		return nil, 0, fmt.Errorf("failed to count ledger entries: %w", err)
+	}
+
+	offset := (page - 1) * pageSize
+
+	query := fmt.Sprintf(`%s
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d
+	`, entryColumns, where, len(args)+1, len(args)+2)
+
+	entries, err := r.queryEntries(ctx, query, append(args, pageSize, offset)...)
 	if err != nil {
-		return fmt.Errorf("failed to update balances: %w", err)
+		return nil, 0, err
+	}
+
+	return entries, totalCount, nil
+}
+
+// ListEntries returns up to limit entries that come after cursor (or from
+// the start when cursor is nil) using keyset pagination on (created_at, id).
+func (r *Repository) ListEntries(ctx context.Context, filter EntryFilter, after *EntryCursor, limit int, ascending bool) ([]LedgerEntry, error) {
+	where, args := entryWhere(filter)
+
+	order, cmp := "DESC", "<"
+	if ascending {
+		order, cmp = "ASC", ">"
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		where += fmt.Sprintf(" AND (created_at, id) %s ($%d, $%d)", cmp, len(args)-1, len(args))
 	}
 
-	return nil
+	query := fmt.Sprintf(`%s
+		WHERE %s
+		ORDER BY created_at %s, id %s
+		LIMIT $%d
+	`, entryColumns, where, order, order, len(args)+1)
+
+	return r.queryEntries(ctx, query, append(args, limit)...)
 }
 
-func buildPlaceholders(startCount, rows, cols int) string {
-	var b strings.Builder
-	for i := 0; i < rows; i++ {
-		if i > 0 {
-			b.WriteString(",")
+func (r *Repository) queryEntries(ctx context.Context, query string, args ...interface{}) ([]LedgerEntry, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledger entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []LedgerEntry
+	for rows.Next() {
+		var entry LedgerEntry
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.AccountID,
+			&entry.Amount,
+			&entry.Direction,
+			&entry.TransactionID,
+			&entry.ReferenceID,
+			&entry.Description,
+			&entry.CreatedAt,
+			&entry.EffectiveDate,
+			&entry.CounterpartyAccountIDs,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan ledger entry: %w", err)
 		}
-		b.WriteString("(")
-		for j := 0; j < cols; j++ {
-			if j > 0 {
-				b.WriteString(",")
-			}
-			fmt.Fprintf(&b, "$%d", startCount+j)
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ledger entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (r *Repository) CreateLedgerAccount(ctx context.Context, account LedgerAccount) (*LedgerAccount, error) {
+	query := `
+		INSERT INTO ledger_accounts (account_id, code, name, class, normal_balance, parent_account_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		RETURNING account_id, code, name, class, normal_balance, parent_account_id, created_at
+	`
+
+	var created LedgerAccount
+	err := r.pool.QueryRow(ctx, query,
+		account.AccountID,
+		account.Code,
+		account.Name,
+		account.Class,
+		account.NormalBalance,
+		account.ParentAccountID,
+	).Scan(
+		&created.AccountID,
+		&created.Code,
+		&created.Name,
+		&created.Class,
+		&created.NormalBalance,
+		&created.ParentAccountID,
+		&created.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, fmt.Errorf("%w: %s", subledgerErrors.ErrLedgerAccountExists, pgErr.Detail)
 		}
-		b.WriteString(")")
-		startCount += cols
+		return nil, fmt.Errorf("failed to create ledger account: %w", err)
 	}
-	return b.String()
+
+	r.logger.Infof("created ledger account %s (%s)", created.AccountID, created.Class)
+	return &created, nil
 }
 
-func (r *Repository) GetBalance(ctx context.Context, accountID string) (decimal.Decimal, error) {
-	query := `SELECT amount FROM balances WHERE account_id = $1`
+// GetLedgerAccount returns nil without an error when accountID is not in the
+// chart of accounts.
+func (r *Repository) GetLedgerAccount(ctx context.Context, accountID string) (*LedgerAccount, error) {
+	query := `
+		SELECT account_id, code, name, class, normal_balance, parent_account_id, created_at
+		FROM ledger_accounts
+		WHERE account_id = $1
+	`
 
-	var amount decimal.Decimal
-	err := r.pool.QueryRow(ctx, query, accountID).Scan(&amount)
+	var account LedgerAccount
+	err := r.pool.QueryRow(ctx, query, accountID).Scan(
+		&account.AccountID,
+		&account.Code,
+		&account.Name,
+		&account.Class,
+		&account.NormalBalance,
+		&account.ParentAccountID,
+		&account.CreatedAt,
+	)
 	if err != nil {
-		return decimal.Zero, fmt.Errorf("failed to get balance for account %s: %w", accountID, err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get ledger account %s: %w", accountID, err)
 	}
 
-	return amount, nil
+	return &account, nil
+}
+
+// ListLedgerAccounts returns one page of the chart of accounts ordered by
+// code (accounts without a code last) along with the total match count.
+func (r *Repository) ListLedgerAccounts(ctx context.Context, class, parentAccountID string, page, pageSize int) ([]LedgerAccount, int, error) {
+	var conds []string
+	var args []interface{}
+	if class != "" {
+		args = append(args, class)
+		conds = append(conds, fmt.Sprintf("class = $%d", len(args)))
+	}
+	if parentAccountID != "" {
+		args = append(args, parentAccountID)
+		conds = append(conds, fmt.Sprintf("parent_account_id = $%d", len(args)))
+	}
+	where := "TRUE"
+	if len(conds) > 0 {
+		where = strings.Join(conds, " AND ")
+	}
+
+	var totalCount int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM ledger_accounts WHERE `+where, args...).Scan(&totalCount); err != nil {
+		return nil, 0, fmt.Errorf("failed to count ledger accounts: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT account_id, code, name, class, normal_balance, parent_account_id, created_at
+		FROM ledger_accounts
+		WHERE %s
+		ORDER BY code NULLS LAST, account_id
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
+
+	rows, err := r.pool.Query(ctx, query, append(args, pageSize, (page-1)*pageSize)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list ledger accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []LedgerAccount
+	for rows.Next() {
+		var account LedgerAccount
+		if err := rows.Scan(
+			&account.AccountID,
+			&account.Code,
+			&account.Name,
+			&account.Class,
+			&account.NormalBalance,
+			&account.ParentAccountID,
+			&account.CreatedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan ledger account: %w", err)
+		}
+		accounts = append(accounts, account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read ledger accounts: %w", err)
+	}
+
+	return accounts, totalCount, nil
 }
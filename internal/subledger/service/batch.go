@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/repository"
+)
+
+// Batch modes: ATOMIC posts every transaction of a batch or none of them,
+// BEST_EFFORT posts those that can be and reports the rest.
+const (
+	BatchAtomic     = "ATOMIC"
+	BatchBestEffort = "BEST_EFFORT"
+)
+
+// maxBatchSize caps the transactions posted in one database transaction.
+const maxBatchSize = 50000
+
+// BatchItem is one transaction of a batch. Err rejects it before it is
+// validated, for a request the caller could not parse.
+type BatchItem struct {
+	Transaction repository.NewTransaction
+	Err         error
+}
+
+// ParseBatchMode returns the batch mode named mode, ATOMIC if empty.
+func ParseBatchMode(mode string) (string, error) {
+	switch mode = strings.ToUpper(strings.TrimSpace(mode)); mode {
+	case "":
+		return BatchAtomic, nil
+	case BatchAtomic, BatchBestEffort:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("%w: mode must be ATOMIC or BEST_EFFORT, got %q", subledgerErrors.ErrInvalidBatch, mode)
+	}
+}
+
+// CreateTransactions posts many independent transactions, each validated
+// like CreateTransaction, and returns the outcome of each in order. In
+// ATOMIC mode a single rejected transaction leaves them all unposted.
+func (s *Service) CreateTransactions(ctx context.Context, items []BatchItem, mode string) ([]repository.PostResult, error) {
+	mode, err := ParseBatchMode(mode)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 || len(items) > maxBatchSize {
+		return nil, fmt.Errorf("%w: a batch holds 1 to %d transactions, got %d", subledgerErrors.ErrInvalidBatch, maxBatchSize, len(items))
+	}
+
+	results := make([]repository.PostResult, len(items))
+	valid := make([]repository.NewTransaction, 0, len(items))
+	positions := make([]int, 0, len(items))
+
+	now := time.Now()
+	for i, item := range items {
+		if item.Err != nil {
+			results[i].Err = item.Err
+			continue
+		}
+		txn := item.Transaction
+		effectiveDate, err := s.validateTransaction(txn.Entries, txn.EffectiveDate, now)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		txn.EffectiveDate = effectiveDate
+		valid = append(valid, txn)
+		positions = append(positions, i)
+	}
+
+	atomic := mode == BatchAtomic
+	if atomic && len(valid) < len(items) {
+		for i := range results {
+			if results[i].Err == nil {
+				results[i].Err = subledgerErrors.ErrBatchAborted
+			}
+		}
+		return results, nil
+	}
+
+	posted, err := s.repo.CreateTransactions(ctx, valid, atomic)
+	if err != nil {
+		return nil, err
+	}
+	for j, result := range posted {
+		results[positions[j]] = result
+	}
+
+	return results, nil
+}
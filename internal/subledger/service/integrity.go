@@ -0,0 +1,357 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/repository"
+)
+
+// Reasons a ChainBreak is reported for.
+const (
+	BreakPrevHashMismatch   = "PREV_HASH_MISMATCH"
+	BreakContentMismatch    = "CONTENT_MISMATCH"
+	BreakCheckpointMismatch = "CHECKPOINT_MISMATCH"
+	BreakCheckpointMissing  = "CHECKPOINT_MISSING"
+	BreakUnchained          = "UNCHAINED_TRANSACTION"
+)
+
+// verifyBatchSize is how many links VerifyLedgerIntegrity reads per query.
+const verifyBatchSize = 500
+
+// ChainBreak is the first point at which the ledger no longer matches its
+// hash chain. Expected and Actual are the hashes or signatures compared.
+type ChainBreak struct {
+	Seq           int64
+	TransactionID string
+	Reason        string
+	Expected      string
+	Actual        string
+}
+
+// IntegrityReport is the outcome of walking the chain. Break is nil when
+// the ledger is intact.
+type IntegrityReport struct {
+	LinksChecked       int64
+	CheckpointsChecked int
+	HeadSeq            int64
+	HeadHash           string
+	Break              *ChainBreak
+}
+
+// CheckpointRecord is one line of the checkpoint file.
+type CheckpointRecord struct {
+	Seq           int64  `json:"seq"`
+	TransactionID string `json:"transaction_id"`
+	Hash          string `json:"hash"`
+	CreatedAt     string `json:"created_at"`
+	PublicKey     string `json:"public_key"`
+	Signature     string `json:"signature"`
+}
+
+// InitLedgerChain chains the transactions posted before the chain existed.
+func (s *Service) InitLedgerChain(ctx context.Context) error {
+	chained, err := s.repo.ChainExistingTransactions(ctx)
+	if err != nil {
+		return err
+	}
+	if chained > 0 {
+		s.logger.Infof("started the ledger chain with %d existing transactions", chained)
+	}
+	return nil
+}
+
+// expectedCheckpoint is a checkpoint the chain must pass through. The
+// exported file is trusted over the database: a checkpoint found in both
+// keeps the file's hash and signature, and stored is the database's copy.
+type expectedCheckpoint struct {
+	repository.Checkpoint
+	exported bool
+	stored   *repository.Checkpoint
+}
+
+// VerifyLedgerIntegrity walks the hash chain from the start, recomputing
+// every link from the entries it covers, and checks the chain against the
+// signed checkpoints, both those exported to the checkpoint file and those
+// recorded in the database. An exported checkpoint the database has lost
+// is a break. It stops at the first broken link.
+func (s *Service) VerifyLedgerIntegrity(ctx context.Context) (*IntegrityReport, error) {
+	stored, err := s.repo.ListCheckpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exported, err := readCheckpointFile(s.checkpointFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ledger checkpoints: %w", err)
+	}
+
+	pending := make(map[int64]*expectedCheckpoint, len(stored)+len(exported))
+	for i := range stored {
+		pending[stored[i].Seq] = &expectedCheckpoint{Checkpoint: stored[i], stored: &stored[i]}
+	}
+	for _, rec := range exported {
+		cp, ok := pending[rec.Seq]
+		if !ok {
+			cp = &expectedCheckpoint{}
+			pending[rec.Seq] = cp
+		}
+		cp.Seq, cp.Hash, cp.Signature = rec.Seq, rec.Hash, rec.Signature
+		cp.exported = true
+	}
+	seqs := make([]int64, 0, len(pending))
+	for seq := range pending {
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	report := &IntegrityReport{HeadHash: repository.GenesisHash}
+	for {
+		links, err := s.repo.ListChainLinks(ctx, report.HeadSeq, verifyBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]string, len(links))
+		for i, link := range links {
+			ids[i] = link.TransactionID
+		}
+		txns, err := s.repo.GetTransactions(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, link := range links {
+			if brk := s.checkLink(link, report.HeadHash, txns[link.TransactionID], pending[link.Seq]); brk != nil {
+				report.Break = brk
+				return report, nil
+			}
+			if _, ok := pending[link.Seq]; ok {
+				delete(pending, link.Seq)
+				report.CheckpointsChecked++
+			}
+			report.LinksChecked++
+			report.HeadSeq = link.Seq
+			report.HeadHash = link.Hash
+		}
+
+		if len(links) < verifyBatchSize {
+			break
+		}
+	}
+
+	// A checkpoint past the head means links were removed from the end.
+	for _, seq := range seqs {
+		if cp, ok := pending[seq]; ok {
+			report.Break = &ChainBreak{
+				Seq:      cp.Seq,
+				Reason:   BreakCheckpointMismatch,
+				Expected: cp.Hash,
+			}
+			return report, nil
+		}
+	}
+
+	unchained, err := s.repo.FindUnchainedTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if unchained != "" {
+		report.Break = &ChainBreak{TransactionID: unchained, Reason: BreakUnchained}
+	}
+
+	return report, nil
+}
+
+// checkLink returns why link does not follow prevHash, does not match txn
+// or does not match cp, the checkpoint at its seq if there is one; nil if
+// it is sound. txn is nil when the link has no entries left.
+func (s *Service) checkLink(link repository.ChainLink, prevHash string, txn *repository.Transaction, cp *expectedCheckpoint) *ChainBreak {
+	brk := &ChainBreak{Seq: link.Seq, TransactionID: link.TransactionID}
+
+	if link.PrevHash != prevHash {
+		brk.Reason, brk.Expected, brk.Actual = BreakPrevHashMismatch, prevHash, link.PrevHash
+		return brk
+	}
+
+	actual := ""
+	if txn != nil {
+		actual = repository.ChainHash(link.Version, prevHash, txn)
+	}
+	if actual != link.Hash {
+		brk.Reason, brk.Expected, brk.Actual = BreakContentMismatch, link.Hash, actual
+		return brk
+	}
+
+	if cp == nil {
+		return nil
+	}
+	if cp.Hash != link.Hash {
+		brk.Reason, brk.Expected, brk.Actual = BreakCheckpointMismatch, cp.Hash, link.Hash
+		return brk
+	}
+	if s.checkpointKey != nil && !s.verifyCheckpoint(cp.Checkpoint) {
+		brk.Reason, brk.Expected, brk.Actual = BreakCheckpointMismatch, "a valid signature", cp.Signature
+		return brk
+	}
+	if cp.stored == nil {
+		brk.Reason, brk.Expected = BreakCheckpointMissing, cp.Hash
+		return brk
+	}
+	if cp.stored.Hash != cp.Hash || cp.stored.Signature != cp.Signature {
+		brk.Reason, brk.Expected, brk.Actual = BreakCheckpointMismatch, cp.Hash, cp.stored.Hash
+		return brk
+	}
+
+	return nil
+}
+
+// readCheckpointFile returns the checkpoints exported to path, oldest first,
+// or none if nothing has been exported there yet.
+func readCheckpointFile(path string) ([]CheckpointRecord, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []CheckpointRecord
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		var rec CheckpointRecord
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", path, line, err)
+		}
+		records = append(records, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// RunCheckpoints writes a checkpoint of the chain head to the checkpoint
+// file every interval until ctx is cancelled.
+func (s *Service) RunCheckpoints(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.WriteCheckpoint(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Errorf("failed to write ledger checkpoint: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// WriteCheckpoint signs the head of the chain, appends it to the checkpoint
+// file as a JSON line and records it. It returns nil if the head has not moved since the
+// last checkpoint. The file is written first, so a checkpoint is never
+// recorded without being exported.
+func (s *Service) WriteCheckpoint(ctx context.Context) (*repository.Checkpoint, error) {
+	if s.checkpointKey == nil {
+		return nil, fmt.Errorf("no checkpoint signing key configured")
+	}
+	if s.checkpointFile == "" {
+		return nil, fmt.Errorf("no checkpoint file configured")
+	}
+
+	head, err := s.repo.GetChainHead(ctx)
+	if err != nil || head == nil {
+		return nil, err
+	}
+	latest, err := s.repo.GetLatestCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil && latest.Seq >= head.Seq {
+		return nil, nil
+	}
+
+	cp := &repository.Checkpoint{
+		Seq:       head.Seq,
+		Hash:      head.Hash,
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(s.checkpointKey, checkpointMessage(head.Seq, head.Hash))),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	line, err := json.Marshal(CheckpointRecord{
+		Seq:           cp.Seq,
+		TransactionID: head.TransactionID,
+		Hash:          cp.Hash,
+		CreatedAt:     cp.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		PublicKey:     hex.EncodeToString(s.checkpointKey.Public().(ed25519.PublicKey)),
+		Signature:     cp.Signature,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode ledger checkpoint: %w", err)
+	}
+	if err := appendLine(s.checkpointFile, line); err != nil {
+		return nil, fmt.Errorf("failed to export ledger checkpoint: %w", err)
+	}
+
+	if err := s.repo.CreateCheckpoint(ctx, cp); err != nil {
+		return nil, err
+	}
+
+	s.logger.Infof("checkpointed the ledger chain at %d (%s)", cp.Seq, cp.Hash)
+	return cp, nil
+}
+
+func (s *Service) verifyCheckpoint(cp repository.Checkpoint) bool {
+	sig, err := base64.StdEncoding.DecodeString(cp.Signature)
+	if err != nil {
+		return false
+	}
+	return ed25519.Verify(s.checkpointKey.Public().(ed25519.PublicKey), checkpointMessage(cp.Seq, cp.Hash), sig)
+}
+
+// checkpointMessage is what a checkpoint signs.
+func checkpointMessage(seq int64, hash string) []byte {
+	return []byte(fmt.Sprintf("ledger-checkpoint:%d:%s", seq, hash))
+}
+
+func appendLine(path string, line []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return f.Sync()
+}
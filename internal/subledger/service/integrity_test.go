@@ -0,0 +1,150 @@
+package service
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/repository"
+
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+func TestCheckLink(t *testing.T) {
+	key := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{7}, ed25519.SeedSize))
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	svc := NewService(nil, key, "", logger)
+
+	txn := &repository.Transaction{
+		TransactionID: "txn-1",
+		ReferenceID:   "ref-1",
+		CreatedAt:     time.Date(2026, 1, 31, 10, 0, 0, 0, time.UTC),
+		EffectiveDate: time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		Entries: []repository.LedgerEntry{
+			{ID: "e-1", AccountID: "1001", Direction: "DEBIT", Amount: decimal.RequireFromString("10")},
+			{ID: "e-2", AccountID: "2001", Direction: "CREDIT", Amount: decimal.RequireFromString("10")},
+		},
+	}
+	prev := repository.GenesisHash
+	hash := repository.ChainHash(repository.ChainVersion, prev, txn)
+	link := repository.ChainLink{Seq: 1, Version: repository.ChainVersion, TransactionID: "txn-1", PrevHash: prev, Hash: hash}
+
+	tampered := *txn
+	tampered.Description = "edited"
+
+	sign := func(seq int64, hash string) string {
+		return base64.StdEncoding.EncodeToString(ed25519.Sign(key, checkpointMessage(seq, hash)))
+	}
+	signed := repository.Checkpoint{Seq: 1, Hash: hash, Signature: sign(1, hash)}
+	checkpoint := func(exported bool, stored *repository.Checkpoint) *expectedCheckpoint {
+		return &expectedCheckpoint{Checkpoint: signed, exported: exported, stored: stored}
+	}
+
+	tests := []struct {
+		name   string
+		prev   string
+		txn    *repository.Transaction
+		cp     *expectedCheckpoint
+		reason string
+	}{
+		{"sound", prev, txn, nil, ""},
+		{"sound at a checkpoint", prev, txn, checkpoint(true, &signed), ""},
+		{"stored checkpoint not yet exported", prev, txn, checkpoint(false, &signed), ""},
+		{"previous hash differs", strings.Repeat("1", 64), txn, nil, BreakPrevHashMismatch},
+		{"entries edited", prev, &tampered, nil, BreakContentMismatch},
+		{"entries deleted", prev, nil, nil, BreakContentMismatch},
+		{
+			name: "checkpoint at another hash",
+			prev: prev, txn: txn,
+			cp:     &expectedCheckpoint{Checkpoint: repository.Checkpoint{Seq: 1, Hash: "other", Signature: sign(1, "other")}, exported: true},
+			reason: BreakCheckpointMismatch,
+		},
+		{
+			name: "checkpoint signed by another key",
+			prev: prev, txn: txn,
+			cp: &expectedCheckpoint{Checkpoint: repository.Checkpoint{
+				Seq:       1,
+				Hash:      hash,
+				Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)), checkpointMessage(1, hash))),
+			}, exported: true},
+			reason: BreakCheckpointMismatch,
+		},
+		{"exported checkpoint lost from the database", prev, txn, checkpoint(true, nil), BreakCheckpointMissing},
+		{"stored checkpoint differs from the export", prev, txn, checkpoint(true, &repository.Checkpoint{Seq: 1, Hash: hash, Signature: "forged"}), BreakCheckpointMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			brk := svc.checkLink(link, tt.prev, tt.txn, tt.cp)
+			if tt.reason == "" {
+				if brk != nil {
+					t.Errorf("checkLink = %+v, want nil", brk)
+				}
+				return
+			}
+			if brk == nil || brk.Reason != tt.reason || brk.Seq != 1 || brk.TransactionID != "txn-1" {
+				t.Errorf("checkLink = %+v, want a %s break at 1 txn-1", brk, tt.reason)
+			}
+		})
+	}
+}
+
+func TestReadCheckpointFile(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, path := range []string{"", filepath.Join(dir, "missing.jsonl")} {
+		records, err := readCheckpointFile(path)
+		if err != nil || records != nil {
+			t.Errorf("readCheckpointFile(%q) = %v, %v, want nothing", path, records, err)
+		}
+	}
+
+	path := filepath.Join(dir, "checkpoints.jsonl")
+	content := `{"seq":1,"transaction_id":"txn-1","hash":"aa","signature":"s1"}` + "\n\n" +
+		`  {"seq":5,"transaction_id":"txn-5","hash":"bb","signature":"s5","unknown":true}  ` + "\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	records, err := readCheckpointFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []CheckpointRecord{
+		{Seq: 1, TransactionID: "txn-1", Hash: "aa", Signature: "s1"},
+		{Seq: 5, TransactionID: "txn-5", Hash: "bb", Signature: "s5"},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("readCheckpointFile = %+v, want %+v", records, want)
+	}
+
+	if err := os.WriteFile(path, []byte(content+"{truncated\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readCheckpointFile(path); err == nil || !strings.Contains(err.Error(), "line 4") {
+		t.Errorf("readCheckpointFile of a damaged file = %v, want an error at line 4", err)
+	}
+}
+
+func TestWriteCheckpointNeedsKeyAndFile(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	key := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{7}, ed25519.SeedSize))
+
+	for _, svc := range []*Service{
+		NewService(nil, nil, filepath.Join(t.TempDir(), "checkpoints.jsonl"), logger),
+		NewService(nil, key, "", logger),
+	} {
+		if _, err := svc.WriteCheckpoint(t.Context()); err == nil {
+			t.Errorf("WriteCheckpoint without a key or file = nil, want an error")
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/journal"
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+// JournalIssue is a problem with one line of an imported journal, or with
+// the transaction that starts on it.
+type JournalIssue struct {
+	Line        int
+	ReferenceID string
+	Err         error
+}
+
+type ImportedTransaction struct {
+	ReferenceID   string
+	TransactionID string
+}
+
+// JournalImport reports on an imported journal. Its transactions are posted
+// all together, and only when it is not a dry run and has no issues.
+type JournalImport struct {
+	DryRun       bool
+	Lines        int
+	Transactions int
+	Posted       []ImportedTransaction
+	Issues       []JournalIssue
+}
+
+// reject marks t invalid and reports err against line.
+func (r *JournalImport) reject(t *journalTransaction, line int, err error) {
+	t.invalid = true
+	r.Issues = append(r.Issues, JournalIssue{Line: line, ReferenceID: t.txn.ReferenceID, Err: err})
+}
+
+// journalTransaction is a transaction being assembled from the lines of a
+// journal, starting on line.
+type journalTransaction struct {
+	line          int
+	effectiveDate string
+	transactionID string
+	txn           repository.NewTransaction
+	invalid       bool
+}
+
+// ImportJournal reads balanced transactions from a journal in format and
+// posts them in one all-or-nothing batch. Entries sharing a reference_id
+// make up one transaction, and must agree on its transaction_id if they
+// give one. Unbalanced transactions, unknown accounts, bad amounts, closed
+// periods and references already in the ledger are reported as issues, in
+// which case nothing is posted; so is a dry run.
+func (s *Service) ImportJournal(ctx context.Context, r io.Reader, format string, dryRun bool) (*JournalImport, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if !journal.IsSupportedFormat(format) {
+		return nil, fmt.Errorf("%w: format must be csv or jsonl, got %q", subledgerErrors.ErrInvalidJournal, format)
+	}
+
+	lines, err := journal.Read(r, format)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", subledgerErrors.ErrInvalidJournal, err)
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: no entries", subledgerErrors.ErrInvalidJournal)
+	}
+
+	report, txns := s.assembleJournal(lines, dryRun, time.Now())
+	if len(txns) > maxBatchSize {
+		return nil, fmt.Errorf("%w: a journal holds up to %d transactions, got %d", subledgerErrors.ErrInvalidJournal, maxBatchSize, len(txns))
+	}
+
+	var refs []string
+	for _, t := range txns {
+		if !t.invalid {
+			refs = append(refs, t.txn.ReferenceID)
+		}
+	}
+
+	if len(refs) > 0 {
+		posted, err := s.repo.PostedReferences(ctx, refs)
+		if err != nil {
+			return nil, err
+		}
+
+		var valid []*journalTransaction
+		var pending []repository.NewTransaction
+		for _, t := range txns {
+			if t.invalid {
+				continue
+			}
+			if posted[t.txn.ReferenceID] {
+				report.reject(t, t.line, fmt.Errorf("%w: %s", subledgerErrors.ErrDuplicateReference, t.txn.ReferenceID))
+				continue
+			}
+			valid = append(valid, t)
+			pending = append(pending, t.txn)
+		}
+
+		errs, err := s.repo.CheckTransactions(ctx, pending)
+		if err != nil {
+			return nil, err
+		}
+		for i, err := range errs {
+			if err != nil {
+				report.reject(valid[i], valid[i].line, err)
+			}
+		}
+	}
+
+	if dryRun || len(report.Issues) > 0 {
+		sortIssues(report.Issues)
+		return report, nil
+	}
+
+	pending := make([]repository.NewTransaction, len(txns))
+	for i, t := range txns {
+		pending[i] = t.txn
+	}
+	results, err := s.repo.CreateTransactions(ctx, pending, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Something changed since the checks above; the batch was not posted.
+	for i, result := range results {
+		if result.Err != nil && !errors.Is(result.Err, subledgerErrors.ErrBatchAborted) {
+			report.reject(txns[i], txns[i].line, result.Err)
+		}
+	}
+	if len(report.Issues) > 0 {
+		sortIssues(report.Issues)
+		return report, nil
+	}
+
+	report.Posted = make([]ImportedTransaction, len(results))
+	for i, result := range results {
+		report.Posted[i] = ImportedTransaction{ReferenceID: txns[i].txn.ReferenceID, TransactionID: result.TransactionID}
+	}
+
+	s.logger.Infof("imported %d transactions from a %d line journal", len(report.Posted), report.Lines)
+	return report, nil
+}
+
+// assembleJournal groups the lines of a journal into transactions and
+// checks each one on its own, reporting what is wrong with them. Nothing is
+// looked up in the ledger.
+func (s *Service) assembleJournal(lines []journal.Line, dryRun bool, now time.Time) (*JournalImport, []*journalTransaction) {
+	report := &JournalImport{DryRun: dryRun, Lines: len(lines)}
+
+	var txns []*journalTransaction
+	byReference := make(map[string]*journalTransaction)
+	for _, l := range lines {
+		t, ok := byReference[l.ReferenceID]
+		if !ok {
+			t = &journalTransaction{
+				line:          l.Number,
+				effectiveDate: l.EffectiveDate,
+				transactionID: l.TransactionID,
+				txn: repository.NewTransaction{
+					ReferenceID: l.ReferenceID,
+					Description: l.Description,
+				},
+			}
+			byReference[l.ReferenceID] = t
+			txns = append(txns, t)
+
+			if l.EffectiveDate != "" {
+				day, err := time.Parse(time.DateOnly, l.EffectiveDate)
+				if err != nil {
+					report.reject(t, l.Number, fmt.Errorf("%w: %q, expected YYYY-MM-DD", subledgerErrors.ErrInvalidEffectiveDate, l.EffectiveDate))
+				}
+				t.txn.EffectiveDate = day
+			}
+		} else {
+			// A reference names one transaction; two in one file would post
+			// both under it.
+			if l.TransactionID != t.transactionID {
+				report.reject(t, l.Number, fmt.Errorf("%w: reference_id %s is used by transaction %q on line %d and %q here",
+					subledgerErrors.ErrInvalidJournal, l.ReferenceID, t.transactionID, t.line, l.TransactionID))
+			}
+			if l.EffectiveDate != t.effectiveDate {
+				report.reject(t, l.Number, fmt.Errorf("%w: effective_date %q differs from %q on line %d",
+					subledgerErrors.ErrInvalidJournal, l.EffectiveDate, t.effectiveDate, t.line))
+			}
+		}
+
+		entry, err := journalEntry(l)
+		if err != nil {
+			report.reject(t, l.Number, err)
+			continue
+		}
+		t.txn.Entries = append(t.txn.Entries, entry)
+	}
+
+	report.Transactions = len(txns)
+	for _, t := range txns {
+		if t.invalid {
+			continue
+		}
+		day, err := s.validateTransaction(t.txn.Entries, t.txn.EffectiveDate, now)
+		if err != nil {
+			report.reject(t, t.line, err)
+			continue
+		}
+		t.txn.EffectiveDate = day
+	}
+
+	return report, txns
+}
+
+// journalEntry parses the entry on one line of a journal.
+func journalEntry(l journal.Line) (repository.TransactionEntry, error) {
+	if l.ReferenceID == "" {
+		return repository.TransactionEntry{}, fmt.Errorf("%w: reference_id is required", subledgerErrors.ErrInvalidJournal)
+	}
+	if l.AccountID == "" {
+		return repository.TransactionEntry{}, fmt.Errorf("%w: account_id is required", subledgerErrors.ErrInvalidJournal)
+	}
+
+	direction := strings.ToUpper(l.Direction)
+	if direction != DEBIT && direction != CREDIT {
+		return repository.TransactionEntry{}, fmt.Errorf("%w: %q", subledgerErrors.ErrInvalidDirection, l.Direction)
+	}
+
+	amount, err := decimal.NewFromString(l.Amount)
+	if err != nil {
+		return repository.TransactionEntry{}, fmt.Errorf("%w: %q", subledgerErrors.ErrInvalidAmount, l.Amount)
+	}
+	if !amount.IsPositive() {
+		return repository.TransactionEntry{}, fmt.Errorf("%w: %s", subledgerErrors.ErrAmountMustBePositive, l.Amount)
+	}
+	// The ledger stores amounts to the cent and would round anything finer.
+	if !amount.Equal(amount.Truncate(2)) {
+		return repository.TransactionEntry{}, fmt.Errorf("%w: %s has more than 2 decimal places", subledgerErrors.ErrInvalidAmount, l.Amount)
+	}
+
+	return repository.TransactionEntry{AccountID: l.AccountID, Amount: amount, Direction: direction}, nil
+}
+
+func sortIssues(issues []JournalIssue) {
+	sort.SliceStable(issues, func(i, j int) bool { return issues[i].Line < issues[j].Line })
+}
+
+// ExportJournal writes to w, in format, either the entries effective in the
+// period named period or the balance of every account over it, and returns
+// the number of rows written.
+func (s *Service) ExportJournal(ctx context.Context, w io.Writer, kind, period, format string) (int, error) {
+	p, err := ParsePeriod(period)
+	if err != nil {
+		return 0, err
+	}
+	if !journal.IsSupportedKind(kind) {
+		return 0, fmt.Errorf("%w: kind must be entries or balances, got %q", subledgerErrors.ErrInvalidFilter, kind)
+	}
+	if !journal.IsSupportedFormat(format) {
+		return 0, fmt.Errorf("%w: format must be csv or jsonl, got %q", subledgerErrors.ErrInvalidFilter, format)
+	}
+
+	if kind == journal.KindBalances {
+		return s.exportPeriodBalances(ctx, journal.NewBalanceWriter(w, format), p)
+	}
+
+	// Nothing is effective later than the day it was posted, so created_at
+	// bounds the scan too. A day early allows for the database clock's zone.
+	postedFrom := p.StartsOn.AddDate(0, 0, -1)
+	filter := repository.EntryFilter{
+		From:          &postedFrom,
+		EffectiveFrom: &p.StartsOn,
+		EffectiveTo:   &p.EndsOn,
+	}
+
+	jw := journal.NewEntryWriter(w, format)
+	count, err := s.ExportEntries(ctx, filter, true, func(e repository.LedgerEntry) error {
+		return jw.WriteLine(journal.Line{
+			ReferenceID:   e.ReferenceID,
+			EffectiveDate: e.EffectiveDate.Format(time.DateOnly),
+			Description:   e.Description,
+			AccountID:     e.AccountID,
+			Direction:     e.Direction,
+			Amount:        e.Amount.StringFixed(2),
+			TransactionID: e.TransactionID,
+			CreatedAt:     e.CreatedAt.Format(time.RFC3339Nano),
+		})
+	})
+	if err != nil {
+		return count, err
+	}
+
+	return count, jw.Flush()
+}
+
+func (s *Service) exportPeriodBalances(ctx context.Context, jw *journal.Writer, period repository.AccountingPeriod) (int, error) {
+	balances, err := s.repo.PeriodBalances(ctx, period)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, b := range balances {
+		movement := b.Credits.Sub(b.Debits)
+		if b.NormalBalance == DEBIT {
+			movement = movement.Neg()
+		}
+		err := jw.WriteBalance(journal.Balance{
+			AccountID:     b.AccountID,
+			NormalBalance: b.NormalBalance,
+			Opening:       b.Opening,
+			Debits:        b.Debits,
+			Credits:       b.Credits,
+			Closing:       b.Opening.Add(movement),
+		})
+		if err != nil {
+			return i, err
+		}
+	}
+
+	return len(balances), jw.Flush()
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/journal"
+
+	"github.com/sirupsen/logrus"
+)
+
+func line(number int, ref, account, direction, amount string) journal.Line {
+	return journal.Line{Number: number, ReferenceID: ref, AccountID: account, Direction: direction, Amount: amount}
+}
+
+func withTransaction(l journal.Line, transactionID string) journal.Line {
+	l.TransactionID = transactionID
+	return l
+}
+
+func withDate(l journal.Line, date string) journal.Line {
+	l.EffectiveDate = date
+	return l
+}
+
+func TestAssembleJournal(t *testing.T) {
+	type issue struct {
+		line int
+		err  error
+	}
+
+	tests := []struct {
+		name         string
+		lines        []journal.Line
+		transactions int
+		issues       []issue
+	}{
+		{
+			name: "balanced",
+			lines: []journal.Line{
+				line(2, "ref-1", "1001", "DEBIT", "10.00"),
+				line(3, "ref-1", "2001", "credit", "10"),
+				line(4, "ref-2", "1001", "CREDIT", "2.50"),
+				line(5, "ref-2", "2001", "DEBIT", "2.5"),
+			},
+			transactions: 2,
+		},
+		{
+			name: "unbalanced",
+			lines: []journal.Line{
+				line(2, "ref-1", "1001", "DEBIT", "10.00"),
+				line(3, "ref-1", "2001", "CREDIT", "9.99"),
+			},
+			transactions: 1,
+			issues:       []issue{{2, subledgerErrors.ErrUnbalancedTransaction}},
+		},
+		{
+			name: "single entry",
+			lines: []journal.Line{
+				line(2, "ref-1", "1001", "DEBIT", "10.00"),
+			},
+			transactions: 1,
+			issues:       []issue{{2, subledgerErrors.ErrNotEnoughEntries}},
+		},
+		{
+			name: "same reference and transaction",
+			lines: []journal.Line{
+				withTransaction(line(2, "ref-1", "1001", "DEBIT", "10.00"), "txn-1"),
+				withTransaction(line(3, "ref-1", "2001", "CREDIT", "10.00"), "txn-1"),
+			},
+			transactions: 1,
+		},
+		{
+			name: "reference shared by two transactions",
+			lines: []journal.Line{
+				withTransaction(line(2, "ref-1", "1001", "DEBIT", "10.00"), "txn-1"),
+				withTransaction(line(3, "ref-1", "2001", "CREDIT", "10.00"), "txn-1"),
+				withTransaction(line(4, "ref-1", "1001", "DEBIT", "5.00"), "txn-2"),
+				withTransaction(line(5, "ref-1", "2001", "CREDIT", "5.00"), "txn-2"),
+			},
+			transactions: 1,
+			issues:       []issue{{4, subledgerErrors.ErrInvalidJournal}, {5, subledgerErrors.ErrInvalidJournal}},
+		},
+		{
+			name: "reference with and without a transaction",
+			lines: []journal.Line{
+				line(2, "ref-1", "1001", "DEBIT", "10.00"),
+				withTransaction(line(3, "ref-1", "2001", "CREDIT", "10.00"), "txn-1"),
+			},
+			transactions: 1,
+			issues:       []issue{{3, subledgerErrors.ErrInvalidJournal}},
+		},
+		{
+			name: "effective dates differ",
+			lines: []journal.Line{
+				withDate(line(2, "ref-1", "1001", "DEBIT", "10.00"), "2026-01-30"),
+				withDate(line(3, "ref-1", "2001", "CREDIT", "10.00"), "2026-01-31"),
+			},
+			transactions: 1,
+			issues:       []issue{{3, subledgerErrors.ErrInvalidJournal}},
+		},
+		{
+			name: "bad effective date",
+			lines: []journal.Line{
+				withDate(line(2, "ref-1", "1001", "DEBIT", "10.00"), "31/01/2026"),
+				withDate(line(3, "ref-1", "2001", "CREDIT", "10.00"), "31/01/2026"),
+			},
+			transactions: 1,
+			issues:       []issue{{2, subledgerErrors.ErrInvalidEffectiveDate}},
+		},
+		{
+			name: "future effective date",
+			lines: []journal.Line{
+				withDate(line(2, "ref-1", "1001", "DEBIT", "10.00"), "2026-03-01"),
+				withDate(line(3, "ref-1", "2001", "CREDIT", "10.00"), "2026-03-01"),
+			},
+			transactions: 1,
+			issues:       []issue{{2, subledgerErrors.ErrInvalidEffectiveDate}},
+		},
+		{
+			name: "bad entries",
+			lines: []journal.Line{
+				line(2, "ref-1", "1001", "SIDEWAYS", "10.00"),
+				line(3, "ref-1", "", "CREDIT", "10.00"),
+				line(4, "ref-2", "1001", "DEBIT", "ten"),
+				line(5, "ref-2", "2001", "CREDIT", "-10"),
+				line(6, "ref-3", "1001", "DEBIT", "0.001"),
+				line(7, "", "2001", "CREDIT", "1"),
+			},
+			transactions: 4,
+			issues: []issue{
+				{2, subledgerErrors.ErrInvalidDirection},
+				{3, subledgerErrors.ErrInvalidJournal},
+				{4, subledgerErrors.ErrInvalidAmount},
+				{5, subledgerErrors.ErrAmountMustBePositive},
+				{6, subledgerErrors.ErrInvalidAmount},
+				{7, subledgerErrors.ErrInvalidJournal},
+			},
+		},
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	svc := NewService(nil, nil, "", logger)
+	now := time.Date(2026, 2, 15, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, txns := svc.assembleJournal(tt.lines, true, now)
+			sortIssues(report.Issues)
+
+			if !report.DryRun || report.Lines != len(tt.lines) || report.Transactions != tt.transactions || len(txns) != tt.transactions {
+				t.Errorf("report = %+v with %d transactions, want a dry run of %d lines and %d transactions",
+					report, len(txns), len(tt.lines), tt.transactions)
+			}
+			if len(report.Issues) != len(tt.issues) {
+				t.Fatalf("issues = %v, want %v", report.Issues, tt.issues)
+			}
+			for i, want := range tt.issues {
+				got := report.Issues[i]
+				if got.Line != want.line || !errors.Is(got.Err, want.err) {
+					t.Errorf("issue %d = line %d: %v, want line %d: %v", i, got.Line, got.Err, want.line, want.err)
+				}
+			}
+
+			for _, txn := range txns {
+				if !txn.invalid && txn.txn.EffectiveDate.IsZero() {
+					t.Errorf("transaction %s has no effective date", txn.txn.ReferenceID)
+				}
+			}
+		})
+	}
+}
+
+func TestAssembleJournalDefaultsEffectiveDate(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	svc := NewService(nil, nil, "", logger)
+	now := time.Date(2026, 2, 15, 12, 0, 0, 0, time.UTC)
+
+	_, txns := svc.assembleJournal([]journal.Line{
+		line(2, "ref-1", "1001", "DEBIT", "10.00"),
+		line(3, "ref-1", "2001", "CREDIT", "10.00"),
+		withDate(line(4, "ref-2", "1001", "DEBIT", "1.00"), "2026-01-31"),
+		withDate(line(5, "ref-2", "2001", "CREDIT", "1.00"), "2026-01-31"),
+	}, false, now)
+
+	want := []time.Time{
+		time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+	for i, txn := range txns {
+		if !txn.txn.EffectiveDate.Equal(want[i]) {
+			t.Errorf("%s effective %s, want %s", txn.txn.ReferenceID, txn.txn.EffectiveDate, want[i])
+		}
+		if len(txn.txn.Entries) != 2 {
+			t.Errorf("%s has %d entries, want 2", txn.txn.ReferenceID, len(txn.txn.Entries))
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
+	"github.com/ChotongW/grit_demo_wallet/internal/subledger/repository"
+)
+
+// utcDate returns the UTC calendar day of t as a UTC midnight.
+func utcDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// resolveEffectiveDate returns the day a posting made at now counts
+// towards: effectiveDate, or today when it is zero. It may not be later
+// than today.
+func resolveEffectiveDate(effectiveDate, now time.Time) (time.Time, error) {
+	today := utcDate(now)
+	if effectiveDate.IsZero() {
+		return today, nil
+	}
+
+	day := utcDate(effectiveDate)
+	if day.After(today) {
+		return time.Time{}, fmt.Errorf("%w: %s is in the future", subledgerErrors.ErrInvalidEffectiveDate, day.Format("2006-01-02"))
+	}
+	return day, nil
+}
+
+// ParsePeriod parses a period name such as 2026-09.
+func ParsePeriod(name string) (repository.AccountingPeriod, error) {
+	start, err := time.Parse("2006-01", strings.TrimSpace(name))
+	if err != nil {
+		return repository.AccountingPeriod{}, fmt.Errorf("%w: %q", subledgerErrors.ErrInvalidPeriod, name)
+	}
+	return repository.PeriodOf(start), nil
+}
+
+// ClosePeriod closes the month named period once it has ended, snapshotting
+// the closing balance of every account. Nothing can be posted into it or
+// any earlier period afterwards.
+func (s *Service) ClosePeriod(ctx context.Context, name string) (*repository.AccountingPeriod, []repository.ClosingBalance, error) {
+	period, err := ParsePeriod(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if period.EndsOn.After(utcDate(time.Now())) {
+		return nil, nil, fmt.Errorf("%w: %s ends on %s", subledgerErrors.ErrPeriodNotEnded, period.Period, period.EndsOn.AddDate(0, 0, -1).Format("2006-01-02"))
+	}
+
+	return s.repo.ClosePeriod(ctx, period)
+}
+
+func (s *Service) ListAccountingPeriods(ctx context.Context, status string) ([]repository.AccountingPeriod, error) {
+	status = strings.ToUpper(strings.TrimSpace(status))
+	switch status {
+	case "", repository.PeriodOpen, repository.PeriodClosed:
+	default:
+		return nil, fmt.Errorf("%w: status must be OPEN or CLOSED", subledgerErrors.ErrInvalidFilter)
+	}
+
+	return s.repo.ListAccountingPeriods(ctx, status)
+}
+
+func (s *Service) GetClosingBalances(ctx context.Context, name string) (*repository.AccountingPeriod, []repository.ClosingBalance, error) {
+	period, err := ParsePeriod(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.repo.GetClosingBalances(ctx, period.Period)
+}
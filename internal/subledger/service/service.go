@@ -2,8 +2,13 @@ package service
 
 import (
 	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"time"
 
+	subledgerErrors "github.com/ChotongW/grit_demo_wallet/internal/subledger/errors"
 	"github.com/ChotongW/grit_demo_wallet/internal/subledger/repository"
 
 	"github.com/shopspring/decimal"
@@ -15,48 +20,305 @@ var (
 	CREDIT = "CREDIT"
 )
 
+const (
+	ClassAsset     = "ASSET"
+	ClassLiability = "LIABILITY"
+	ClassEquity    = "EQUITY"
+	ClassRevenue   = "REVENUE"
+	ClassExpense   = "EXPENSE"
+)
+
+// normalBalances maps each account class to the direction that increases
+// the balance of accounts in that class.
+var normalBalances = map[string]string{
+	ClassAsset:     DEBIT,
+	ClassExpense:   DEBIT,
+	ClassLiability: CREDIT,
+	ClassEquity:    CREDIT,
+	ClassRevenue:   CREDIT,
+}
+
 type Service struct {
-	repo   *repository.Repository
-	logger logrus.FieldLogger
+	repo *repository.Repository
+	// checkpointKey signs checkpoints of the ledger chain; nil disables them.
+	checkpointKey ed25519.PrivateKey
+	// checkpointFile is where checkpoints are exported to, and read back
+	// from when the chain is verified.
+	checkpointFile string
+	logger         logrus.FieldLogger
 }
 
-func NewService(repo *repository.Repository, logger *logrus.Logger) *Service {
+func NewService(repo *repository.Repository, checkpointKey ed25519.PrivateKey, checkpointFile string, logger *logrus.Logger) *Service {
 	newLogger := logger.WithFields(
 		logrus.Fields{
 			"package": "service",
 		},
 	)
 	return &Service{
-		repo:   repo,
-		logger: newLogger,
+		repo:           repo,
+		checkpointKey:  checkpointKey,
+		checkpointFile: checkpointFile,
+		logger:         newLogger,
 	}
 }
 
-func (s *Service) CreateTransaction(ctx context.Context, refID string, desc string, entries []repository.TransactionEntry) error {
+// CreateTransaction posts balanced entries effective on effectiveDate, or
+// today if it is zero. Back-dated postings are allowed into open periods;
+// postings dated in the future are not.
+func (s *Service) CreateTransaction(ctx context.Context, refID string, desc string, effectiveDate time.Time, entries []repository.TransactionEntry) (string, error) {
+	effectiveDate, err := s.validateTransaction(entries, effectiveDate, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	return s.repo.CreateTransaction(ctx, refID, desc, effectiveDate, entries)
+}
+
+// validateTransaction checks that entries make a balanced double-entry
+// transaction and returns the day it takes effect.
+func (s *Service) validateTransaction(entries []repository.TransactionEntry, effectiveDate, now time.Time) (time.Time, error) {
 	if len(entries) < 2 {
 		s.logger.Errorf("at least 2 entries required for double-entry accounting")
-		return fmt.Errorf("at least 2 entries required for double-entry accounting")
+		return time.Time{}, fmt.Errorf("%w: got %d", subledgerErrors.ErrNotEnoughEntries, len(entries))
 	}
 	totalDebits := decimal.Zero
 	totalCredits := decimal.Zero
 
 	for _, entry := range entries {
+		if entry.Amount.LessThanOrEqual(decimal.Zero) {
+			return time.Time{}, fmt.Errorf("%w: %s for account %s", subledgerErrors.ErrAmountMustBePositive, entry.Amount.String(), entry.AccountID)
+		}
 		if entry.Direction == DEBIT {
 			totalDebits = totalDebits.Add(entry.Amount)
 		} else if entry.Direction == CREDIT {
 			totalCredits = totalCredits.Add(entry.Amount)
 		} else {
-			return fmt.Errorf("invalid direction: %s", entry.Direction)
+			return time.Time{}, fmt.Errorf("%w: %s", subledgerErrors.ErrInvalidDirection, entry.Direction)
 		}
 	}
 
 	if !totalDebits.Equal(totalCredits) {
-		return fmt.Errorf("debits (%s) must equal credits (%s)", totalDebits.String(), totalCredits.String())
+		return time.Time{}, fmt.Errorf("%w: debits (%s), credits (%s)", subledgerErrors.ErrUnbalancedTransaction, totalDebits.String(), totalCredits.String())
 	}
 
-	return s.repo.CreateTransaction(ctx, refID, desc, entries)
+	return resolveEffectiveDate(effectiveDate, now)
 }
 
 func (s *Service) GetBalance(ctx context.Context, accountID string) (decimal.Decimal, error) {
 	return s.repo.GetBalance(ctx, accountID)
 }
+
+// GetBalanceAt returns the balance of accountID just before asOf. Unlike
+// GetBalance it reports zero rather than ErrBalanceNotFound for an account
+// that has never been posted to.
+func (s *Service) GetBalanceAt(ctx context.Context, accountID string, asOf time.Time) (decimal.Decimal, error) {
+	return s.repo.GetBalanceAt(ctx, accountID, asOf)
+}
+
+// maxBalancesPerCall caps the accounts GetBalances looks up at once.
+const maxBalancesPerCall = 1000
+
+// GetBalances returns the balance of each of accountIDs, just before asOf if
+// it is set. Accounts never posted to have a zero balance.
+func (s *Service) GetBalances(ctx context.Context, accountIDs []string, asOf *time.Time) (map[string]decimal.Decimal, error) {
+	if len(accountIDs) > maxBalancesPerCall {
+		return nil, fmt.Errorf("%w: at most %d account_ids, got %d", subledgerErrors.ErrInvalidFilter, maxBalancesPerCall, len(accountIDs))
+	}
+	if len(accountIDs) == 0 {
+		return map[string]decimal.Decimal{}, nil
+	}
+	return s.repo.GetBalances(ctx, accountIDs, asOf)
+}
+
+// exportBatchSize is how many rows ExportEntries reads per query.
+const exportBatchSize = 500
+
+// validateEntryFilter rejects listings that no index can serve, which would
+// otherwise scan the whole ledger.
+func validateEntryFilter(filter repository.EntryFilter) error {
+	if filter.AccountID == "" && filter.TransactionID == "" && filter.ReferenceID == "" &&
+		filter.From == nil && filter.To == nil && filter.EffectiveFrom == nil && filter.EffectiveTo == nil {
+		return fmt.Errorf("%w: one of account_id, transaction_id, reference_id, from or to is required", subledgerErrors.ErrInvalidFilter)
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return fmt.Errorf("%w: from must be before to", subledgerErrors.ErrInvalidFilter)
+	}
+	return nil
+}
+
+func (s *Service) ListEntriesPage(ctx context.Context, filter repository.EntryFilter, page, pageSize int) ([]repository.LedgerEntry, int, error) {
+	if err := validateEntryFilter(filter); err != nil {
+		return nil, 0, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return s.repo.ListEntriesPage(ctx, filter, page, pageSize)
+}
+
+// ListEntries returns the entries that follow cursor (an empty cursor starts
+// from the newest entry, or the oldest when ascending), together with the
+// cursor for the next page. The returned cursor is empty when there are no
+// more rows.
+func (s *Service) ListEntries(ctx context.Context, filter repository.EntryFilter, cursor string, pageSize int, ascending bool) ([]repository.LedgerEntry, string, error) {
+	if err := validateEntryFilter(filter); err != nil {
+		return nil, "", err
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	var after *repository.EntryCursor
+	if cursor != "" {
+		decoded, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %v", subledgerErrors.ErrInvalidCursor, err)
+		}
+		after = decoded
+	}
+
+	entries, err := s.repo.ListEntries(ctx, filter, after, pageSize+1, ascending)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(entries) <= pageSize {
+		return entries, "", nil
+	}
+
+	entries = entries[:pageSize]
+	last := entries[len(entries)-1]
+	next := encodeCursor(repository.EntryCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+
+	return entries, next, nil
+}
+
+// ExportEntries calls fn for every entry matching filter, reading the ledger
+// in keyset batches so memory use does not grow with the result size. It
+// stops at the first error returned by fn.
+func (s *Service) ExportEntries(ctx context.Context, filter repository.EntryFilter, ascending bool, fn func(repository.LedgerEntry) error) (int, error) {
+	if err := validateEntryFilter(filter); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	var after *repository.EntryCursor
+	for {
+		entries, err := s.repo.ListEntries(ctx, filter, after, exportBatchSize, ascending)
+		if err != nil {
+			return count, err
+		}
+
+		for _, entry := range entries {
+			if err := fn(entry); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		if len(entries) < exportBatchSize {
+			return count, nil
+		}
+		last := entries[len(entries)-1]
+		after = &repository.EntryCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+}
+
+func encodeCursor(c repository.EntryCursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(cursor string) (*repository.EntryCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var c repository.EntryCursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, err
+	}
+	if c.ID == "" || c.CreatedAt.IsZero() {
+		return nil, fmt.Errorf("incomplete cursor")
+	}
+
+	return &c, nil
+}
+
+// GetTransaction returns every leg of a transaction along with the ids of
+// any transactions that reversed it.
+func (s *Service) GetTransaction(ctx context.Context, transactionID string) (*repository.Transaction, []string, error) {
+	txn, err := s.repo.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reversedBy, err := s.repo.GetReversals(ctx, transactionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return txn, reversedBy, nil
+}
+
+// CreateLedgerAccount adds an account to the chart of accounts. The normal
+// balance is derived from the class, and a parent must already exist and
+// belong to the same class.
+func (s *Service) CreateLedgerAccount(ctx context.Context, account repository.LedgerAccount) (*repository.LedgerAccount, error) {
+	if account.AccountID == "" || account.Name == "" {
+		return nil, fmt.Errorf("%w: account_id and name are required", subledgerErrors.ErrInvalidLedgerAccount)
+	}
+
+	normalBalance, ok := normalBalances[account.Class]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", subledgerErrors.ErrInvalidAccountClass, account.Class)
+	}
+	account.NormalBalance = normalBalance
+
+	if account.ParentAccountID != nil {
+		parent, err := s.repo.GetLedgerAccount(ctx, *account.ParentAccountID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return nil, fmt.Errorf("%w: %s does not exist", subledgerErrors.ErrInvalidParentAccount, *account.ParentAccountID)
+		}
+		if parent.Class != account.Class {
+			return nil, fmt.Errorf("%w: %s is %s, not %s", subledgerErrors.ErrInvalidParentAccount, parent.AccountID, parent.Class, account.Class)
+		}
+	}
+
+	return s.repo.CreateLedgerAccount(ctx, account)
+}
+
+func (s *Service) GetLedgerAccount(ctx context.Context, accountID string) (*repository.LedgerAccount, error) {
+	account, err := s.repo.GetLedgerAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, fmt.Errorf("%w: %s", subledgerErrors.ErrLedgerAccountNotFound, accountID)
+	}
+	return account, nil
+}
+
+func (s *Service) ListLedgerAccounts(ctx context.Context, class, parentAccountID string, page, pageSize int) ([]repository.LedgerAccount, int, error) {
+	if class != "" {
+		if _, ok := normalBalances[class]; !ok {
+			return nil, 0, fmt.Errorf("%w: %q", subledgerErrors.ErrInvalidAccountClass, class)
+		}
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return s.repo.ListLedgerAccounts(ctx, class, parentAccountID, page, pageSize)
+}
@@ -543,6 +543,7 @@ type WithdrawResponse struct {
 	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 	NewBalance    string                 `protobuf:"bytes,3,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
 	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Fee           *FeeBreakdown          `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -605,12 +606,22 @@ func (x *WithdrawResponse) GetMessage() string {
 	return ""
 }
 
+func (x *WithdrawResponse) GetFee() *FeeBreakdown {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+// TransferRequest names the receiving account either by to_account_id or
+// by recipient, an email or @handle, but not both.
 type TransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FromAccountId string                 `protobuf:"bytes,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
 	ToAccountId   string                 `protobuf:"bytes,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
 	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Recipient     string                 `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -673,12 +684,21 @@ func (x *TransferRequest) GetDescription() string {
 	return ""
 }
 
+func (x *TransferRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
 type TransferResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 	NewBalance    string                 `protobuf:"bytes,3,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
 	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Fee           *FeeBreakdown          `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Recipient     *Recipient             `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"` // set when the request named a recipient
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -741,29 +761,46 @@ func (x *TransferResponse) GetMessage() string {
 	return ""
 }
 
-type GetTransactionHistoryRequest struct {
+func (x *TransferResponse) GetFee() *FeeBreakdown {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *TransferResponse) GetRecipient() *Recipient {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+// Recipient is what a sender is shown to confirm who they are paying. It
+// deliberately leaves out the account id and the full email.
+type Recipient struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // EMAIL or HANDLE, how it was looked up
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	MaskedEmail   string                 `protobuf:"bytes,3,opt,name=masked_email,json=maskedEmail,proto3" json:"masked_email,omitempty"`
+	Handle        string                 `protobuf:"bytes,4,opt,name=handle,proto3" json:"handle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionHistoryRequest) Reset() {
-	*x = GetTransactionHistoryRequest{}
+func (x *Recipient) Reset() {
+	*x = Recipient{}
 	mi := &file_accounts_accounts_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionHistoryRequest) String() string {
+func (x *Recipient) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionHistoryRequest) ProtoMessage() {}
+func (*Recipient) ProtoMessage() {}
 
-func (x *GetTransactionHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *Recipient) ProtoReflect() protoreflect.Message {
 	mi := &file_accounts_accounts_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -775,57 +812,60 @@ func (x *GetTransactionHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Recipient.ProtoReflect.Descriptor instead.
+func (*Recipient) Descriptor() ([]byte, []int) {
 	return file_accounts_accounts_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetTransactionHistoryRequest) GetAccountId() string {
+func (x *Recipient) GetType() string {
 	if x != nil {
-		return x.AccountId
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetTransactionHistoryRequest) GetPage() int32 {
+func (x *Recipient) GetDisplayName() string {
 	if x != nil {
-		return x.Page
+		return x.DisplayName
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTransactionHistoryRequest) GetPageSize() int32 {
+func (x *Recipient) GetMaskedEmail() string {
 	if x != nil {
-		return x.PageSize
+		return x.MaskedEmail
 	}
-	return 0
+	return ""
 }
 
-type GetTransactionHistoryResponse struct {
+func (x *Recipient) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+type LookupRecipientRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"` // email or @handle
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionHistoryResponse) Reset() {
-	*x = GetTransactionHistoryResponse{}
+func (x *LookupRecipientRequest) Reset() {
+	*x = LookupRecipientRequest{}
 	mi := &file_accounts_accounts_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionHistoryResponse) String() string {
+func (x *LookupRecipientRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionHistoryResponse) ProtoMessage() {}
+func (*LookupRecipientRequest) ProtoMessage() {}
 
-func (x *GetTransactionHistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *LookupRecipientRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_accounts_accounts_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -837,74 +877,85 @@ func (x *GetTransactionHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionHistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LookupRecipientRequest.ProtoReflect.Descriptor instead.
+func (*LookupRecipientRequest) Descriptor() ([]byte, []int) {
 	return file_accounts_accounts_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetTransactionHistoryResponse) GetTransactions() []*Transaction {
+func (x *LookupRecipientRequest) GetRecipient() string {
 	if x != nil {
-		return x.Transactions
+		return x.Recipient
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTransactionHistoryResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
+type LookupRecipientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipient     *Recipient             `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionHistoryResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+func (x *LookupRecipientResponse) Reset() {
+	*x = LookupRecipientResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionHistoryResponse) GetPageSize() int32 {
+func (x *LookupRecipientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupRecipientResponse) ProtoMessage() {}
+
+func (x *LookupRecipientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[14]
 	if x != nil {
-		return x.PageSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetTransactionHistoryResponse) GetTotalPages() int32 {
+// Deprecated: Use LookupRecipientResponse.ProtoReflect.Descriptor instead.
+func (*LookupRecipientResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LookupRecipientResponse) GetRecipient() *Recipient {
 	if x != nil {
-		return x.TotalPages
+		return x.Recipient
 	}
-	return 0
+	return nil
 }
 
-type Account struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	AccountId         string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	AccountType       string                 `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
-	UserId            *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
-	Email             *string                `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	ReferrerAccountId *string                `protobuf:"bytes,5,opt,name=referrer_account_id,json=referrerAccountId,proto3,oneof" json:"referrer_account_id,omitempty"`
-	Balance           string                 `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
-	CreatedAt         string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type SetHandleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Handle        string                 `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"` // empty removes the handle
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Account) Reset() {
-	*x = Account{}
-	mi := &file_accounts_accounts_proto_msgTypes[14]
+func (x *SetHandleRequest) Reset() {
+	*x = SetHandleRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Account) String() string {
+func (x *SetHandleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account) ProtoMessage() {}
+func (*SetHandleRequest) ProtoMessage() {}
 
-func (x *Account) ProtoReflect() protoreflect.Message {
-	mi := &file_accounts_accounts_proto_msgTypes[14]
+func (x *SetHandleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -915,89 +966,93 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account.ProtoReflect.Descriptor instead.
-func (*Account) Descriptor() ([]byte, []int) {
-	return file_accounts_accounts_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SetHandleRequest.ProtoReflect.Descriptor instead.
+func (*SetHandleRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Account) GetAccountId() string {
+func (x *SetHandleRequest) GetAccountId() string {
 	if x != nil {
 		return x.AccountId
 	}
 	return ""
 }
 
-func (x *Account) GetAccountType() string {
+func (x *SetHandleRequest) GetHandle() string {
 	if x != nil {
-		return x.AccountType
+		return x.Handle
 	}
 	return ""
 }
 
-func (x *Account) GetUserId() string {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
-	}
-	return ""
+type SetHandleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Account) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
-	}
-	return ""
+func (x *SetHandleResponse) Reset() {
+	*x = SetHandleResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Account) GetReferrerAccountId() string {
-	if x != nil && x.ReferrerAccountId != nil {
-		return *x.ReferrerAccountId
-	}
-	return ""
+func (x *SetHandleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Account) GetBalance() string {
+func (*SetHandleResponse) ProtoMessage() {}
+
+func (x *SetHandleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[16]
 	if x != nil {
-		return x.Balance
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Account) GetCreatedAt() string {
+// Deprecated: Use SetHandleResponse.ProtoReflect.Descriptor instead.
+func (*SetHandleResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetHandleResponse) GetAccount() *Account {
 	if x != nil {
-		return x.CreatedAt
+		return x.Account
 	}
-	return ""
+	return nil
 }
 
-type Transaction struct {
+type FeeItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	AccountId     string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Direction     string                 `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
-	ReferenceId   string                 `protobuf:"bytes,6,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // FLAT, PERCENTAGE, MINIMUM_FEE or FEE_CAP
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // negative for FEE_CAP
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
-	mi := &file_accounts_accounts_proto_msgTypes[15]
+func (x *FeeItem) Reset() {
+	*x = FeeItem{}
+	mi := &file_accounts_accounts_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) String() string {
+func (x *FeeItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*FeeItem) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_accounts_accounts_proto_msgTypes[15]
+func (x *FeeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1008,89 +1063,6337 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_accounts_accounts_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use FeeItem.ProtoReflect.Descriptor instead.
+func (*FeeItem) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Transaction) GetId() string {
+func (x *FeeItem) GetCode() string {
 	if x != nil {
-		return x.Id
+		return x.Code
 	}
 	return ""
 }
 
-func (x *Transaction) GetTransactionId() string {
+func (x *FeeItem) GetDescription() string {
 	if x != nil {
-		return x.TransactionId
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Transaction) GetAccountId() string {
+func (x *FeeItem) GetAmount() string {
 	if x != nil {
-		return x.AccountId
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *Transaction) GetAmount() string {
+// FeeBreakdown is the fee for one operation. The items sum to fee, and
+// total_debit (amount + fee) is what the paying account is charged.
+type FeeBreakdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee           string                 `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	TotalDebit    string                 `protobuf:"bytes,4,opt,name=total_debit,json=totalDebit,proto3" json:"total_debit,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Items         []*FeeItem             `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeBreakdown) Reset() {
+	*x = FeeBreakdown{}
+	mi := &file_accounts_accounts_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeBreakdown) ProtoMessage() {}
+
+func (x *FeeBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[18]
 	if x != nil {
-		return x.Amount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeBreakdown.ProtoReflect.Descriptor instead.
+func (*FeeBreakdown) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FeeBreakdown) GetOperation() string {
+	if x != nil {
+		return x.Operation
 	}
 	return ""
 }
 
-func (x *Transaction) GetDirection() string {
+func (x *FeeBreakdown) GetAmount() string {
 	if x != nil {
-		return x.Direction
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *Transaction) GetReferenceId() string {
+func (x *FeeBreakdown) GetFee() string {
 	if x != nil {
-		return x.ReferenceId
+		return x.Fee
 	}
 	return ""
 }
 
-func (x *Transaction) GetDescription() string {
+func (x *FeeBreakdown) GetTotalDebit() string {
 	if x != nil {
-		return x.Description
+		return x.TotalDebit
 	}
 	return ""
 }
 
-func (x *Transaction) GetCreatedAt() string {
+func (x *FeeBreakdown) GetCurrency() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Currency
 	}
 	return ""
 }
 
-var File_accounts_accounts_proto protoreflect.FileDescriptor
+func (x *FeeBreakdown) GetItems() []*FeeItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
 
-const file_accounts_accounts_proto_rawDesc = "" +
-	"\n" +
-	"\x17accounts/accounts.proto\x12\baccounts\"\x85\x01\n" +
-	"\x14CreateAccountRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12'\n" +
-	"\x0finitial_balance\x18\x02 \x01(\tR\x0einitialBalance\x12.\n" +
-	"\x13referrer_account_id\x18\x03 \x01(\tR\x11referrerAccountId\"\x97\x01\n" +
-	"\x15CreateAccountResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x02 \x01(\tR\taccountId\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\x12+\n" +
-	"\aaccount\x18\x04 \x01(\v2\x11.accounts.AccountR\aaccount\"2\n" +
-	"\x11GetAccountRequest\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\"A\n" +
-	"\x12GetAccountResponse\x12+\n" +
-	"\aaccount\x18\x01 \x01(\v2\x11.accounts.AccountR\aaccount\"2\n" +
-	"\x11GetBalanceRequest\x12\x1d\n" +
-	"\n" +
+type QuoteFeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // the paying account
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`                  // WITHDRAW or TRANSFER
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteFeeRequest) Reset() {
+	*x = QuoteFeeRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteFeeRequest) ProtoMessage() {}
+
+func (x *QuoteFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteFeeRequest.ProtoReflect.Descriptor instead.
+func (*QuoteFeeRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QuoteFeeRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *QuoteFeeRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *QuoteFeeRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type QuoteFeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fee           *FeeBreakdown          `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteFeeResponse) Reset() {
+	*x = QuoteFeeResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteFeeResponse) ProtoMessage() {}
+
+func (x *QuoteFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteFeeResponse.ProtoReflect.Descriptor instead.
+func (*QuoteFeeResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QuoteFeeResponse) GetFee() *FeeBreakdown {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+// GetTransactionHistoryRequest pages by page number (page defaults to 1)
+// unless a cursor is given or use_cursor is set. To start a cursor listing
+// set use_cursor with an empty cursor, then pass back next_cursor until
+// has_more is false.
+type GetTransactionHistoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountId       string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Page            int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor          string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	From            string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`           // RFC 3339, inclusive
+	To              string                 `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`               // RFC 3339, exclusive
+	Direction       string                 `protobuf:"bytes,7,opt,name=direction,proto3" json:"direction,omitempty"` // DEBIT or CREDIT
+	MinAmount       string                 `protobuf:"bytes,8,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount       string                 `protobuf:"bytes,9,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	ReferencePrefix string                 `protobuf:"bytes,10,opt,name=reference_prefix,json=referencePrefix,proto3" json:"reference_prefix,omitempty"`
+	Search          string                 `protobuf:"bytes,11,opt,name=search,proto3" json:"search,omitempty"` // case-insensitive match on description
+	UseCursor       bool                   `protobuf:"varint,12,opt,name=use_cursor,json=useCursor,proto3" json:"use_cursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTransactionHistoryRequest) Reset() {
+	*x = GetTransactionHistoryRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionHistoryRequest) ProtoMessage() {}
+
+func (x *GetTransactionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTransactionHistoryRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTransactionHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTransactionHistoryRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetMaxAmount() string {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetReferencePrefix() string {
+	if x != nil {
+		return x.ReferencePrefix
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetUseCursor() bool {
+	if x != nil {
+		return x.UseCursor
+	}
+	return false
+}
+
+type GetTransactionHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,7,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionHistoryResponse) Reset() {
+	*x = GetTransactionHistoryResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionHistoryResponse) ProtoMessage() {}
+
+func (x *GetTransactionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetTransactionHistoryResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetTransactionHistoryResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetTransactionHistoryResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTransactionHistoryResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTransactionHistoryResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *GetTransactionHistoryResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type Account struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AccountId         string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountType       string                 `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	UserId            *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Email             *string                `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	ReferrerAccountId *string                `protobuf:"bytes,5,opt,name=referrer_account_id,json=referrerAccountId,proto3,oneof" json:"referrer_account_id,omitempty"`
+	Balance           string                 `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Handle            *string                `protobuf:"bytes,8,opt,name=handle,proto3,oneof" json:"handle,omitempty"`
+	Name              string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
+	Currency          string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	IsDefault         bool                   `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_accounts_accounts_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Account) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Account) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *Account) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *Account) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *Account) GetReferrerAccountId() string {
+	if x != nil && x.ReferrerAccountId != nil {
+		return *x.ReferrerAccountId
+	}
+	return ""
+}
+
+func (x *Account) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *Account) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Account) GetHandle() string {
+	if x != nil && x.Handle != nil {
+		return *x.Handle
+	}
+	return ""
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Account) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type OpenWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`         // e.g. Savings; unique per user
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // defaults to USD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenWalletRequest) Reset() {
+	*x = OpenWalletRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenWalletRequest) ProtoMessage() {}
+
+func (x *OpenWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenWalletRequest.ProtoReflect.Descriptor instead.
+func (*OpenWalletRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OpenWalletRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OpenWalletRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OpenWalletRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type OpenWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenWalletResponse) Reset() {
+	*x = OpenWalletResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenWalletResponse) ProtoMessage() {}
+
+func (x *OpenWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenWalletResponse.ProtoReflect.Descriptor instead.
+func (*OpenWalletResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OpenWalletResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type ListAccountsForUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsForUserRequest) Reset() {
+	*x = ListAccountsForUserRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsForUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsForUserRequest) ProtoMessage() {}
+
+func (x *ListAccountsForUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsForUserRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsForUserRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListAccountsForUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListAccountsForUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"` // the default wallet first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsForUserResponse) Reset() {
+	*x = ListAccountsForUserResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsForUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsForUserResponse) ProtoMessage() {}
+
+func (x *ListAccountsForUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsForUserResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsForUserResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListAccountsForUserResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type SetDefaultWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultWalletRequest) Reset() {
+	*x = SetDefaultWalletRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultWalletRequest) ProtoMessage() {}
+
+func (x *SetDefaultWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultWalletRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultWalletRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetDefaultWalletRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetDefaultWalletRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type SetDefaultWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultWalletResponse) Reset() {
+	*x = SetDefaultWalletResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultWalletResponse) ProtoMessage() {}
+
+func (x *SetDefaultWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultWalletResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultWalletResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetDefaultWalletResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Phone         *string                `protobuf:"bytes,4,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Address       *string                `protobuf:"bytes,5,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Locale        *string                `protobuf:"bytes,6,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_accounts_accounts_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *User) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *User) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *User) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *User) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// UpdateProfileRequest changes the fields that are set; an empty string
+// clears a field.
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Phone         *string                `protobuf:"bytes,3,opt,name=phone,proto3,oneof" json:"phone,omitempty"` // E.164, e.g. +66812345678
+	Address       *string                `protobuf:"bytes,4,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Locale        *string                `protobuf:"bytes,5,opt,name=locale,proto3,oneof" json:"locale,omitempty"` // e.g. en or th-TH
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateProfileResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type RequestEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewEmail      string                 `protobuf:"bytes,2,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeRequest) Reset() {
+	*x = RequestEmailChangeRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeRequest) ProtoMessage() {}
+
+func (x *RequestEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RequestEmailChangeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+type RequestEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresAt     string                 `protobuf:"bytes,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // when the token sent to new_email expires
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeResponse) Reset() {
+	*x = RequestEmailChangeResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeResponse) ProtoMessage() {}
+
+func (x *RequestEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RequestEmailChangeResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ConfirmEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeRequest) Reset() {
+	*x = ConfirmEmailChangeRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ConfirmEmailChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ConfirmEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeResponse) Reset() {
+	*x = ConfirmEmailChangeResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeResponse) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ConfirmEmailChangeResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type NotificationPreferences struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Channels            []string               `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"` // EMAIL, SMS, PUSH
+	PushToken           *string                `protobuf:"bytes,3,opt,name=push_token,json=pushToken,proto3,oneof" json:"push_token,omitempty"`
+	LowBalanceThreshold *string                `protobuf:"bytes,4,opt,name=low_balance_threshold,json=lowBalanceThreshold,proto3,oneof" json:"low_balance_threshold,omitempty"`
+	UpdatedAt           string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // empty until preferences are first set
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_accounts_accounts_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NotificationPreferences) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetPushToken() string {
+	if x != nil && x.PushToken != nil {
+		return *x.PushToken
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetLowBalanceThreshold() string {
+	if x != nil && x.LowBalanceThreshold != nil {
+		return *x.LowBalanceThreshold
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetNotificationPreferencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// SetNotificationPreferencesRequest replaces all preferences of the user.
+type SetNotificationPreferencesRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Channels            []string               `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	PushToken           *string                `protobuf:"bytes,3,opt,name=push_token,json=pushToken,proto3,oneof" json:"push_token,omitempty"`
+	LowBalanceThreshold *string                `protobuf:"bytes,4,opt,name=low_balance_threshold,json=lowBalanceThreshold,proto3,oneof" json:"low_balance_threshold,omitempty"` // unset disables the alert
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SetNotificationPreferencesRequest) Reset() {
+	*x = SetNotificationPreferencesRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *SetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetNotificationPreferencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetNotificationPreferencesRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *SetNotificationPreferencesRequest) GetPushToken() string {
+	if x != nil && x.PushToken != nil {
+		return *x.PushToken
+	}
+	return ""
+}
+
+func (x *SetNotificationPreferencesRequest) GetLowBalanceThreshold() string {
+	if x != nil && x.LowBalanceThreshold != nil {
+		return *x.LowBalanceThreshold
+	}
+	return ""
+}
+
+type NotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferencesResponse) Reset() {
+	*x = NotificationPreferencesResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *NotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*NotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *NotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Event         string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"` // DEPOSIT, WITHDRAWAL, TRANSFER_IN, TRANSFER_OUT, REFERRAL_REWARD or LOW_BALANCE
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	TransactionId string                 `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body          string                 `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // PENDING, SENT or FAILED
+	Attempts      int32                  `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SentAt        *string                `protobuf:"bytes,11,opt,name=sent_at,json=sentAt,proto3,oneof" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_accounts_accounts_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Notification) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Notification) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Notification) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Notification) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Notification) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Notification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Notification) GetSentAt() string {
+	if x != nil && x.SentAt != nil {
+		return *x.SentAt
+	}
+	return ""
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListNotificationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListNotificationsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNotificationsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"` // transaction.posted, account.created
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_accounts_accounts_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type RegisterWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyId      string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterWebhookRequest) Reset() {
+	*x = RegisterWebhookRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWebhookRequest) ProtoMessage() {}
+
+func (x *RegisterWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RegisterWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RegisterWebhookRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+func (x *RegisterWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RegisterWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *RegisterWebhookRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// RegisterWebhookResponse carries the signing secret, which is not
+// returned again.
+type RegisterWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterWebhookResponse) Reset() {
+	*x = RegisterWebhookResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWebhookResponse) ProtoMessage() {}
+
+func (x *RegisterWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RegisterWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RegisterWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *RegisterWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyId      string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListWebhooksRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyId      string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	WebhookId     string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteWebhookRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+func (x *DeleteWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{52}
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId      string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // the same for every delivery and replay of an event
+	Event          string                 `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	Payload        string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"` // JSON, as sent
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`   // PENDING, DELIVERED or DEAD
+	Attempts       int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt  string                 `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastStatusCode *int32                 `protobuf:"varint,9,opt,name=last_status_code,json=lastStatusCode,proto3,oneof" json:"last_status_code,omitempty"`
+	LastError      *string                `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	ReplayOf       *string                `protobuf:"bytes,11,opt,name=replay_of,json=replayOf,proto3,oneof" json:"replay_of,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt    *string                `protobuf:"bytes,13,opt,name=delivered_at,json=deliveredAt,proto3,oneof" json:"delivered_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_accounts_accounts_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil && x.LastStatusCode != nil {
+		return *x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetReplayOf() string {
+	if x != nil && x.ReplayOf != nil {
+		return *x.ReplayOf
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() string {
+	if x != nil && x.DeliveredAt != nil {
+		return *x.DeliveredAt
+	}
+	return ""
+}
+
+type WebhookAttempt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attempt       int32                  `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	StatusCode    *int32                 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3,oneof" json:"status_code,omitempty"` // unset when the endpoint could not be reached
+	Error         *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	AttemptedAt   string                 `protobuf:"bytes,6,opt,name=attempted_at,json=attemptedAt,proto3" json:"attempted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookAttempt) Reset() {
+	*x = WebhookAttempt{}
+	mi := &file_accounts_accounts_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookAttempt) ProtoMessage() {}
+
+func (x *WebhookAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookAttempt.ProtoReflect.Descriptor instead.
+func (*WebhookAttempt) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *WebhookAttempt) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *WebhookAttempt) GetStatusCode() int32 {
+	if x != nil && x.StatusCode != nil {
+		return *x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookAttempt) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *WebhookAttempt) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *WebhookAttempt) GetAttemptedAt() string {
+	if x != nil {
+		return x.AttemptedAt
+	}
+	return ""
+}
+
+// ListWebhookDeliveriesRequest lists deliveries to the endpoints of
+// api_key_id, optionally of one endpoint and in one status; DEAD is the
+// dead-letter list.
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyId      string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	WebhookId     string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyId      string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	DeliveryId    string                 `protobuf:"bytes,2,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookDeliveryRequest) Reset() {
+	*x = GetWebhookDeliveryRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *GetWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetWebhookDeliveryRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+func (x *GetWebhookDeliveryRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+type GetWebhookDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	Attempts      []*WebhookAttempt      `protobuf:"bytes,2,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookDeliveryResponse) Reset() {
+	*x = GetWebhookDeliveryResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *GetWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetWebhookDeliveryResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *GetWebhookDeliveryResponse) GetAttempts() []*WebhookAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+type ReplayWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyId      string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	DeliveryId    string                 `protobuf:"bytes,2,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookDeliveryRequest) Reset() {
+	*x = ReplayWebhookDeliveryRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *ReplayWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReplayWebhookDeliveryRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+func (x *ReplayWebhookDeliveryRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+type ReplayWebhookDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookDeliveryResponse) Reset() {
+	*x = ReplayWebhookDeliveryResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *ReplayWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReplayWebhookDeliveryResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+type Transaction struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId  string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AccountId      string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount         string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Direction      string                 `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	ReferenceId    string                 `protobuf:"bytes,6,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Description    string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Counterparties []*Counterparty        `protobuf:"bytes,9,rep,name=counterparties,proto3" json:"counterparties,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_accounts_accounts_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Transaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Transaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Transaction) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Transaction) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Transaction) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *Transaction) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *Transaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Transaction) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Transaction) GetCounterparties() []*Counterparty {
+	if x != nil {
+		return x.Counterparties
+	}
+	return nil
+}
+
+// Counterparty is the other side of a posting as seen from one account.
+// Emails are always masked.
+type Counterparty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountType   string                 `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	MaskedEmail   string                 `protobuf:"bytes,3,opt,name=masked_email,json=maskedEmail,proto3" json:"masked_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Counterparty) Reset() {
+	*x = Counterparty{}
+	mi := &file_accounts_accounts_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Counterparty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counterparty) ProtoMessage() {}
+
+func (x *Counterparty) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counterparty.ProtoReflect.Descriptor instead.
+func (*Counterparty) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Counterparty) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Counterparty) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *Counterparty) GetMaskedEmail() string {
+	if x != nil {
+		return x.MaskedEmail
+	}
+	return ""
+}
+
+// GetTransactionRequest looks up a posted transaction, which must have a leg
+// on account_id, otherwise NotFound is returned. account_id is required.
+type GetTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetTransactionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *GetTransactionRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type TransactionLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Direction     string                 `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionLeg) Reset() {
+	*x = TransactionLeg{}
+	mi := &file_accounts_accounts_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionLeg) ProtoMessage() {}
+
+func (x *TransactionLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionLeg.ProtoReflect.Descriptor instead.
+func (*TransactionLeg) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *TransactionLeg) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransactionLeg) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *TransactionLeg) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransactionLeg) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type TransactionDetail struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId            string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ReferenceId              string                 `protobuf:"bytes,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Description              string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt                string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Legs                     []*TransactionLeg      `protobuf:"bytes,5,rep,name=legs,proto3" json:"legs,omitempty"`
+	Counterparties           []*Counterparty        `protobuf:"bytes,6,rep,name=counterparties,proto3" json:"counterparties,omitempty"`
+	ReversesTransactionId    string                 `protobuf:"bytes,7,opt,name=reverses_transaction_id,json=reversesTransactionId,proto3" json:"reverses_transaction_id,omitempty"`
+	ReversedByTransactionIds []string               `protobuf:"bytes,8,rep,name=reversed_by_transaction_ids,json=reversedByTransactionIds,proto3" json:"reversed_by_transaction_ids,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *TransactionDetail) Reset() {
+	*x = TransactionDetail{}
+	mi := &file_accounts_accounts_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionDetail) ProtoMessage() {}
+
+func (x *TransactionDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionDetail.ProtoReflect.Descriptor instead.
+func (*TransactionDetail) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TransactionDetail) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetLegs() []*TransactionLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *TransactionDetail) GetCounterparties() []*Counterparty {
+	if x != nil {
+		return x.Counterparties
+	}
+	return nil
+}
+
+func (x *TransactionDetail) GetReversesTransactionId() string {
+	if x != nil {
+		return x.ReversesTransactionId
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetReversedByTransactionIds() []string {
+	if x != nil {
+		return x.ReversedByTransactionIds
+	}
+	return nil
+}
+
+type GetTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *TransactionDetail     `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponse) ProtoMessage() {}
+
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetTransactionResponse) GetTransaction() *TransactionDetail {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type GenerateStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"` // calendar month, YYYY-MM
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // csv, json or pdf
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateStatementRequest) Reset() {
+	*x = GenerateStatementRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateStatementRequest) ProtoMessage() {}
+
+func (x *GenerateStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateStatementRequest.ProtoReflect.Descriptor instead.
+func (*GenerateStatementRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GenerateStatementRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GenerateStatementRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GenerateStatementRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// StatementChunk carries the rendered statement. content_type and filename
+// are only set on the first chunk.
+type StatementChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatementChunk) Reset() {
+	*x = StatementChunk{}
+	mi := &file_accounts_accounts_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatementChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementChunk) ProtoMessage() {}
+
+func (x *StatementChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementChunk.ProtoReflect.Descriptor instead.
+func (*StatementChunk) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *StatementChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *StatementChunk) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *StatementChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SystemAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemAccount) Reset() {
+	*x = SystemAccount{}
+	mi := &file_accounts_accounts_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemAccount) ProtoMessage() {}
+
+func (x *SystemAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemAccount.ProtoReflect.Descriptor instead.
+func (*SystemAccount) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SystemAccount) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SystemAccount) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SystemAccount) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SystemAccount) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListSystemAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSystemAccountsRequest) Reset() {
+	*x = ListSystemAccountsRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSystemAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSystemAccountsRequest) ProtoMessage() {}
+
+func (x *ListSystemAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSystemAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListSystemAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{70}
+}
+
+type ListSystemAccountsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SystemAccounts []*SystemAccount       `protobuf:"bytes,1,rep,name=system_accounts,json=systemAccounts,proto3" json:"system_accounts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSystemAccountsResponse) Reset() {
+	*x = ListSystemAccountsResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSystemAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSystemAccountsResponse) ProtoMessage() {}
+
+func (x *ListSystemAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSystemAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListSystemAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListSystemAccountsResponse) GetSystemAccounts() []*SystemAccount {
+	if x != nil {
+		return x.SystemAccounts
+	}
+	return nil
+}
+
+// SetSystemAccountRequest points a role at an account. An account_id that
+// does not exist yet is created as a SYSTEM account and registered in the
+// subledger's chart of accounts with the class the role requires; an
+// existing one must already have that class there.
+type SetSystemAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSystemAccountRequest) Reset() {
+	*x = SetSystemAccountRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSystemAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSystemAccountRequest) ProtoMessage() {}
+
+func (x *SetSystemAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSystemAccountRequest.ProtoReflect.Descriptor instead.
+func (*SetSystemAccountRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SetSystemAccountRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SetSystemAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SetSystemAccountRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SetSystemAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemAccount *SystemAccount         `protobuf:"bytes,1,opt,name=system_account,json=systemAccount,proto3" json:"system_account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSystemAccountResponse) Reset() {
+	*x = SetSystemAccountResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSystemAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSystemAccountResponse) ProtoMessage() {}
+
+func (x *SetSystemAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSystemAccountResponse.ProtoReflect.Descriptor instead.
+func (*SetSystemAccountResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SetSystemAccountResponse) GetSystemAccount() *SystemAccount {
+	if x != nil {
+		return x.SystemAccount
+	}
+	return nil
+}
+
+type RunInterestAccrualRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD (UTC), must be before today
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunInterestAccrualRequest) Reset() {
+	*x = RunInterestAccrualRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunInterestAccrualRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunInterestAccrualRequest) ProtoMessage() {}
+
+func (x *RunInterestAccrualRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunInterestAccrualRequest.ProtoReflect.Descriptor instead.
+func (*RunInterestAccrualRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RunInterestAccrualRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type RunInterestAccrualResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	AccountsCount int32                  `protobuf:"varint,2,opt,name=accounts_count,json=accountsCount,proto3" json:"accounts_count,omitempty"`
+	TotalAccrued  string                 `protobuf:"bytes,3,opt,name=total_accrued,json=totalAccrued,proto3" json:"total_accrued,omitempty"` // sum of the unrounded daily accruals
+	PostedAmount  string                 `protobuf:"bytes,4,opt,name=posted_amount,json=postedAmount,proto3" json:"posted_amount,omitempty"` // total_accrued rounded to cents, as posted
+	TransactionId string                 `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AlreadyRun    bool                   `protobuf:"varint,6,opt,name=already_run,json=alreadyRun,proto3" json:"already_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunInterestAccrualResponse) Reset() {
+	*x = RunInterestAccrualResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunInterestAccrualResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunInterestAccrualResponse) ProtoMessage() {}
+
+func (x *RunInterestAccrualResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunInterestAccrualResponse.ProtoReflect.Descriptor instead.
+func (*RunInterestAccrualResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RunInterestAccrualResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *RunInterestAccrualResponse) GetAccountsCount() int32 {
+	if x != nil {
+		return x.AccountsCount
+	}
+	return 0
+}
+
+func (x *RunInterestAccrualResponse) GetTotalAccrued() string {
+	if x != nil {
+		return x.TotalAccrued
+	}
+	return ""
+}
+
+func (x *RunInterestAccrualResponse) GetPostedAmount() string {
+	if x != nil {
+		return x.PostedAmount
+	}
+	return ""
+}
+
+func (x *RunInterestAccrualResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RunInterestAccrualResponse) GetAlreadyRun() bool {
+	if x != nil {
+		return x.AlreadyRun
+	}
+	return false
+}
+
+type RunInterestPayoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // YYYY-MM, must have ended
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunInterestPayoutRequest) Reset() {
+	*x = RunInterestPayoutRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunInterestPayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunInterestPayoutRequest) ProtoMessage() {}
+
+func (x *RunInterestPayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunInterestPayoutRequest.ProtoReflect.Descriptor instead.
+func (*RunInterestPayoutRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RunInterestPayoutRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type RunInterestPayoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	AccountsCount int32                  `protobuf:"varint,2,opt,name=accounts_count,json=accountsCount,proto3" json:"accounts_count,omitempty"`
+	AccruedAmount string                 `protobuf:"bytes,3,opt,name=accrued_amount,json=accruedAmount,proto3" json:"accrued_amount,omitempty"`
+	PaidAmount    string                 `protobuf:"bytes,4,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	TransactionId string                 `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AlreadyRun    bool                   `protobuf:"varint,6,opt,name=already_run,json=alreadyRun,proto3" json:"already_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunInterestPayoutResponse) Reset() {
+	*x = RunInterestPayoutResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunInterestPayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunInterestPayoutResponse) ProtoMessage() {}
+
+func (x *RunInterestPayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunInterestPayoutResponse.ProtoReflect.Descriptor instead.
+func (*RunInterestPayoutResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RunInterestPayoutResponse) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *RunInterestPayoutResponse) GetAccountsCount() int32 {
+	if x != nil {
+		return x.AccountsCount
+	}
+	return 0
+}
+
+func (x *RunInterestPayoutResponse) GetAccruedAmount() string {
+	if x != nil {
+		return x.AccruedAmount
+	}
+	return ""
+}
+
+func (x *RunInterestPayoutResponse) GetPaidAmount() string {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return ""
+}
+
+func (x *RunInterestPayoutResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RunInterestPayoutResponse) GetAlreadyRun() bool {
+	if x != nil {
+		return x.AlreadyRun
+	}
+	return false
+}
+
+type ScheduledTransfer struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromAccountId        string                 `protobuf:"bytes,2,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId          string                 `protobuf:"bytes,3,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount               string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description          string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ScheduleKind         string                 `protobuf:"bytes,6,opt,name=schedule_kind,json=scheduleKind,proto3" json:"schedule_kind,omitempty"` // ONCE, CRON or RRULE
+	Schedule             string                 `protobuf:"bytes,7,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	StartAt              string                 `protobuf:"bytes,8,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt                string                 `protobuf:"bytes,9,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	MaxOccurrences       int32                  `protobuf:"varint,10,opt,name=max_occurrences,json=maxOccurrences,proto3" json:"max_occurrences,omitempty"`                 // 0 means unlimited
+	OnInsufficientFunds  string                 `protobuf:"bytes,11,opt,name=on_insufficient_funds,json=onInsufficientFunds,proto3" json:"on_insufficient_funds,omitempty"` // RETRY or SKIP
+	MaxRetries           int32                  `protobuf:"varint,12,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	RetryIntervalSeconds int32                  `protobuf:"varint,13,opt,name=retry_interval_seconds,json=retryIntervalSeconds,proto3" json:"retry_interval_seconds,omitempty"`
+	Status               string                 `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"` // ACTIVE, PAUSED, CANCELLED or COMPLETED
+	Occurrences          int32                  `protobuf:"varint,15,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	NextRunAt            string                 `protobuf:"bytes,16,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	Attempts             int32                  `protobuf:"varint,17,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError            string                 `protobuf:"bytes,18,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt            string                 `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ScheduledTransfer) Reset() {
+	*x = ScheduledTransfer{}
+	mi := &file_accounts_accounts_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTransfer) ProtoMessage() {}
+
+func (x *ScheduledTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTransfer.ProtoReflect.Descriptor instead.
+func (*ScheduledTransfer) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ScheduledTransfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetFromAccountId() string {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetToAccountId() string {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetScheduleKind() string {
+	if x != nil {
+		return x.ScheduleKind
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetMaxOccurrences() int32 {
+	if x != nil {
+		return x.MaxOccurrences
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetOnInsufficientFunds() string {
+	if x != nil {
+		return x.OnInsufficientFunds
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetRetryIntervalSeconds() int32 {
+	if x != nil {
+		return x.RetryIntervalSeconds
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetNextRunAt() string {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// CreateScheduledTransferRequest schedules amount to be sent from
+// from_account_id to to_account_id. schedule is empty for a one-off
+// transfer at start_at, a five-field cron expression ("0 9 * * FRI") or an
+// RRULE ("FREQ=WEEKLY;BYDAY=FR"); all times are UTC. schedule_kind may be
+// left empty to infer it from schedule.
+type CreateScheduledTransferRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	FromAccountId        string                 `protobuf:"bytes,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId          string                 `protobuf:"bytes,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount               string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description          string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ScheduleKind         string                 `protobuf:"bytes,5,opt,name=schedule_kind,json=scheduleKind,proto3" json:"schedule_kind,omitempty"`
+	Schedule             string                 `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	StartAt              string                 `protobuf:"bytes,7,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"` // RFC 3339, defaults to now
+	EndAt                string                 `protobuf:"bytes,8,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	MaxOccurrences       int32                  `protobuf:"varint,9,opt,name=max_occurrences,json=maxOccurrences,proto3" json:"max_occurrences,omitempty"`
+	OnInsufficientFunds  string                 `protobuf:"bytes,10,opt,name=on_insufficient_funds,json=onInsufficientFunds,proto3" json:"on_insufficient_funds,omitempty"`     // defaults to RETRY
+	MaxRetries           *int32                 `protobuf:"varint,11,opt,name=max_retries,json=maxRetries,proto3,oneof" json:"max_retries,omitempty"`                           // defaults to 3
+	RetryIntervalSeconds int32                  `protobuf:"varint,12,opt,name=retry_interval_seconds,json=retryIntervalSeconds,proto3" json:"retry_interval_seconds,omitempty"` // defaults to 3600
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateScheduledTransferRequest) Reset() {
+	*x = CreateScheduledTransferRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduledTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledTransferRequest) ProtoMessage() {}
+
+func (x *CreateScheduledTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduledTransferRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateScheduledTransferRequest) GetFromAccountId() string {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetToAccountId() string {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetScheduleKind() string {
+	if x != nil {
+		return x.ScheduleKind
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetMaxOccurrences() int32 {
+	if x != nil {
+		return x.MaxOccurrences
+	}
+	return 0
+}
+
+func (x *CreateScheduledTransferRequest) GetOnInsufficientFunds() string {
+	if x != nil {
+		return x.OnInsufficientFunds
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetMaxRetries() int32 {
+	if x != nil && x.MaxRetries != nil {
+		return *x.MaxRetries
+	}
+	return 0
+}
+
+func (x *CreateScheduledTransferRequest) GetRetryIntervalSeconds() int32 {
+	if x != nil {
+		return x.RetryIntervalSeconds
+	}
+	return 0
+}
+
+type CreateScheduledTransferResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledTransfer *ScheduledTransfer     `protobuf:"bytes,1,opt,name=scheduled_transfer,json=scheduledTransfer,proto3" json:"scheduled_transfer,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateScheduledTransferResponse) Reset() {
+	*x = CreateScheduledTransferResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduledTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledTransferResponse) ProtoMessage() {}
+
+func (x *CreateScheduledTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledTransferResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduledTransferResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateScheduledTransferResponse) GetScheduledTransfer() *ScheduledTransfer {
+	if x != nil {
+		return x.ScheduledTransfer
+	}
+	return nil
+}
+
+type ListScheduledTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledTransfersRequest) Reset() {
+	*x = ListScheduledTransfersRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransfersRequest) ProtoMessage() {}
+
+func (x *ListScheduledTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListScheduledTransfersRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListScheduledTransfersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListScheduledTransfersResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledTransfers []*ScheduledTransfer   `protobuf:"bytes,1,rep,name=scheduled_transfers,json=scheduledTransfers,proto3" json:"scheduled_transfers,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListScheduledTransfersResponse) Reset() {
+	*x = ListScheduledTransfersResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransfersResponse) ProtoMessage() {}
+
+func (x *ListScheduledTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListScheduledTransfersResponse) GetScheduledTransfers() []*ScheduledTransfer {
+	if x != nil {
+		return x.ScheduledTransfers
+	}
+	return nil
+}
+
+type ScheduledTransferActionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AccountId           string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ScheduledTransferId string                 `protobuf:"bytes,2,opt,name=scheduled_transfer_id,json=scheduledTransferId,proto3" json:"scheduled_transfer_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ScheduledTransferActionRequest) Reset() {
+	*x = ScheduledTransferActionRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledTransferActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTransferActionRequest) ProtoMessage() {}
+
+func (x *ScheduledTransferActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTransferActionRequest.ProtoReflect.Descriptor instead.
+func (*ScheduledTransferActionRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ScheduledTransferActionRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ScheduledTransferActionRequest) GetScheduledTransferId() string {
+	if x != nil {
+		return x.ScheduledTransferId
+	}
+	return ""
+}
+
+type ScheduledTransferActionResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledTransfer *ScheduledTransfer     `protobuf:"bytes,1,opt,name=scheduled_transfer,json=scheduledTransfer,proto3" json:"scheduled_transfer,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScheduledTransferActionResponse) Reset() {
+	*x = ScheduledTransferActionResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledTransferActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTransferActionResponse) ProtoMessage() {}
+
+func (x *ScheduledTransferActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTransferActionResponse.ProtoReflect.Descriptor instead.
+func (*ScheduledTransferActionResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ScheduledTransferActionResponse) GetScheduledTransfer() *ScheduledTransfer {
+	if x != nil {
+		return x.ScheduledTransfer
+	}
+	return nil
+}
+
+type PaymentRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RequesterAccountId string                 `protobuf:"bytes,2,opt,name=requester_account_id,json=requesterAccountId,proto3" json:"requester_account_id,omitempty"`
+	PayerAccountId     string                 `protobuf:"bytes,3,opt,name=payer_account_id,json=payerAccountId,proto3" json:"payer_account_id,omitempty"`
+	Amount             string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo               string                 `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	Status             string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // PENDING, ACCEPTED, DECLINED, CANCELLED or EXPIRED
+	ExpiresAt          string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	TransactionId      string                 `protobuf:"bytes,8,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // set once accepted
+	CreatedAt          string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RespondedAt        string                 `protobuf:"bytes,10,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PaymentRequest) Reset() {
+	*x = PaymentRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequest) ProtoMessage() {}
+
+func (x *PaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRequest.ProtoReflect.Descriptor instead.
+func (*PaymentRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetRequesterAccountId() string {
+	if x != nil {
+		return x.RequesterAccountId
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetPayerAccountId() string {
+	if x != nil {
+		return x.PayerAccountId
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetRespondedAt() string {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return ""
+}
+
+type CreatePaymentRequestRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RequesterAccountId string                 `protobuf:"bytes,1,opt,name=requester_account_id,json=requesterAccountId,proto3" json:"requester_account_id,omitempty"`
+	PayerAccountId     string                 `protobuf:"bytes,2,opt,name=payer_account_id,json=payerAccountId,proto3" json:"payer_account_id,omitempty"`
+	Amount             string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo               string                 `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	ExpiresAt          string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // RFC 3339, defaults to 7 days, at most 30
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequestRequest) Reset() {
+	*x = CreatePaymentRequestRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequestRequest) ProtoMessage() {}
+
+func (x *CreatePaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreatePaymentRequestRequest) GetRequesterAccountId() string {
+	if x != nil {
+		return x.RequesterAccountId
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetPayerAccountId() string {
+	if x != nil {
+		return x.PayerAccountId
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type CreatePaymentRequestResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequest *PaymentRequest        `protobuf:"bytes,1,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequestResponse) Reset() {
+	*x = CreatePaymentRequestResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequestResponse) ProtoMessage() {}
+
+func (x *CreatePaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreatePaymentRequestResponse) GetPaymentRequest() *PaymentRequest {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return nil
+}
+
+// PaymentRequestActionRequest acts on a request as account_id, which must
+// be the payer to accept or decline and the requester to cancel.
+type PaymentRequestActionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PaymentRequestId string                 `protobuf:"bytes,2,opt,name=payment_request_id,json=paymentRequestId,proto3" json:"payment_request_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PaymentRequestActionRequest) Reset() {
+	*x = PaymentRequestActionRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentRequestActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequestActionRequest) ProtoMessage() {}
+
+func (x *PaymentRequestActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRequestActionRequest.ProtoReflect.Descriptor instead.
+func (*PaymentRequestActionRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *PaymentRequestActionRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PaymentRequestActionRequest) GetPaymentRequestId() string {
+	if x != nil {
+		return x.PaymentRequestId
+	}
+	return ""
+}
+
+type PaymentRequestActionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequest *PaymentRequest        `protobuf:"bytes,1,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PaymentRequestActionResponse) Reset() {
+	*x = PaymentRequestActionResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentRequestActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequestActionResponse) ProtoMessage() {}
+
+func (x *PaymentRequestActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRequestActionResponse.ProtoReflect.Descriptor instead.
+func (*PaymentRequestActionResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PaymentRequestActionResponse) GetPaymentRequest() *PaymentRequest {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return nil
+}
+
+type AcceptPaymentRequestResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequest *PaymentRequest        `protobuf:"bytes,1,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	NewBalance     string                 `protobuf:"bytes,2,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	Fee            *FeeBreakdown          `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AcceptPaymentRequestResponse) Reset() {
+	*x = AcceptPaymentRequestResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptPaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPaymentRequestResponse) ProtoMessage() {}
+
+func (x *AcceptPaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*AcceptPaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AcceptPaymentRequestResponse) GetPaymentRequest() *PaymentRequest {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return nil
+}
+
+func (x *AcceptPaymentRequestResponse) GetNewBalance() string {
+	if x != nil {
+		return x.NewBalance
+	}
+	return ""
+}
+
+func (x *AcceptPaymentRequestResponse) GetFee() *FeeBreakdown {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+type ListPaymentRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Direction     string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"` // INCOMING (to pay) or OUTGOING (made), default INCOMING
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentRequestsRequest) Reset() {
+	*x = ListPaymentRequestsRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentRequestsRequest) ProtoMessage() {}
+
+func (x *ListPaymentRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListPaymentRequestsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListPaymentRequestsRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ListPaymentRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPaymentRequestsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPaymentRequestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPaymentRequestsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequests []*PaymentRequest      `protobuf:"bytes,1,rep,name=payment_requests,json=paymentRequests,proto3" json:"payment_requests,omitempty"`
+	TotalCount      int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPaymentRequestsResponse) Reset() {
+	*x = ListPaymentRequestsResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentRequestsResponse) ProtoMessage() {}
+
+func (x *ListPaymentRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListPaymentRequestsResponse) GetPaymentRequests() []*PaymentRequest {
+	if x != nil {
+		return x.PaymentRequests
+	}
+	return nil
+}
+
+func (x *ListPaymentRequestsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListPaymentRequestsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPaymentRequestsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// AuditEntry is one mutating call as recorded in the append-only audit
+// log. request, before and after are JSON with secrets redacted.
+type AuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Service       string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`                        // method name, e.g. Deposit
+	ActorType     string                 `protobuf:"bytes,5,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"` // API_KEY, SERVICE or UNKNOWN
+	ActorId       string                 `protobuf:"bytes,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ClaimedUserId string                 `protobuf:"bytes,7,opt,name=claimed_user_id,json=claimedUserId,proto3" json:"claimed_user_id,omitempty"` // X-User-ID as sent by the partner, unverified
+	Target        string                 `protobuf:"bytes,8,opt,name=target,proto3" json:"target,omitempty"`                                      // e.g. account_id=1001
+	Request       string                 `protobuf:"bytes,9,opt,name=request,proto3" json:"request,omitempty"`
+	Before        string                 `protobuf:"bytes,10,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,11,opt,name=after,proto3" json:"after,omitempty"`
+	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"` // gRPC status code, OK on success
+	Error         string                 `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	RequestId     string                 `protobuf:"bytes,14,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SourceIp      string                 `protobuf:"bytes,15,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`    // address the gateway was called from
+	ClaimedIp     string                 `protobuf:"bytes,16,opt,name=claimed_ip,json=claimedIp,proto3" json:"claimed_ip,omitempty"` // address X-Forwarded-For claims, unverified
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_accounts_accounts_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetClaimedUserId() string {
+	if x != nil {
+		return x.ClaimedUserId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetClaimedIp() string {
+	if x != nil {
+		return x.ClaimedIp
+	}
+	return ""
+}
+
+type ListAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	From          string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"` // RFC 3339, inclusive
+	To            string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`     // RFC 3339, exclusive
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogRequest) Reset() {
+	*x = ListAuditLogRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogRequest) ProtoMessage() {}
+
+func (x *ListAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListAuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogResponse) Reset() {
+	*x = ListAuditLogResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogResponse) ProtoMessage() {}
+
+func (x *ListAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListAuditLogResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListAuditLogResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListAuditLogResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type PayoutBatch struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Filename        string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // PENDING_APPROVAL, APPROVED, COMPLETED or CANCELLED
+	SourceAccountId string                 `protobuf:"bytes,4,opt,name=source_account_id,json=sourceAccountId,proto3" json:"source_account_id,omitempty"`
+	TotalRows       int32                  `protobuf:"varint,5,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ValidRows       int32                  `protobuf:"varint,6,opt,name=valid_rows,json=validRows,proto3" json:"valid_rows,omitempty"`
+	InvalidRows     int32                  `protobuf:"varint,7,opt,name=invalid_rows,json=invalidRows,proto3" json:"invalid_rows,omitempty"`
+	TotalAmount     string                 `protobuf:"bytes,8,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"` // sum of the valid rows
+	PaidRows        int32                  `protobuf:"varint,9,opt,name=paid_rows,json=paidRows,proto3" json:"paid_rows,omitempty"`
+	FailedRows      int32                  `protobuf:"varint,10,opt,name=failed_rows,json=failedRows,proto3" json:"failed_rows,omitempty"`
+	PaidAmount      string                 `protobuf:"bytes,11,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ApprovedBy      string                 `protobuf:"bytes,13,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ApprovedAt      string                 `protobuf:"bytes,15,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	CompletedAt     string                 `protobuf:"bytes,16,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PayoutBatch) Reset() {
+	*x = PayoutBatch{}
+	mi := &file_accounts_accounts_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutBatch) ProtoMessage() {}
+
+func (x *PayoutBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutBatch.ProtoReflect.Descriptor instead.
+func (*PayoutBatch) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PayoutBatch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetSourceAccountId() string {
+	if x != nil {
+		return x.SourceAccountId
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *PayoutBatch) GetValidRows() int32 {
+	if x != nil {
+		return x.ValidRows
+	}
+	return 0
+}
+
+func (x *PayoutBatch) GetInvalidRows() int32 {
+	if x != nil {
+		return x.InvalidRows
+	}
+	return 0
+}
+
+func (x *PayoutBatch) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetPaidRows() int32 {
+	if x != nil {
+		return x.PaidRows
+	}
+	return 0
+}
+
+func (x *PayoutBatch) GetFailedRows() int32 {
+	if x != nil {
+		return x.FailedRows
+	}
+	return 0
+}
+
+func (x *PayoutBatch) GetPaidAmount() string {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetApprovedAt() string {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+type PayoutRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // line of the uploaded file
+	Recipient     string                 `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	AccountId     string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // VALID, INVALID, SUBMITTED, PAID or FAILED
+	TransactionId string                 `protobuf:"bytes,7,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayoutRow) Reset() {
+	*x = PayoutRow{}
+	mi := &file_accounts_accounts_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutRow) ProtoMessage() {}
+
+func (x *PayoutRow) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutRow.ProtoReflect.Descriptor instead.
+func (*PayoutRow) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PayoutRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *PayoutRow) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *PayoutRow) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PayoutRow) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PayoutRow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PayoutRow) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PayoutRow) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *PayoutRow) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// FundDisbursementPoolRequest moves amount from the INSTITUTION_MAIN
+// account into the DISBURSEMENT pool payouts are paid from.
+type FundDisbursementPoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundDisbursementPoolRequest) Reset() {
+	*x = FundDisbursementPoolRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundDisbursementPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundDisbursementPoolRequest) ProtoMessage() {}
+
+func (x *FundDisbursementPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundDisbursementPoolRequest.ProtoReflect.Descriptor instead.
+func (*FundDisbursementPoolRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *FundDisbursementPoolRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *FundDisbursementPoolRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type FundDisbursementPoolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // the DISBURSEMENT account
+	Balance       string                 `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`                      // the pool's balance after funding
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundDisbursementPoolResponse) Reset() {
+	*x = FundDisbursementPoolResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundDisbursementPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundDisbursementPoolResponse) ProtoMessage() {}
+
+func (x *FundDisbursementPoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundDisbursementPoolResponse.ProtoReflect.Descriptor instead.
+func (*FundDisbursementPoolResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *FundDisbursementPoolResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *FundDisbursementPoolResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *FundDisbursementPoolResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+// CreatePayoutBatchRequest carries a CSV with a header row naming the
+// columns recipient (account id, email or handle), amount and, optionally,
+// description. user_id names who uploads it, as claimed with X-User-ID,
+// and is recorded as created_by.
+type CreatePayoutBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePayoutBatchRequest) Reset() {
+	*x = CreatePayoutBatchRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePayoutBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayoutBatchRequest) ProtoMessage() {}
+
+func (x *CreatePayoutBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayoutBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreatePayoutBatchRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CreatePayoutBatchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreatePayoutBatchRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *CreatePayoutBatchRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type CreatePayoutBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batch         *PayoutBatch           `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePayoutBatchResponse) Reset() {
+	*x = CreatePayoutBatchResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePayoutBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayoutBatchResponse) ProtoMessage() {}
+
+func (x *CreatePayoutBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayoutBatchResponse.ProtoReflect.Descriptor instead.
+func (*CreatePayoutBatchResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CreatePayoutBatchResponse) GetBatch() *PayoutBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type GetPayoutBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      string                 `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoutBatchRequest) Reset() {
+	*x = GetPayoutBatchRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutBatchRequest) ProtoMessage() {}
+
+func (x *GetPayoutBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoutBatchRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetPayoutBatchRequest) GetPayoutId() string {
+	if x != nil {
+		return x.PayoutId
+	}
+	return ""
+}
+
+type GetPayoutBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batch         *PayoutBatch           `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoutBatchResponse) Reset() {
+	*x = GetPayoutBatchResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutBatchResponse) ProtoMessage() {}
+
+func (x *GetPayoutBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetPayoutBatchResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetPayoutBatchResponse) GetBatch() *PayoutBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type ListPayoutBatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutBatchesRequest) Reset() {
+	*x = ListPayoutBatchesRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutBatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutBatchesRequest) ProtoMessage() {}
+
+func (x *ListPayoutBatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutBatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListPayoutBatchesRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListPayoutBatchesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPayoutBatchesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPayoutBatchesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPayoutBatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batches       []*PayoutBatch         `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutBatchesResponse) Reset() {
+	*x = ListPayoutBatchesResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutBatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutBatchesResponse) ProtoMessage() {}
+
+func (x *ListPayoutBatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutBatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListPayoutBatchesResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListPayoutBatchesResponse) GetBatches() []*PayoutBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+func (x *ListPayoutBatchesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListPayoutBatchesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPayoutBatchesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPayoutRowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      string                 `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutRowsRequest) Reset() {
+	*x = ListPayoutRowsRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutRowsRequest) ProtoMessage() {}
+
+func (x *ListPayoutRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutRowsRequest.ProtoReflect.Descriptor instead.
+func (*ListPayoutRowsRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListPayoutRowsRequest) GetPayoutId() string {
+	if x != nil {
+		return x.PayoutId
+	}
+	return ""
+}
+
+func (x *ListPayoutRowsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPayoutRowsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPayoutRowsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPayoutRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*PayoutRow           `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutRowsResponse) Reset() {
+	*x = ListPayoutRowsResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutRowsResponse) ProtoMessage() {}
+
+func (x *ListPayoutRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutRowsResponse.ProtoReflect.Descriptor instead.
+func (*ListPayoutRowsResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListPayoutRowsResponse) GetRows() []*PayoutRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ListPayoutRowsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListPayoutRowsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPayoutRowsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ApprovePayoutBatch requires the admin key in x-admin-key metadata and a
+// user_id other than the batch's created_by.
+type PayoutBatchActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      string                 `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayoutBatchActionRequest) Reset() {
+	*x = PayoutBatchActionRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutBatchActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutBatchActionRequest) ProtoMessage() {}
+
+func (x *PayoutBatchActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutBatchActionRequest.ProtoReflect.Descriptor instead.
+func (*PayoutBatchActionRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *PayoutBatchActionRequest) GetPayoutId() string {
+	if x != nil {
+		return x.PayoutId
+	}
+	return ""
+}
+
+func (x *PayoutBatchActionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type PayoutBatchActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batch         *PayoutBatch           `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayoutBatchActionResponse) Reset() {
+	*x = PayoutBatchActionResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutBatchActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutBatchActionResponse) ProtoMessage() {}
+
+func (x *PayoutBatchActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutBatchActionResponse.ProtoReflect.Descriptor instead.
+func (*PayoutBatchActionResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *PayoutBatchActionResponse) GetBatch() *PayoutBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type ExportPayoutResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      string                 `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPayoutResultsRequest) Reset() {
+	*x = ExportPayoutResultsRequest{}
+	mi := &file_accounts_accounts_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPayoutResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPayoutResultsRequest) ProtoMessage() {}
+
+func (x *ExportPayoutResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPayoutResultsRequest.ProtoReflect.Descriptor instead.
+func (*ExportPayoutResultsRequest) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ExportPayoutResultsRequest) GetPayoutId() string {
+	if x != nil {
+		return x.PayoutId
+	}
+	return ""
+}
+
+// ExportPayoutResultsResponse is a CSV of every row of a batch with its
+// status, transaction id and error.
+type ExportPayoutResultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPayoutResultsResponse) Reset() {
+	*x = ExportPayoutResultsResponse{}
+	mi := &file_accounts_accounts_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPayoutResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPayoutResultsResponse) ProtoMessage() {}
+
+func (x *ExportPayoutResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accounts_accounts_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPayoutResultsResponse.ProtoReflect.Descriptor instead.
+func (*ExportPayoutResultsResponse) Descriptor() ([]byte, []int) {
+	return file_accounts_accounts_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ExportPayoutResultsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportPayoutResultsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportPayoutResultsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_accounts_accounts_proto protoreflect.FileDescriptor
+
+const file_accounts_accounts_proto_rawDesc = "" +
+	"\n" +
+	"\x17accounts/accounts.proto\x12\baccounts\"\x85\x01\n" +
+	"\x14CreateAccountRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12'\n" +
+	"\x0finitial_balance\x18\x02 \x01(\tR\x0einitialBalance\x12.\n" +
+	"\x13referrer_account_id\x18\x03 \x01(\tR\x11referrerAccountId\"\x97\x01\n" +
+	"\x15CreateAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12+\n" +
+	"\aaccount\x18\x04 \x01(\v2\x11.accounts.AccountR\aaccount\"2\n" +
+	"\x11GetAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"A\n" +
+	"\x12GetAccountResponse\x12+\n" +
+	"\aaccount\x18\x01 \x01(\v2\x11.accounts.AccountR\aaccount\"2\n" +
+	"\x11GetBalanceRequest\x12\x1d\n" +
+	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\"\x88\x01\n" +
 	"\x12GetBalanceResponse\x12\x1d\n" +
 	"\n" +
@@ -1114,29 +7417,80 @@ const file_accounts_accounts_proto_rawDesc = "" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\tR\x06amount\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x8e\x01\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\xb8\x01\n" +
 	"\x10WithdrawResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
 	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x1f\n" +
 	"\vnew_balance\x18\x03 \x01(\tR\n" +
 	"newBalance\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"\x97\x01\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12(\n" +
+	"\x03fee\x18\x05 \x01(\v2\x16.accounts.FeeBreakdownR\x03fee\"\xb5\x01\n" +
 	"\x0fTransferRequest\x12&\n" +
 	"\x0ffrom_account_id\x18\x01 \x01(\tR\rfromAccountId\x12\"\n" +
 	"\rto_account_id\x18\x02 \x01(\tR\vtoAccountId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\tR\x06amount\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\"\x8e\x01\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1c\n" +
+	"\trecipient\x18\x05 \x01(\tR\trecipient\"\xeb\x01\n" +
 	"\x10TransferResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
 	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x1f\n" +
 	"\vnew_balance\x18\x03 \x01(\tR\n" +
 	"newBalance\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"n\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12(\n" +
+	"\x03fee\x18\x05 \x01(\v2\x16.accounts.FeeBreakdownR\x03fee\x121\n" +
+	"\trecipient\x18\x06 \x01(\v2\x13.accounts.RecipientR\trecipient\"}\n" +
+	"\tRecipient\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12!\n" +
+	"\fmasked_email\x18\x03 \x01(\tR\vmaskedEmail\x12\x16\n" +
+	"\x06handle\x18\x04 \x01(\tR\x06handle\"6\n" +
+	"\x16LookupRecipientRequest\x12\x1c\n" +
+	"\trecipient\x18\x01 \x01(\tR\trecipient\"L\n" +
+	"\x17LookupRecipientResponse\x121\n" +
+	"\trecipient\x18\x01 \x01(\v2\x13.accounts.RecipientR\trecipient\"I\n" +
+	"\x10SetHandleRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06handle\x18\x02 \x01(\tR\x06handle\"@\n" +
+	"\x11SetHandleResponse\x12+\n" +
+	"\aaccount\x18\x01 \x01(\v2\x11.accounts.AccountR\aaccount\"W\n" +
+	"\aFeeItem\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"\xbc\x01\n" +
+	"\fFeeBreakdown\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x10\n" +
+	"\x03fee\x18\x03 \x01(\tR\x03fee\x12\x1f\n" +
+	"\vtotal_debit\x18\x04 \x01(\tR\n" +
+	"totalDebit\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12'\n" +
+	"\x05items\x18\x06 \x03(\v2\x11.accounts.FeeItemR\x05items\"f\n" +
+	"\x0fQuoteFeeRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"<\n" +
+	"\x10QuoteFeeResponse\x12(\n" +
+	"\x03fee\x18\x01 \x01(\v2\x16.accounts.FeeBreakdownR\x03fee\"\xe8\x02\n" +
 	"\x1cGetTransactionHistoryRequest\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xcd\x01\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\tR\x02to\x12\x1c\n" +
+	"\tdirection\x18\a \x01(\tR\tdirection\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\b \x01(\tR\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\t \x01(\tR\tmaxAmount\x12)\n" +
+	"\x10reference_prefix\x18\n" +
+	" \x01(\tR\x0freferencePrefix\x12\x16\n" +
+	"\x06search\x18\v \x01(\tR\x06search\x12\x1d\n" +
+	"\n" +
+	"use_cursor\x18\f \x01(\bR\tuseCursor\"\x89\x02\n" +
 	"\x1dGetTransactionHistoryResponse\x129\n" +
 	"\ftransactions\x18\x01 \x03(\v2\x15.accounts.TransactionR\ftransactions\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
@@ -1144,7 +7498,10 @@ const file_accounts_accounts_proto_rawDesc = "" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
-	"totalPages\"\xa0\x02\n" +
+	"totalPages\x12\x1f\n" +
+	"\vnext_cursor\x18\x06 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\a \x01(\bR\ahasMore\"\x97\x03\n" +
 	"\aAccount\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12!\n" +
@@ -1154,11 +7511,217 @@ const file_accounts_accounts_proto_rawDesc = "" +
 	"\x13referrer_account_id\x18\x05 \x01(\tH\x02R\x11referrerAccountId\x88\x01\x01\x12\x18\n" +
 	"\abalance\x18\x06 \x01(\tR\abalance\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAtB\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\x06handle\x18\b \x01(\tH\x03R\x06handle\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\t \x01(\tR\x04name\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\v \x01(\bR\tisDefaultB\n" +
 	"\n" +
 	"\b_user_idB\b\n" +
 	"\x06_emailB\x16\n" +
-	"\x14_referrer_account_id\"\xfd\x01\n" +
+	"\x14_referrer_account_idB\t\n" +
+	"\a_handle\"\\\n" +
+	"\x11OpenWalletRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"A\n" +
+	"\x12OpenWalletResponse\x12+\n" +
+	"\aaccount\x18\x01 \x01(\v2\x11.accounts.AccountR\aaccount\"5\n" +
+	"\x1aListAccountsForUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"L\n" +
+	"\x1bListAccountsForUserResponse\x12-\n" +
+	"\baccounts\x18\x01 \x03(\v2\x11.accounts.AccountR\baccounts\"Q\n" +
+	"\x17SetDefaultWalletRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\"G\n" +
+	"\x18SetDefaultWalletResponse\x12+\n" +
+	"\aaccount\x18\x01 \x01(\v2\x11.accounts.AccountR\aaccount\"\x8d\x02\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05phone\x18\x04 \x01(\tH\x01R\x05phone\x88\x01\x01\x12\x1d\n" +
+	"\aaddress\x18\x05 \x01(\tH\x02R\aaddress\x88\x01\x01\x12\x1b\n" +
+	"\x06locale\x18\x06 \x01(\tH\x03R\x06locale\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAtB\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_phoneB\n" +
+	"\n" +
+	"\b_addressB\t\n" +
+	"\a_locale\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"5\n" +
+	"\x0fGetUserResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.accounts.UserR\x04user\"\xc9\x01\n" +
+	"\x14UpdateProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05phone\x18\x03 \x01(\tH\x01R\x05phone\x88\x01\x01\x12\x1d\n" +
+	"\aaddress\x18\x04 \x01(\tH\x02R\aaddress\x88\x01\x01\x12\x1b\n" +
+	"\x06locale\x18\x05 \x01(\tH\x03R\x06locale\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_phoneB\n" +
+	"\n" +
+	"\b_addressB\t\n" +
+	"\a_locale\";\n" +
+	"\x15UpdateProfileResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.accounts.UserR\x04user\"Q\n" +
+	"\x19RequestEmailChangeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tnew_email\x18\x02 \x01(\tR\bnewEmail\";\n" +
+	"\x1aRequestEmailChangeResponse\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x01 \x01(\tR\texpiresAt\"1\n" +
+	"\x19ConfirmEmailChangeRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"@\n" +
+	"\x1aConfirmEmailChangeResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.accounts.UserR\x04user\"\xf3\x01\n" +
+	"\x17NotificationPreferences\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bchannels\x18\x02 \x03(\tR\bchannels\x12\"\n" +
+	"\n" +
+	"push_token\x18\x03 \x01(\tH\x00R\tpushToken\x88\x01\x01\x127\n" +
+	"\x15low_balance_threshold\x18\x04 \x01(\tH\x01R\x13lowBalanceThreshold\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAtB\r\n" +
+	"\v_push_tokenB\x18\n" +
+	"\x16_low_balance_threshold\"<\n" +
+	"!GetNotificationPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xde\x01\n" +
+	"!SetNotificationPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bchannels\x18\x02 \x03(\tR\bchannels\x12\"\n" +
+	"\n" +
+	"push_token\x18\x03 \x01(\tH\x00R\tpushToken\x88\x01\x01\x127\n" +
+	"\x15low_balance_threshold\x18\x04 \x01(\tH\x01R\x13lowBalanceThreshold\x88\x01\x01B\r\n" +
+	"\v_push_tokenB\x18\n" +
+	"\x16_low_balance_threshold\"f\n" +
+	"\x1fNotificationPreferencesResponse\x12C\n" +
+	"\vpreferences\x18\x01 \x01(\v2!.accounts.NotificationPreferencesR\vpreferences\"\xbf\x02\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12%\n" +
+	"\x0etransaction_id\x18\x05 \x01(\tR\rtransactionId\x12\x18\n" +
+	"\asubject\x18\x06 \x01(\tR\asubject\x12\x12\n" +
+	"\x04body\x18\a \x01(\tR\x04body\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\t \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1c\n" +
+	"\asent_at\x18\v \x01(\tH\x00R\x06sentAt\x88\x01\x01B\n" +
+	"\n" +
+	"\b_sent_at\"d\n" +
+	"\x18ListNotificationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xab\x01\n" +
+	"\x19ListNotificationsResponse\x12<\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x16.accounts.NotificationR\rnotifications\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x84\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x82\x01\n" +
+	"\x16RegisterWebhookRequest\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"^\n" +
+	"\x17RegisterWebhookResponse\x12+\n" +
+	"\awebhook\x18\x01 \x01(\v2\x11.accounts.WebhookR\awebhook\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"3\n" +
+	"\x13ListWebhooksRequest\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\"E\n" +
+	"\x14ListWebhooksResponse\x12-\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x11.accounts.WebhookR\bwebhooks\"S\n" +
+	"\x14DeleteWebhookRequest\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\"\x17\n" +
+	"\x15DeleteWebhookResponse\"\xe6\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05event\x18\x04 \x01(\tR\x05event\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12&\n" +
+	"\x0fnext_attempt_at\x18\b \x01(\tR\rnextAttemptAt\x12-\n" +
+	"\x10last_status_code\x18\t \x01(\x05H\x00R\x0elastStatusCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tH\x01R\tlastError\x88\x01\x01\x12 \n" +
+	"\treplay_of\x18\v \x01(\tH\x02R\breplayOf\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\x12&\n" +
+	"\fdelivered_at\x18\r \x01(\tH\x03R\vdeliveredAt\x88\x01\x01B\x13\n" +
+	"\x11_last_status_codeB\r\n" +
+	"\v_last_errorB\f\n" +
+	"\n" +
+	"_replay_ofB\x0f\n" +
+	"\r_delivered_at\"\xde\x01\n" +
+	"\x0eWebhookAttempt\x12\x18\n" +
+	"\aattempt\x18\x01 \x01(\x05R\aattempt\x12$\n" +
+	"\vstatus_code\x18\x02 \x01(\x05H\x00R\n" +
+	"statusCode\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\x03 \x01(\tH\x01R\x05error\x88\x01\x01\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\x12!\n" +
+	"\fattempted_at\x18\x06 \x01(\tR\vattemptedAtB\x0e\n" +
+	"\f_status_codeB\b\n" +
+	"\x06_errorJ\x04\b\x04\x10\x05R\rresponse_body\"\xa4\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\xac\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x129\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x19.accounts.WebhookDeliveryR\n" +
+	"deliveries\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"Z\n" +
+	"\x19GetWebhookDeliveryRequest\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\x12\x1f\n" +
+	"\vdelivery_id\x18\x02 \x01(\tR\n" +
+	"deliveryId\"\x89\x01\n" +
+	"\x1aGetWebhookDeliveryResponse\x125\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x19.accounts.WebhookDeliveryR\bdelivery\x124\n" +
+	"\battempts\x18\x02 \x03(\v2\x18.accounts.WebhookAttemptR\battempts\"]\n" +
+	"\x1cReplayWebhookDeliveryRequest\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\x12\x1f\n" +
+	"\vdelivery_id\x18\x02 \x01(\tR\n" +
+	"deliveryId\"V\n" +
+	"\x1dReplayWebhookDeliveryResponse\x125\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x19.accounts.WebhookDeliveryR\bdelivery\"\xbd\x02\n" +
 	"\vTransaction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x1d\n" +
@@ -1169,17 +7732,361 @@ const file_accounts_accounts_proto_rawDesc = "" +
 	"\freference_id\x18\x06 \x01(\tR\vreferenceId\x12 \n" +
 	"\vdescription\x18\a \x01(\tR\vdescription\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\b \x01(\tR\tcreatedAt2\xa5\x04\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12>\n" +
+	"\x0ecounterparties\x18\t \x03(\v2\x16.accounts.CounterpartyR\x0ecounterparties\"s\n" +
+	"\fCounterparty\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12!\n" +
+	"\faccount_type\x18\x02 \x01(\tR\vaccountType\x12!\n" +
+	"\fmasked_email\x18\x03 \x01(\tR\vmaskedEmail\"]\n" +
+	"\x15GetTransactionRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\"u\n" +
+	"\x0eTransactionLeg\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1c\n" +
+	"\tdirection\x18\x04 \x01(\tR\tdirection\"\x83\x03\n" +
+	"\x11TransactionDetail\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12!\n" +
+	"\freference_id\x18\x02 \x01(\tR\vreferenceId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12,\n" +
+	"\x04legs\x18\x05 \x03(\v2\x18.accounts.TransactionLegR\x04legs\x12>\n" +
+	"\x0ecounterparties\x18\x06 \x03(\v2\x16.accounts.CounterpartyR\x0ecounterparties\x126\n" +
+	"\x17reverses_transaction_id\x18\a \x01(\tR\x15reversesTransactionId\x12=\n" +
+	"\x1breversed_by_transaction_ids\x18\b \x03(\tR\x18reversedByTransactionIds\"W\n" +
+	"\x16GetTransactionResponse\x12=\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1b.accounts.TransactionDetailR\vtransaction\"i\n" +
+	"\x18GenerateStatementRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\"c\n" +
+	"\x0eStatementChunk\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"\x83\x01\n" +
+	"\rSystemAccount\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"\x1b\n" +
+	"\x19ListSystemAccountsRequest\"^\n" +
+	"\x1aListSystemAccountsResponse\x12@\n" +
+	"\x0fsystem_accounts\x18\x01 \x03(\v2\x17.accounts.SystemAccountR\x0esystemAccounts\"n\n" +
+	"\x17SetSystemAccountRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"Z\n" +
+	"\x18SetSystemAccountResponse\x12>\n" +
+	"\x0esystem_account\x18\x01 \x01(\v2\x17.accounts.SystemAccountR\rsystemAccount\"/\n" +
+	"\x19RunInterestAccrualRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"\xe9\x01\n" +
+	"\x1aRunInterestAccrualResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12%\n" +
+	"\x0eaccounts_count\x18\x02 \x01(\x05R\raccountsCount\x12#\n" +
+	"\rtotal_accrued\x18\x03 \x01(\tR\ftotalAccrued\x12#\n" +
+	"\rposted_amount\x18\x04 \x01(\tR\fpostedAmount\x12%\n" +
+	"\x0etransaction_id\x18\x05 \x01(\tR\rtransactionId\x12\x1f\n" +
+	"\valready_run\x18\x06 \x01(\bR\n" +
+	"alreadyRun\"2\n" +
+	"\x18RunInterestPayoutRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\"\xea\x01\n" +
+	"\x19RunInterestPayoutResponse\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12%\n" +
+	"\x0eaccounts_count\x18\x02 \x01(\x05R\raccountsCount\x12%\n" +
+	"\x0eaccrued_amount\x18\x03 \x01(\tR\raccruedAmount\x12\x1f\n" +
+	"\vpaid_amount\x18\x04 \x01(\tR\n" +
+	"paidAmount\x12%\n" +
+	"\x0etransaction_id\x18\x05 \x01(\tR\rtransactionId\x12\x1f\n" +
+	"\valready_run\x18\x06 \x01(\bR\n" +
+	"alreadyRun\"\x84\x05\n" +
+	"\x11ScheduledTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x0ffrom_account_id\x18\x02 \x01(\tR\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x03 \x01(\tR\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12#\n" +
+	"\rschedule_kind\x18\x06 \x01(\tR\fscheduleKind\x12\x1a\n" +
+	"\bschedule\x18\a \x01(\tR\bschedule\x12\x19\n" +
+	"\bstart_at\x18\b \x01(\tR\astartAt\x12\x15\n" +
+	"\x06end_at\x18\t \x01(\tR\x05endAt\x12'\n" +
+	"\x0fmax_occurrences\x18\n" +
+	" \x01(\x05R\x0emaxOccurrences\x122\n" +
+	"\x15on_insufficient_funds\x18\v \x01(\tR\x13onInsufficientFunds\x12\x1f\n" +
+	"\vmax_retries\x18\f \x01(\x05R\n" +
+	"maxRetries\x124\n" +
+	"\x16retry_interval_seconds\x18\r \x01(\x05R\x14retryIntervalSeconds\x12\x16\n" +
+	"\x06status\x18\x0e \x01(\tR\x06status\x12 \n" +
+	"\voccurrences\x18\x0f \x01(\x05R\voccurrences\x12\x1e\n" +
+	"\vnext_run_at\x18\x10 \x01(\tR\tnextRunAt\x12\x1a\n" +
+	"\battempts\x18\x11 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x12 \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x13 \x01(\tR\tcreatedAt\"\xe2\x03\n" +
+	"\x1eCreateScheduledTransferRequest\x12&\n" +
+	"\x0ffrom_account_id\x18\x01 \x01(\tR\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x02 \x01(\tR\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
+	"\rschedule_kind\x18\x05 \x01(\tR\fscheduleKind\x12\x1a\n" +
+	"\bschedule\x18\x06 \x01(\tR\bschedule\x12\x19\n" +
+	"\bstart_at\x18\a \x01(\tR\astartAt\x12\x15\n" +
+	"\x06end_at\x18\b \x01(\tR\x05endAt\x12'\n" +
+	"\x0fmax_occurrences\x18\t \x01(\x05R\x0emaxOccurrences\x122\n" +
+	"\x15on_insufficient_funds\x18\n" +
+	" \x01(\tR\x13onInsufficientFunds\x12$\n" +
+	"\vmax_retries\x18\v \x01(\x05H\x00R\n" +
+	"maxRetries\x88\x01\x01\x124\n" +
+	"\x16retry_interval_seconds\x18\f \x01(\x05R\x14retryIntervalSecondsB\x0e\n" +
+	"\f_max_retries\"m\n" +
+	"\x1fCreateScheduledTransferResponse\x12J\n" +
+	"\x12scheduled_transfer\x18\x01 \x01(\v2\x1b.accounts.ScheduledTransferR\x11scheduledTransfer\"V\n" +
+	"\x1dListScheduledTransfersRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"n\n" +
+	"\x1eListScheduledTransfersResponse\x12L\n" +
+	"\x13scheduled_transfers\x18\x01 \x03(\v2\x1b.accounts.ScheduledTransferR\x12scheduledTransfers\"s\n" +
+	"\x1eScheduledTransferActionRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x122\n" +
+	"\x15scheduled_transfer_id\x18\x02 \x01(\tR\x13scheduledTransferId\"m\n" +
+	"\x1fScheduledTransferActionResponse\x12J\n" +
+	"\x12scheduled_transfer\x18\x01 \x01(\v2\x1b.accounts.ScheduledTransferR\x11scheduledTransfer\"\xc8\x02\n" +
+	"\x0ePaymentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x14requester_account_id\x18\x02 \x01(\tR\x12requesterAccountId\x12(\n" +
+	"\x10payer_account_id\x18\x03 \x01(\tR\x0epayerAccountId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x12\n" +
+	"\x04memo\x18\x05 \x01(\tR\x04memo\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\x12%\n" +
+	"\x0etransaction_id\x18\b \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12!\n" +
+	"\fresponded_at\x18\n" +
+	" \x01(\tR\vrespondedAt\"\xc4\x01\n" +
+	"\x1bCreatePaymentRequestRequest\x120\n" +
+	"\x14requester_account_id\x18\x01 \x01(\tR\x12requesterAccountId\x12(\n" +
+	"\x10payer_account_id\x18\x02 \x01(\tR\x0epayerAccountId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x12\n" +
+	"\x04memo\x18\x04 \x01(\tR\x04memo\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"a\n" +
+	"\x1cCreatePaymentRequestResponse\x12A\n" +
+	"\x0fpayment_request\x18\x01 \x01(\v2\x18.accounts.PaymentRequestR\x0epaymentRequest\"j\n" +
+	"\x1bPaymentRequestActionRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12,\n" +
+	"\x12payment_request_id\x18\x02 \x01(\tR\x10paymentRequestId\"a\n" +
+	"\x1cPaymentRequestActionResponse\x12A\n" +
+	"\x0fpayment_request\x18\x01 \x01(\v2\x18.accounts.PaymentRequestR\x0epaymentRequest\"\xac\x01\n" +
+	"\x1cAcceptPaymentRequestResponse\x12A\n" +
+	"\x0fpayment_request\x18\x01 \x01(\v2\x18.accounts.PaymentRequestR\x0epaymentRequest\x12\x1f\n" +
+	"\vnew_balance\x18\x02 \x01(\tR\n" +
+	"newBalance\x12(\n" +
+	"\x03fee\x18\x03 \x01(\v2\x16.accounts.FeeBreakdownR\x03fee\"\xa2\x01\n" +
+	"\x1aListPaymentRequestsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\xb4\x01\n" +
+	"\x1bListPaymentRequestsResponse\x12C\n" +
+	"\x10payment_requests\x18\x01 \x03(\v2\x18.accounts.PaymentRequestR\x0fpaymentRequests\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xba\x03\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\voccurred_at\x18\x02 \x01(\tR\n" +
+	"occurredAt\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x05 \x01(\tR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\tR\aactorId\x12&\n" +
+	"\x0fclaimed_user_id\x18\a \x01(\tR\rclaimedUserId\x12\x16\n" +
+	"\x06target\x18\b \x01(\tR\x06target\x12\x18\n" +
+	"\arequest\x18\t \x01(\tR\arequest\x12\x16\n" +
+	"\x06before\x18\n" +
+	" \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\v \x01(\tR\x05after\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\r \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x0e \x01(\tR\trequestId\x12\x1b\n" +
+	"\tsource_ip\x18\x0f \x01(\tR\bsourceIp\x12\x1d\n" +
+	"\n" +
+	"claimed_ip\x18\x10 \x01(\tR\tclaimedIp\"\xb5\x01\n" +
+	"\x13ListAuditLogRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\"\x98\x01\n" +
+	"\x14ListAuditLogResponse\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.accounts.AuditEntryR\aentries\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x83\x04\n" +
+	"\vPayoutBatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12*\n" +
+	"\x11source_account_id\x18\x04 \x01(\tR\x0fsourceAccountId\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x05 \x01(\x05R\ttotalRows\x12\x1d\n" +
+	"\n" +
+	"valid_rows\x18\x06 \x01(\x05R\tvalidRows\x12!\n" +
+	"\finvalid_rows\x18\a \x01(\x05R\vinvalidRows\x12!\n" +
+	"\ftotal_amount\x18\b \x01(\tR\vtotalAmount\x12\x1b\n" +
+	"\tpaid_rows\x18\t \x01(\x05R\bpaidRows\x12\x1f\n" +
+	"\vfailed_rows\x18\n" +
+	" \x01(\x05R\n" +
+	"failedRows\x12\x1f\n" +
+	"\vpaid_amount\x18\v \x01(\tR\n" +
+	"paidAmount\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\f \x01(\tR\tcreatedBy\x12\x1f\n" +
+	"\vapproved_by\x18\r \x01(\tR\n" +
+	"approvedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vapproved_at\x18\x0f \x01(\tR\n" +
+	"approvedAt\x12!\n" +
+	"\fcompleted_at\x18\x10 \x01(\tR\vcompletedAt\"\xeb\x01\n" +
+	"\tPayoutRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x1c\n" +
+	"\trecipient\x18\x02 \x01(\tR\trecipient\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12%\n" +
+	"\x0etransaction_id\x18\a \x01(\tR\rtransactionId\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"W\n" +
+	"\x1bFundDisbursementPoolRequest\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\tR\x06amount\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"~\n" +
+	"\x1cFundDisbursementPoolResponse\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\tR\abalance\"i\n" +
+	"\x18CreatePayoutBatchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"H\n" +
+	"\x19CreatePayoutBatchResponse\x12+\n" +
+	"\x05batch\x18\x01 \x01(\v2\x15.accounts.PayoutBatchR\x05batch\"4\n" +
+	"\x15GetPayoutBatchRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\tR\bpayoutId\"E\n" +
+	"\x16GetPayoutBatchResponse\x12+\n" +
+	"\x05batch\x18\x01 \x01(\v2\x15.accounts.PayoutBatchR\x05batch\"c\n" +
+	"\x18ListPayoutBatchesRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x9e\x01\n" +
+	"\x19ListPayoutBatchesResponse\x12/\n" +
+	"\abatches\x18\x01 \x03(\v2\x15.accounts.PayoutBatchR\abatches\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"}\n" +
+	"\x15ListPayoutRowsRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\tR\bpayoutId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x93\x01\n" +
+	"\x16ListPayoutRowsResponse\x12'\n" +
+	"\x04rows\x18\x01 \x03(\v2\x13.accounts.PayoutRowR\x04rows\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"P\n" +
+	"\x18PayoutBatchActionRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\tR\bpayoutId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"H\n" +
+	"\x19PayoutBatchActionResponse\x12+\n" +
+	"\x05batch\x18\x01 \x01(\v2\x15.accounts.PayoutBatchR\x05batch\"9\n" +
+	"\x1aExportPayoutResultsRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\tR\bpayoutId\"p\n" +
+	"\x1bExportPayoutResultsResponse\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data2\xe9$\n" +
 	"\x0fAccountsService\x12P\n" +
 	"\rCreateAccount\x12\x1e.accounts.CreateAccountRequest\x1a\x1f.accounts.CreateAccountResponse\x12G\n" +
 	"\n" +
 	"GetAccount\x12\x1b.accounts.GetAccountRequest\x1a\x1c.accounts.GetAccountResponse\x12G\n" +
 	"\n" +
-	"GetBalance\x12\x1b.accounts.GetBalanceRequest\x1a\x1c.accounts.GetBalanceResponse\x12>\n" +
+	"GetBalance\x12\x1b.accounts.GetBalanceRequest\x1a\x1c.accounts.GetBalanceResponse\x12G\n" +
+	"\n" +
+	"OpenWallet\x12\x1b.accounts.OpenWalletRequest\x1a\x1c.accounts.OpenWalletResponse\x12b\n" +
+	"\x13ListAccountsForUser\x12$.accounts.ListAccountsForUserRequest\x1a%.accounts.ListAccountsForUserResponse\x12Y\n" +
+	"\x10SetDefaultWallet\x12!.accounts.SetDefaultWalletRequest\x1a\".accounts.SetDefaultWalletResponse\x12>\n" +
+	"\aGetUser\x12\x18.accounts.GetUserRequest\x1a\x19.accounts.GetUserResponse\x12P\n" +
+	"\rUpdateProfile\x12\x1e.accounts.UpdateProfileRequest\x1a\x1f.accounts.UpdateProfileResponse\x12_\n" +
+	"\x12RequestEmailChange\x12#.accounts.RequestEmailChangeRequest\x1a$.accounts.RequestEmailChangeResponse\x12_\n" +
+	"\x12ConfirmEmailChange\x12#.accounts.ConfirmEmailChangeRequest\x1a$.accounts.ConfirmEmailChangeResponse\x12t\n" +
+	"\x1aGetNotificationPreferences\x12+.accounts.GetNotificationPreferencesRequest\x1a).accounts.NotificationPreferencesResponse\x12t\n" +
+	"\x1aSetNotificationPreferences\x12+.accounts.SetNotificationPreferencesRequest\x1a).accounts.NotificationPreferencesResponse\x12\\\n" +
+	"\x11ListNotifications\x12\".accounts.ListNotificationsRequest\x1a#.accounts.ListNotificationsResponse\x12V\n" +
+	"\x0fRegisterWebhook\x12 .accounts.RegisterWebhookRequest\x1a!.accounts.RegisterWebhookResponse\x12M\n" +
+	"\fListWebhooks\x12\x1d.accounts.ListWebhooksRequest\x1a\x1e.accounts.ListWebhooksResponse\x12P\n" +
+	"\rDeleteWebhook\x12\x1e.accounts.DeleteWebhookRequest\x1a\x1f.accounts.DeleteWebhookResponse\x12h\n" +
+	"\x15ListWebhookDeliveries\x12&.accounts.ListWebhookDeliveriesRequest\x1a'.accounts.ListWebhookDeliveriesResponse\x12_\n" +
+	"\x12GetWebhookDelivery\x12#.accounts.GetWebhookDeliveryRequest\x1a$.accounts.GetWebhookDeliveryResponse\x12h\n" +
+	"\x15ReplayWebhookDelivery\x12&.accounts.ReplayWebhookDeliveryRequest\x1a'.accounts.ReplayWebhookDeliveryResponse\x12>\n" +
 	"\aDeposit\x12\x18.accounts.DepositRequest\x1a\x19.accounts.DepositResponse\x12A\n" +
 	"\bWithdraw\x12\x19.accounts.WithdrawRequest\x1a\x1a.accounts.WithdrawResponse\x12A\n" +
-	"\bTransfer\x12\x19.accounts.TransferRequest\x1a\x1a.accounts.TransferResponse\x12h\n" +
-	"\x15GetTransactionHistory\x12&.accounts.GetTransactionHistoryRequest\x1a'.accounts.GetTransactionHistoryResponseB2Z0github.com/ChotongW/grit_demo_wallet/pb/accountsb\x06proto3"
+	"\bTransfer\x12\x19.accounts.TransferRequest\x1a\x1a.accounts.TransferResponse\x12A\n" +
+	"\bQuoteFee\x12\x19.accounts.QuoteFeeRequest\x1a\x1a.accounts.QuoteFeeResponse\x12V\n" +
+	"\x0fLookupRecipient\x12 .accounts.LookupRecipientRequest\x1a!.accounts.LookupRecipientResponse\x12D\n" +
+	"\tSetHandle\x12\x1a.accounts.SetHandleRequest\x1a\x1b.accounts.SetHandleResponse\x12n\n" +
+	"\x17CreateScheduledTransfer\x12(.accounts.CreateScheduledTransferRequest\x1a).accounts.CreateScheduledTransferResponse\x12k\n" +
+	"\x16ListScheduledTransfers\x12'.accounts.ListScheduledTransfersRequest\x1a(.accounts.ListScheduledTransfersResponse\x12m\n" +
+	"\x16PauseScheduledTransfer\x12(.accounts.ScheduledTransferActionRequest\x1a).accounts.ScheduledTransferActionResponse\x12n\n" +
+	"\x17ResumeScheduledTransfer\x12(.accounts.ScheduledTransferActionRequest\x1a).accounts.ScheduledTransferActionResponse\x12n\n" +
+	"\x17CancelScheduledTransfer\x12(.accounts.ScheduledTransferActionRequest\x1a).accounts.ScheduledTransferActionResponse\x12e\n" +
+	"\x14CreatePaymentRequest\x12%.accounts.CreatePaymentRequestRequest\x1a&.accounts.CreatePaymentRequestResponse\x12e\n" +
+	"\x14AcceptPaymentRequest\x12%.accounts.PaymentRequestActionRequest\x1a&.accounts.AcceptPaymentRequestResponse\x12f\n" +
+	"\x15DeclinePaymentRequest\x12%.accounts.PaymentRequestActionRequest\x1a&.accounts.PaymentRequestActionResponse\x12e\n" +
+	"\x14CancelPaymentRequest\x12%.accounts.PaymentRequestActionRequest\x1a&.accounts.PaymentRequestActionResponse\x12b\n" +
+	"\x13ListPaymentRequests\x12$.accounts.ListPaymentRequestsRequest\x1a%.accounts.ListPaymentRequestsResponse\x12h\n" +
+	"\x15GetTransactionHistory\x12&.accounts.GetTransactionHistoryRequest\x1a'.accounts.GetTransactionHistoryResponse\x12S\n" +
+	"\x0eGetTransaction\x12\x1f.accounts.GetTransactionRequest\x1a .accounts.GetTransactionResponse\x12S\n" +
+	"\x11GenerateStatement\x12\".accounts.GenerateStatementRequest\x1a\x18.accounts.StatementChunk0\x01\x12_\n" +
+	"\x12ListSystemAccounts\x12#.accounts.ListSystemAccountsRequest\x1a$.accounts.ListSystemAccountsResponse\x12Y\n" +
+	"\x10SetSystemAccount\x12!.accounts.SetSystemAccountRequest\x1a\".accounts.SetSystemAccountResponse\x12_\n" +
+	"\x12RunInterestAccrual\x12#.accounts.RunInterestAccrualRequest\x1a$.accounts.RunInterestAccrualResponse\x12\\\n" +
+	"\x11RunInterestPayout\x12\".accounts.RunInterestPayoutRequest\x1a#.accounts.RunInterestPayoutResponse\x12M\n" +
+	"\fListAuditLog\x12\x1d.accounts.ListAuditLogRequest\x1a\x1e.accounts.ListAuditLogResponse\x12e\n" +
+	"\x14FundDisbursementPool\x12%.accounts.FundDisbursementPoolRequest\x1a&.accounts.FundDisbursementPoolResponse\x12\\\n" +
+	"\x11CreatePayoutBatch\x12\".accounts.CreatePayoutBatchRequest\x1a#.accounts.CreatePayoutBatchResponse\x12S\n" +
+	"\x0eGetPayoutBatch\x12\x1f.accounts.GetPayoutBatchRequest\x1a .accounts.GetPayoutBatchResponse\x12\\\n" +
+	"\x11ListPayoutBatches\x12\".accounts.ListPayoutBatchesRequest\x1a#.accounts.ListPayoutBatchesResponse\x12S\n" +
+	"\x0eListPayoutRows\x12\x1f.accounts.ListPayoutRowsRequest\x1a .accounts.ListPayoutRowsResponse\x12]\n" +
+	"\x12ApprovePayoutBatch\x12\".accounts.PayoutBatchActionRequest\x1a#.accounts.PayoutBatchActionResponse\x12\\\n" +
+	"\x11CancelPayoutBatch\x12\".accounts.PayoutBatchActionRequest\x1a#.accounts.PayoutBatchActionResponse\x12b\n" +
+	"\x13ExportPayoutResults\x12$.accounts.ExportPayoutResultsRequest\x1a%.accounts.ExportPayoutResultsResponseB2Z0github.com/ChotongW/grit_demo_wallet/pb/accountsb\x06proto3"
 
 var (
 	file_accounts_accounts_proto_rawDescOnce sync.Once
@@ -1193,48 +8100,273 @@ func file_accounts_accounts_proto_rawDescGZIP() []byte {
 	return file_accounts_accounts_proto_rawDescData
 }
 
-var file_accounts_accounts_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_accounts_accounts_proto_msgTypes = make([]protoimpl.MessageInfo, 112)
 var file_accounts_accounts_proto_goTypes = []any{
-	(*CreateAccountRequest)(nil),          // 0: accounts.CreateAccountRequest
-	(*CreateAccountResponse)(nil),         // 1: accounts.CreateAccountResponse
-	(*GetAccountRequest)(nil),             // 2: accounts.GetAccountRequest
-	(*GetAccountResponse)(nil),            // 3: accounts.GetAccountResponse
-	(*GetBalanceRequest)(nil),             // 4: accounts.GetBalanceRequest
-	(*GetBalanceResponse)(nil),            // 5: accounts.GetBalanceResponse
-	(*DepositRequest)(nil),                // 6: accounts.DepositRequest
-	(*DepositResponse)(nil),               // 7: accounts.DepositResponse
-	(*WithdrawRequest)(nil),               // 8: accounts.WithdrawRequest
-	(*WithdrawResponse)(nil),              // 9: accounts.WithdrawResponse
-	(*TransferRequest)(nil),               // 10: accounts.TransferRequest
-	(*TransferResponse)(nil),              // 11: accounts.TransferResponse
-	(*GetTransactionHistoryRequest)(nil),  // 12: accounts.GetTransactionHistoryRequest
-	(*GetTransactionHistoryResponse)(nil), // 13: accounts.GetTransactionHistoryResponse
-	(*Account)(nil),                       // 14: accounts.Account
-	(*Transaction)(nil),                   // 15: accounts.Transaction
+	(*CreateAccountRequest)(nil),              // 0: accounts.CreateAccountRequest
+	(*CreateAccountResponse)(nil),             // 1: accounts.CreateAccountResponse
+	(*GetAccountRequest)(nil),                 // 2: accounts.GetAccountRequest
+	(*GetAccountResponse)(nil),                // 3: accounts.GetAccountResponse
+	(*GetBalanceRequest)(nil),                 // 4: accounts.GetBalanceRequest
+	(*GetBalanceResponse)(nil),                // 5: accounts.GetBalanceResponse
+	(*DepositRequest)(nil),                    // 6: accounts.DepositRequest
+	(*DepositResponse)(nil),                   // 7: accounts.DepositResponse
+	(*WithdrawRequest)(nil),                   // 8: accounts.WithdrawRequest
+	(*WithdrawResponse)(nil),                  // 9: accounts.WithdrawResponse
+	(*TransferRequest)(nil),                   // 10: accounts.TransferRequest
+	(*TransferResponse)(nil),                  // 11: accounts.TransferResponse
+	(*Recipient)(nil),                         // 12: accounts.Recipient
+	(*LookupRecipientRequest)(nil),            // 13: accounts.LookupRecipientRequest
+	(*LookupRecipientResponse)(nil),           // 14: accounts.LookupRecipientResponse
+	(*SetHandleRequest)(nil),                  // 15: accounts.SetHandleRequest
+	(*SetHandleResponse)(nil),                 // 16: accounts.SetHandleResponse
+	(*FeeItem)(nil),                           // 17: accounts.FeeItem
+	(*FeeBreakdown)(nil),                      // 18: accounts.FeeBreakdown
+	(*QuoteFeeRequest)(nil),                   // 19: accounts.QuoteFeeRequest
+	(*QuoteFeeResponse)(nil),                  // 20: accounts.QuoteFeeResponse
+	(*GetTransactionHistoryRequest)(nil),      // 21: accounts.GetTransactionHistoryRequest
+	(*GetTransactionHistoryResponse)(nil),     // 22: accounts.GetTransactionHistoryResponse
+	(*Account)(nil),                           // 23: accounts.Account
+	(*OpenWalletRequest)(nil),                 // 24: accounts.OpenWalletRequest
+	(*OpenWalletResponse)(nil),                // 25: accounts.OpenWalletResponse
+	(*ListAccountsForUserRequest)(nil),        // 26: accounts.ListAccountsForUserRequest
+	(*ListAccountsForUserResponse)(nil),       // 27: accounts.ListAccountsForUserResponse
+	(*SetDefaultWalletRequest)(nil),           // 28: accounts.SetDefaultWalletRequest
+	(*SetDefaultWalletResponse)(nil),          // 29: accounts.SetDefaultWalletResponse
+	(*User)(nil),                              // 30: accounts.User
+	(*GetUserRequest)(nil),                    // 31: accounts.GetUserRequest
+	(*GetUserResponse)(nil),                   // 32: accounts.GetUserResponse
+	(*UpdateProfileRequest)(nil),              // 33: accounts.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),             // 34: accounts.UpdateProfileResponse
+	(*RequestEmailChangeRequest)(nil),         // 35: accounts.RequestEmailChangeRequest
+	(*RequestEmailChangeResponse)(nil),        // 36: accounts.RequestEmailChangeResponse
+	(*ConfirmEmailChangeRequest)(nil),         // 37: accounts.ConfirmEmailChangeRequest
+	(*ConfirmEmailChangeResponse)(nil),        // 38: accounts.ConfirmEmailChangeResponse
+	(*NotificationPreferences)(nil),           // 39: accounts.NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil), // 40: accounts.GetNotificationPreferencesRequest
+	(*SetNotificationPreferencesRequest)(nil), // 41: accounts.SetNotificationPreferencesRequest
+	(*NotificationPreferencesResponse)(nil),   // 42: accounts.NotificationPreferencesResponse
+	(*Notification)(nil),                      // 43: accounts.Notification
+	(*ListNotificationsRequest)(nil),          // 44: accounts.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),         // 45: accounts.ListNotificationsResponse
+	(*Webhook)(nil),                           // 46: accounts.Webhook
+	(*RegisterWebhookRequest)(nil),            // 47: accounts.RegisterWebhookRequest
+	(*RegisterWebhookResponse)(nil),           // 48: accounts.RegisterWebhookResponse
+	(*ListWebhooksRequest)(nil),               // 49: accounts.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),              // 50: accounts.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),              // 51: accounts.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),             // 52: accounts.DeleteWebhookResponse
+	(*WebhookDelivery)(nil),                   // 53: accounts.WebhookDelivery
+	(*WebhookAttempt)(nil),                    // 54: accounts.WebhookAttempt
+	(*ListWebhookDeliveriesRequest)(nil),      // 55: accounts.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),     // 56: accounts.ListWebhookDeliveriesResponse
+	(*GetWebhookDeliveryRequest)(nil),         // 57: accounts.GetWebhookDeliveryRequest
+	(*GetWebhookDeliveryResponse)(nil),        // 58: accounts.GetWebhookDeliveryResponse
+	(*ReplayWebhookDeliveryRequest)(nil),      // 59: accounts.ReplayWebhookDeliveryRequest
+	(*ReplayWebhookDeliveryResponse)(nil),     // 60: accounts.ReplayWebhookDeliveryResponse
+	(*Transaction)(nil),                       // 61: accounts.Transaction
+	(*Counterparty)(nil),                      // 62: accounts.Counterparty
+	(*GetTransactionRequest)(nil),             // 63: accounts.GetTransactionRequest
+	(*TransactionLeg)(nil),                    // 64: accounts.TransactionLeg
+	(*TransactionDetail)(nil),                 // 65: accounts.TransactionDetail
+	(*GetTransactionResponse)(nil),            // 66: accounts.GetTransactionResponse
+	(*GenerateStatementRequest)(nil),          // 67: accounts.GenerateStatementRequest
+	(*StatementChunk)(nil),                    // 68: accounts.StatementChunk
+	(*SystemAccount)(nil),                     // 69: accounts.SystemAccount
+	(*ListSystemAccountsRequest)(nil),         // 70: accounts.ListSystemAccountsRequest
+	(*ListSystemAccountsResponse)(nil),        // 71: accounts.ListSystemAccountsResponse
+	(*SetSystemAccountRequest)(nil),           // 72: accounts.SetSystemAccountRequest
+	(*SetSystemAccountResponse)(nil),          // 73: accounts.SetSystemAccountResponse
+	(*RunInterestAccrualRequest)(nil),         // 74: accounts.RunInterestAccrualRequest
+	(*RunInterestAccrualResponse)(nil),        // 75: accounts.RunInterestAccrualResponse
+	(*RunInterestPayoutRequest)(nil),          // 76: accounts.RunInterestPayoutRequest
+	(*RunInterestPayoutResponse)(nil),         // 77: accounts.RunInterestPayoutResponse
+	(*ScheduledTransfer)(nil),                 // 78: accounts.ScheduledTransfer
+	(*CreateScheduledTransferRequest)(nil),    // 79: accounts.CreateScheduledTransferRequest
+	(*CreateScheduledTransferResponse)(nil),   // 80: accounts.CreateScheduledTransferResponse
+	(*ListScheduledTransfersRequest)(nil),     // 81: accounts.ListScheduledTransfersRequest
+	(*ListScheduledTransfersResponse)(nil),    // 82: accounts.ListScheduledTransfersResponse
+	(*ScheduledTransferActionRequest)(nil),    // 83: accounts.ScheduledTransferActionRequest
+	(*ScheduledTransferActionResponse)(nil),   // 84: accounts.ScheduledTransferActionResponse
+	(*PaymentRequest)(nil),                    // 85: accounts.PaymentRequest
+	(*CreatePaymentRequestRequest)(nil),       // 86: accounts.CreatePaymentRequestRequest
+	(*CreatePaymentRequestResponse)(nil),      // 87: accounts.CreatePaymentRequestResponse
+	(*PaymentRequestActionRequest)(nil),       // 88: accounts.PaymentRequestActionRequest
+	(*PaymentRequestActionResponse)(nil),      // 89: accounts.PaymentRequestActionResponse
+	(*AcceptPaymentRequestResponse)(nil),      // 90: accounts.AcceptPaymentRequestResponse
+	(*ListPaymentRequestsRequest)(nil),        // 91: accounts.ListPaymentRequestsRequest
+	(*ListPaymentRequestsResponse)(nil),       // 92: accounts.ListPaymentRequestsResponse
+	(*AuditEntry)(nil),                        // 93: accounts.AuditEntry
+	(*ListAuditLogRequest)(nil),               // 94: accounts.ListAuditLogRequest
+	(*ListAuditLogResponse)(nil),              // 95: accounts.ListAuditLogResponse
+	(*PayoutBatch)(nil),                       // 96: accounts.PayoutBatch
+	(*PayoutRow)(nil),                         // 97: accounts.PayoutRow
+	(*FundDisbursementPoolRequest)(nil),       // 98: accounts.FundDisbursementPoolRequest
+	(*FundDisbursementPoolResponse)(nil),      // 99: accounts.FundDisbursementPoolResponse
+	(*CreatePayoutBatchRequest)(nil),          // 100: accounts.CreatePayoutBatchRequest
+	(*CreatePayoutBatchResponse)(nil),         // 101: accounts.CreatePayoutBatchResponse
+	(*GetPayoutBatchRequest)(nil),             // 102: accounts.GetPayoutBatchRequest
+	(*GetPayoutBatchResponse)(nil),            // 103: accounts.GetPayoutBatchResponse
+	(*ListPayoutBatchesRequest)(nil),          // 104: accounts.ListPayoutBatchesRequest
+	(*ListPayoutBatchesResponse)(nil),         // 105: accounts.ListPayoutBatchesResponse
+	(*ListPayoutRowsRequest)(nil),             // 106: accounts.ListPayoutRowsRequest
+	(*ListPayoutRowsResponse)(nil),            // 107: accounts.ListPayoutRowsResponse
+	(*PayoutBatchActionRequest)(nil),          // 108: accounts.PayoutBatchActionRequest
+	(*PayoutBatchActionResponse)(nil),         // 109: accounts.PayoutBatchActionResponse
+	(*ExportPayoutResultsRequest)(nil),        // 110: accounts.ExportPayoutResultsRequest
+	(*ExportPayoutResultsResponse)(nil),       // 111: accounts.ExportPayoutResultsResponse
 }
 var file_accounts_accounts_proto_depIdxs = []int32{
-	14, // 0: accounts.CreateAccountResponse.account:type_name -> accounts.Account
-	14, // 1: accounts.GetAccountResponse.account:type_name -> accounts.Account
-	15, // 2: accounts.GetTransactionHistoryResponse.transactions:type_name -> accounts.Transaction
-	0,  // 3: accounts.AccountsService.CreateAccount:input_type -> accounts.CreateAccountRequest
-	2,  // 4: accounts.AccountsService.GetAccount:input_type -> accounts.GetAccountRequest
-	4,  // 5: accounts.AccountsService.GetBalance:input_type -> accounts.GetBalanceRequest
-	6,  // 6: accounts.AccountsService.Deposit:input_type -> accounts.DepositRequest
-	8,  // 7: accounts.AccountsService.Withdraw:input_type -> accounts.WithdrawRequest
-	10, // 8: accounts.AccountsService.Transfer:input_type -> accounts.TransferRequest
-	12, // 9: accounts.AccountsService.GetTransactionHistory:input_type -> accounts.GetTransactionHistoryRequest
-	1,  // 10: accounts.AccountsService.CreateAccount:output_type -> accounts.CreateAccountResponse
-	3,  // 11: accounts.AccountsService.GetAccount:output_type -> accounts.GetAccountResponse
-	5,  // 12: accounts.AccountsService.GetBalance:output_type -> accounts.GetBalanceResponse
-	7,  // 13: accounts.AccountsService.Deposit:output_type -> accounts.DepositResponse
-	9,  // 14: accounts.AccountsService.Withdraw:output_type -> accounts.WithdrawResponse
-	11, // 15: accounts.AccountsService.Transfer:output_type -> accounts.TransferResponse
-	13, // 16: accounts.AccountsService.GetTransactionHistory:output_type -> accounts.GetTransactionHistoryResponse
-	10, // [10:17] is the sub-list for method output_type
-	3,  // [3:10] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	23,  // 0: accounts.CreateAccountResponse.account:type_name -> accounts.Account
+	23,  // 1: accounts.GetAccountResponse.account:type_name -> accounts.Account
+	18,  // 2: accounts.WithdrawResponse.fee:type_name -> accounts.FeeBreakdown
+	18,  // 3: accounts.TransferResponse.fee:type_name -> accounts.FeeBreakdown
+	12,  // 4: accounts.TransferResponse.recipient:type_name -> accounts.Recipient
+	12,  // 5: accounts.LookupRecipientResponse.recipient:type_name -> accounts.Recipient
+	23,  // 6: accounts.SetHandleResponse.account:type_name -> accounts.Account
+	17,  // 7: accounts.FeeBreakdown.items:type_name -> accounts.FeeItem
+	18,  // 8: accounts.QuoteFeeResponse.fee:type_name -> accounts.FeeBreakdown
+	61,  // 9: accounts.GetTransactionHistoryResponse.transactions:type_name -> accounts.Transaction
+	23,  // 10: accounts.OpenWalletResponse.account:type_name -> accounts.Account
+	23,  // 11: accounts.ListAccountsForUserResponse.accounts:type_name -> accounts.Account
+	23,  // 12: accounts.SetDefaultWalletResponse.account:type_name -> accounts.Account
+	30,  // 13: accounts.GetUserResponse.user:type_name -> accounts.User
+	30,  // 14: accounts.UpdateProfileResponse.user:type_name -> accounts.User
+	30,  // 15: accounts.ConfirmEmailChangeResponse.user:type_name -> accounts.User
+	39,  // 16: accounts.NotificationPreferencesResponse.preferences:type_name -> accounts.NotificationPreferences
+	43,  // 17: accounts.ListNotificationsResponse.notifications:type_name -> accounts.Notification
+	46,  // 18: accounts.RegisterWebhookResponse.webhook:type_name -> accounts.Webhook
+	46,  // 19: accounts.ListWebhooksResponse.webhooks:type_name -> accounts.Webhook
+	53,  // 20: accounts.ListWebhookDeliveriesResponse.deliveries:type_name -> accounts.WebhookDelivery
+	53,  // 21: accounts.GetWebhookDeliveryResponse.delivery:type_name -> accounts.WebhookDelivery
+	54,  // 22: accounts.GetWebhookDeliveryResponse.attempts:type_name -> accounts.WebhookAttempt
+	53,  // 23: accounts.ReplayWebhookDeliveryResponse.delivery:type_name -> accounts.WebhookDelivery
+	62,  // 24: accounts.Transaction.counterparties:type_name -> accounts.Counterparty
+	64,  // 25: accounts.TransactionDetail.legs:type_name -> accounts.TransactionLeg
+	62,  // 26: accounts.TransactionDetail.counterparties:type_name -> accounts.Counterparty
+	65,  // 27: accounts.GetTransactionResponse.transaction:type_name -> accounts.TransactionDetail
+	69,  // 28: accounts.ListSystemAccountsResponse.system_accounts:type_name -> accounts.SystemAccount
+	69,  // 29: accounts.SetSystemAccountResponse.system_account:type_name -> accounts.SystemAccount
+	78,  // 30: accounts.CreateScheduledTransferResponse.scheduled_transfer:type_name -> accounts.ScheduledTransfer
+	78,  // 31: accounts.ListScheduledTransfersResponse.scheduled_transfers:type_name -> accounts.ScheduledTransfer
+	78,  // 32: accounts.ScheduledTransferActionResponse.scheduled_transfer:type_name -> accounts.ScheduledTransfer
+	85,  // 33: accounts.CreatePaymentRequestResponse.payment_request:type_name -> accounts.PaymentRequest
+	85,  // 34: accounts.PaymentRequestActionResponse.payment_request:type_name -> accounts.PaymentRequest
+	85,  // 35: accounts.AcceptPaymentRequestResponse.payment_request:type_name -> accounts.PaymentRequest
+	18,  // 36: accounts.AcceptPaymentRequestResponse.fee:type_name -> accounts.FeeBreakdown
+	85,  // 37: accounts.ListPaymentRequestsResponse.payment_requests:type_name -> accounts.PaymentRequest
+	93,  // 38: accounts.ListAuditLogResponse.entries:type_name -> accounts.AuditEntry
+	96,  // 39: accounts.CreatePayoutBatchResponse.batch:type_name -> accounts.PayoutBatch
+	96,  // 40: accounts.GetPayoutBatchResponse.batch:type_name -> accounts.PayoutBatch
+	96,  // 41: accounts.ListPayoutBatchesResponse.batches:type_name -> accounts.PayoutBatch
+	97,  // 42: accounts.ListPayoutRowsResponse.rows:type_name -> accounts.PayoutRow
+	96,  // 43: accounts.PayoutBatchActionResponse.batch:type_name -> accounts.PayoutBatch
+	0,   // 44: accounts.AccountsService.CreateAccount:input_type -> accounts.CreateAccountRequest
+	2,   // 45: accounts.AccountsService.GetAccount:input_type -> accounts.GetAccountRequest
+	4,   // 46: accounts.AccountsService.GetBalance:input_type -> accounts.GetBalanceRequest
+	24,  // 47: accounts.AccountsService.OpenWallet:input_type -> accounts.OpenWalletRequest
+	26,  // 48: accounts.AccountsService.ListAccountsForUser:input_type -> accounts.ListAccountsForUserRequest
+	28,  // 49: accounts.AccountsService.SetDefaultWallet:input_type -> accounts.SetDefaultWalletRequest
+	31,  // 50: accounts.AccountsService.GetUser:input_type -> accounts.GetUserRequest
+	33,  // 51: accounts.AccountsService.UpdateProfile:input_type -> accounts.UpdateProfileRequest
+	35,  // 52: accounts.AccountsService.RequestEmailChange:input_type -> accounts.RequestEmailChangeRequest
+	37,  // 53: accounts.AccountsService.ConfirmEmailChange:input_type -> accounts.ConfirmEmailChangeRequest
+	40,  // 54: accounts.AccountsService.GetNotificationPreferences:input_type -> accounts.GetNotificationPreferencesRequest
+	41,  // 55: accounts.AccountsService.SetNotificationPreferences:input_type -> accounts.SetNotificationPreferencesRequest
+	44,  // 56: accounts.AccountsService.ListNotifications:input_type -> accounts.ListNotificationsRequest
+	47,  // 57: accounts.AccountsService.RegisterWebhook:input_type -> accounts.RegisterWebhookRequest
+	49,  // 58: accounts.AccountsService.ListWebhooks:input_type -> accounts.ListWebhooksRequest
+	51,  // 59: accounts.AccountsService.DeleteWebhook:input_type -> accounts.DeleteWebhookRequest
+	55,  // 60: accounts.AccountsService.ListWebhookDeliveries:input_type -> accounts.ListWebhookDeliveriesRequest
+	57,  // 61: accounts.AccountsService.GetWebhookDelivery:input_type -> accounts.GetWebhookDeliveryRequest
+	59,  // 62: accounts.AccountsService.ReplayWebhookDelivery:input_type -> accounts.ReplayWebhookDeliveryRequest
+	6,   // 63: accounts.AccountsService.Deposit:input_type -> accounts.DepositRequest
+	8,   // 64: accounts.AccountsService.Withdraw:input_type -> accounts.WithdrawRequest
+	10,  // 65: accounts.AccountsService.Transfer:input_type -> accounts.TransferRequest
+	19,  // 66: accounts.AccountsService.QuoteFee:input_type -> accounts.QuoteFeeRequest
+	13,  // 67: accounts.AccountsService.LookupRecipient:input_type -> accounts.LookupRecipientRequest
+	15,  // 68: accounts.AccountsService.SetHandle:input_type -> accounts.SetHandleRequest
+	79,  // 69: accounts.AccountsService.CreateScheduledTransfer:input_type -> accounts.CreateScheduledTransferRequest
+	81,  // 70: accounts.AccountsService.ListScheduledTransfers:input_type -> accounts.ListScheduledTransfersRequest
+	83,  // 71: accounts.AccountsService.PauseScheduledTransfer:input_type -> accounts.ScheduledTransferActionRequest
+	83,  // 72: accounts.AccountsService.ResumeScheduledTransfer:input_type -> accounts.ScheduledTransferActionRequest
+	83,  // 73: accounts.AccountsService.CancelScheduledTransfer:input_type -> accounts.ScheduledTransferActionRequest
+	86,  // 74: accounts.AccountsService.CreatePaymentRequest:input_type -> accounts.CreatePaymentRequestRequest
+	88,  // 75: accounts.AccountsService.AcceptPaymentRequest:input_type -> accounts.PaymentRequestActionRequest
+	88,  // 76: accounts.AccountsService.DeclinePaymentRequest:input_type -> accounts.PaymentRequestActionRequest
+	88,  // 77: accounts.AccountsService.CancelPaymentRequest:input_type -> accounts.PaymentRequestActionRequest
+	91,  // 78: accounts.AccountsService.ListPaymentRequests:input_type -> accounts.ListPaymentRequestsRequest
+	21,  // 79: accounts.AccountsService.GetTransactionHistory:input_type -> accounts.GetTransactionHistoryRequest
+	63,  // 80: accounts.AccountsService.GetTransaction:input_type -> accounts.GetTransactionRequest
+	67,  // 81: accounts.AccountsService.GenerateStatement:input_type -> accounts.GenerateStatementRequest
+	70,  // 82: accounts.AccountsService.ListSystemAccounts:input_type -> accounts.ListSystemAccountsRequest
+	72,  // 83: accounts.AccountsService.SetSystemAccount:input_type -> accounts.SetSystemAccountRequest
+	74,  // 84: accounts.AccountsService.RunInterestAccrual:input_type -> accounts.RunInterestAccrualRequest
+	76,  // 85: accounts.AccountsService.RunInterestPayout:input_type -> accounts.RunInterestPayoutRequest
+	94,  // 86: accounts.AccountsService.ListAuditLog:input_type -> accounts.ListAuditLogRequest
+	98,  // 87: accounts.AccountsService.FundDisbursementPool:input_type -> accounts.FundDisbursementPoolRequest
+	100, // 88: accounts.AccountsService.CreatePayoutBatch:input_type -> accounts.CreatePayoutBatchRequest
+	102, // 89: accounts.AccountsService.GetPayoutBatch:input_type -> accounts.GetPayoutBatchRequest
+	104, // 90: accounts.AccountsService.ListPayoutBatches:input_type -> accounts.ListPayoutBatchesRequest
+	106, // 91: accounts.AccountsService.ListPayoutRows:input_type -> accounts.ListPayoutRowsRequest
+	108, // 92: accounts.AccountsService.ApprovePayoutBatch:input_type -> accounts.PayoutBatchActionRequest
+	108, // 93: accounts.AccountsService.CancelPayoutBatch:input_type -> accounts.PayoutBatchActionRequest
+	110, // 94: accounts.AccountsService.ExportPayoutResults:input_type -> accounts.ExportPayoutResultsRequest
+	1,   // 95: accounts.AccountsService.CreateAccount:output_type -> accounts.CreateAccountResponse
+	3,   // 96: accounts.AccountsService.GetAccount:output_type -> accounts.GetAccountResponse
+	5,   // 97: accounts.AccountsService.GetBalance:output_type -> accounts.GetBalanceResponse
+	25,  // 98: accounts.AccountsService.OpenWallet:output_type -> accounts.OpenWalletResponse
+	27,  // 99: accounts.AccountsService.ListAccountsForUser:output_type -> accounts.ListAccountsForUserResponse
+	29,  // 100: accounts.AccountsService.SetDefaultWallet:output_type -> accounts.SetDefaultWalletResponse
+	32,  // 101: accounts.AccountsService.GetUser:output_type -> accounts.GetUserResponse
+	34,  // 102: accounts.AccountsService.UpdateProfile:output_type -> accounts.UpdateProfileResponse
+	36,  // 103: accounts.AccountsService.RequestEmailChange:output_type -> accounts.RequestEmailChangeResponse
+	38,  // 104: accounts.AccountsService.ConfirmEmailChange:output_type -> accounts.ConfirmEmailChangeResponse
+	42,  // 105: accounts.AccountsService.GetNotificationPreferences:output_type -> accounts.NotificationPreferencesResponse
+	42,  // 106: accounts.AccountsService.SetNotificationPreferences:output_type -> accounts.NotificationPreferencesResponse
+	45,  // 107: accounts.AccountsService.ListNotifications:output_type -> accounts.ListNotificationsResponse
+	48,  // 108: accounts.AccountsService.RegisterWebhook:output_type -> accounts.RegisterWebhookResponse
+	50,  // 109: accounts.AccountsService.ListWebhooks:output_type -> accounts.ListWebhooksResponse
+	52,  // 110: accounts.AccountsService.DeleteWebhook:output_type -> accounts.DeleteWebhookResponse
+	56,  // 111: accounts.AccountsService.ListWebhookDeliveries:output_type -> accounts.ListWebhookDeliveriesResponse
+	58,  // 112: accounts.AccountsService.GetWebhookDelivery:output_type -> accounts.GetWebhookDeliveryResponse
+	60,  // 113: accounts.AccountsService.ReplayWebhookDelivery:output_type -> accounts.ReplayWebhookDeliveryResponse
+	7,   // 114: accounts.AccountsService.Deposit:output_type -> accounts.DepositResponse
+	9,   // 115: accounts.AccountsService.Withdraw:output_type -> accounts.WithdrawResponse
+	11,  // 116: accounts.AccountsService.Transfer:output_type -> accounts.TransferResponse
+	20,  // 117: accounts.AccountsService.QuoteFee:output_type -> accounts.QuoteFeeResponse
+	14,  // 118: accounts.AccountsService.LookupRecipient:output_type -> accounts.LookupRecipientResponse
+	16,  // 119: accounts.AccountsService.SetHandle:output_type -> accounts.SetHandleResponse
+	80,  // 120: accounts.AccountsService.CreateScheduledTransfer:output_type -> accounts.CreateScheduledTransferResponse
+	82,  // 121: accounts.AccountsService.ListScheduledTransfers:output_type -> accounts.ListScheduledTransfersResponse
+	84,  // 122: accounts.AccountsService.PauseScheduledTransfer:output_type -> accounts.ScheduledTransferActionResponse
+	84,  // 123: accounts.AccountsService.ResumeScheduledTransfer:output_type -> accounts.ScheduledTransferActionResponse
+	84,  // 124: accounts.AccountsService.CancelScheduledTransfer:output_type -> accounts.ScheduledTransferActionResponse
+	87,  // 125: accounts.AccountsService.CreatePaymentRequest:output_type -> accounts.CreatePaymentRequestResponse
+	90,  // 126: accounts.AccountsService.AcceptPaymentRequest:output_type -> accounts.AcceptPaymentRequestResponse
+	89,  // 127: accounts.AccountsService.DeclinePaymentRequest:output_type -> accounts.PaymentRequestActionResponse
+	89,  // 128: accounts.AccountsService.CancelPaymentRequest:output_type -> accounts.PaymentRequestActionResponse
+	92,  // 129: accounts.AccountsService.ListPaymentRequests:output_type -> accounts.ListPaymentRequestsResponse
+	22,  // 130: accounts.AccountsService.GetTransactionHistory:output_type -> accounts.GetTransactionHistoryResponse
+	66,  // 131: accounts.AccountsService.GetTransaction:output_type -> accounts.GetTransactionResponse
+	68,  // 132: accounts.AccountsService.GenerateStatement:output_type -> accounts.StatementChunk
+	71,  // 133: accounts.AccountsService.ListSystemAccounts:output_type -> accounts.ListSystemAccountsResponse
+	73,  // 134: accounts.AccountsService.SetSystemAccount:output_type -> accounts.SetSystemAccountResponse
+	75,  // 135: accounts.AccountsService.RunInterestAccrual:output_type -> accounts.RunInterestAccrualResponse
+	77,  // 136: accounts.AccountsService.RunInterestPayout:output_type -> accounts.RunInterestPayoutResponse
+	95,  // 137: accounts.AccountsService.ListAuditLog:output_type -> accounts.ListAuditLogResponse
+	99,  // 138: accounts.AccountsService.FundDisbursementPool:output_type -> accounts.FundDisbursementPoolResponse
+	101, // 139: accounts.AccountsService.CreatePayoutBatch:output_type -> accounts.CreatePayoutBatchResponse
+	103, // 140: accounts.AccountsService.GetPayoutBatch:output_type -> accounts.GetPayoutBatchResponse
+	105, // 141: accounts.AccountsService.ListPayoutBatches:output_type -> accounts.ListPayoutBatchesResponse
+	107, // 142: accounts.AccountsService.ListPayoutRows:output_type -> accounts.ListPayoutRowsResponse
+	109, // 143: accounts.AccountsService.ApprovePayoutBatch:output_type -> accounts.PayoutBatchActionResponse
+	109, // 144: accounts.AccountsService.CancelPayoutBatch:output_type -> accounts.PayoutBatchActionResponse
+	111, // 145: accounts.AccountsService.ExportPayoutResults:output_type -> accounts.ExportPayoutResultsResponse
+	95,  // [95:146] is the sub-list for method output_type
+	44,  // [44:95] is the sub-list for method input_type
+	44,  // [44:44] is the sub-list for extension type_name
+	44,  // [44:44] is the sub-list for extension extendee
+	0,   // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_accounts_accounts_proto_init() }
@@ -1242,14 +8374,22 @@ func file_accounts_accounts_proto_init() {
 	if File_accounts_accounts_proto != nil {
 		return
 	}
-	file_accounts_accounts_proto_msgTypes[14].OneofWrappers = []any{}
+	file_accounts_accounts_proto_msgTypes[23].OneofWrappers = []any{}
+	file_accounts_accounts_proto_msgTypes[30].OneofWrappers = []any{}
+	file_accounts_accounts_proto_msgTypes[33].OneofWrappers = []any{}
+	file_accounts_accounts_proto_msgTypes[39].OneofWrappers = []any{}
+	file_accounts_accounts_proto_msgTypes[41].OneofWrappers = []any{}
+	file_accounts_accounts_proto_msgTypes[43].OneofWrappers = []any{}
+	file_accounts_accounts_proto_msgTypes[53].OneofWrappers = []any{}
+	file_accounts_accounts_proto_msgTypes[54].OneofWrappers = []any{}
+	file_accounts_accounts_proto_msgTypes[79].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_accounts_accounts_proto_rawDesc), len(file_accounts_accounts_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   112,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,13 +19,57 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AccountsService_CreateAccount_FullMethodName         = "/accounts.AccountsService/CreateAccount"
-	AccountsService_GetAccount_FullMethodName            = "/accounts.AccountsService/GetAccount"
-	AccountsService_GetBalance_FullMethodName            = "/accounts.AccountsService/GetBalance"
-	AccountsService_Deposit_FullMethodName               = "/accounts.AccountsService/Deposit"
-	AccountsService_Withdraw_FullMethodName              = "/accounts.AccountsService/Withdraw"
-	AccountsService_Transfer_FullMethodName              = "/accounts.AccountsService/Transfer"
-	AccountsService_GetTransactionHistory_FullMethodName = "/accounts.AccountsService/GetTransactionHistory"
+	AccountsService_CreateAccount_FullMethodName              = "/accounts.AccountsService/CreateAccount"
+	AccountsService_GetAccount_FullMethodName                 = "/accounts.AccountsService/GetAccount"
+	AccountsService_GetBalance_FullMethodName                 = "/accounts.AccountsService/GetBalance"
+	AccountsService_OpenWallet_FullMethodName                 = "/accounts.AccountsService/OpenWallet"
+	AccountsService_ListAccountsForUser_FullMethodName        = "/accounts.AccountsService/ListAccountsForUser"
+	AccountsService_SetDefaultWallet_FullMethodName           = "/accounts.AccountsService/SetDefaultWallet"
+	AccountsService_GetUser_FullMethodName                    = "/accounts.AccountsService/GetUser"
+	AccountsService_UpdateProfile_FullMethodName              = "/accounts.AccountsService/UpdateProfile"
+	AccountsService_RequestEmailChange_FullMethodName         = "/accounts.AccountsService/RequestEmailChange"
+	AccountsService_ConfirmEmailChange_FullMethodName         = "/accounts.AccountsService/ConfirmEmailChange"
+	AccountsService_GetNotificationPreferences_FullMethodName = "/accounts.AccountsService/GetNotificationPreferences"
+	AccountsService_SetNotificationPreferences_FullMethodName = "/accounts.AccountsService/SetNotificationPreferences"
+	AccountsService_ListNotifications_FullMethodName          = "/accounts.AccountsService/ListNotifications"
+	AccountsService_RegisterWebhook_FullMethodName            = "/accounts.AccountsService/RegisterWebhook"
+	AccountsService_ListWebhooks_FullMethodName               = "/accounts.AccountsService/ListWebhooks"
+	AccountsService_DeleteWebhook_FullMethodName              = "/accounts.AccountsService/DeleteWebhook"
+	AccountsService_ListWebhookDeliveries_FullMethodName      = "/accounts.AccountsService/ListWebhookDeliveries"
+	AccountsService_GetWebhookDelivery_FullMethodName         = "/accounts.AccountsService/GetWebhookDelivery"
+	AccountsService_ReplayWebhookDelivery_FullMethodName      = "/accounts.AccountsService/ReplayWebhookDelivery"
+	AccountsService_Deposit_FullMethodName                    = "/accounts.AccountsService/Deposit"
+	AccountsService_Withdraw_FullMethodName                   = "/accounts.AccountsService/Withdraw"
+	AccountsService_Transfer_FullMethodName                   = "/accounts.AccountsService/Transfer"
+	AccountsService_QuoteFee_FullMethodName                   = "/accounts.AccountsService/QuoteFee"
+	AccountsService_LookupRecipient_FullMethodName            = "/accounts.AccountsService/LookupRecipient"
+	AccountsService_SetHandle_FullMethodName                  = "/accounts.AccountsService/SetHandle"
+	AccountsService_CreateScheduledTransfer_FullMethodName    = "/accounts.AccountsService/CreateScheduledTransfer"
+	AccountsService_ListScheduledTransfers_FullMethodName     = "/accounts.AccountsService/ListScheduledTransfers"
+	AccountsService_PauseScheduledTransfer_FullMethodName     = "/accounts.AccountsService/PauseScheduledTransfer"
+	AccountsService_ResumeScheduledTransfer_FullMethodName    = "/accounts.AccountsService/ResumeScheduledTransfer"
+	AccountsService_CancelScheduledTransfer_FullMethodName    = "/accounts.AccountsService/CancelScheduledTransfer"
+	AccountsService_CreatePaymentRequest_FullMethodName       = "/accounts.AccountsService/CreatePaymentRequest"
+	AccountsService_AcceptPaymentRequest_FullMethodName       = "/accounts.AccountsService/AcceptPaymentRequest"
+	AccountsService_DeclinePaymentRequest_FullMethodName      = "/accounts.AccountsService/DeclinePaymentRequest"
+	AccountsService_CancelPaymentRequest_FullMethodName       = "/accounts.AccountsService/CancelPaymentRequest"
+	AccountsService_ListPaymentRequests_FullMethodName        = "/accounts.AccountsService/ListPaymentRequests"
+	AccountsService_GetTransactionHistory_FullMethodName      = "/accounts.AccountsService/GetTransactionHistory"
+	AccountsService_GetTransaction_FullMethodName             = "/accounts.AccountsService/GetTransaction"
+	AccountsService_GenerateStatement_FullMethodName          = "/accounts.AccountsService/GenerateStatement"
+	AccountsService_ListSystemAccounts_FullMethodName         = "/accounts.AccountsService/ListSystemAccounts"
+	AccountsService_SetSystemAccount_FullMethodName           = "/accounts.AccountsService/SetSystemAccount"
+	AccountsService_RunInterestAccrual_FullMethodName         = "/accounts.AccountsService/RunInterestAccrual"
+	AccountsService_RunInterestPayout_FullMethodName          = "/accounts.AccountsService/RunInterestPayout"
+	AccountsService_ListAuditLog_FullMethodName               = "/accounts.AccountsService/ListAuditLog"
+	AccountsService_FundDisbursementPool_FullMethodName       = "/accounts.AccountsService/FundDisbursementPool"
+	AccountsService_CreatePayoutBatch_FullMethodName          = "/accounts.AccountsService/CreatePayoutBatch"
+	AccountsService_GetPayoutBatch_FullMethodName             = "/accounts.AccountsService/GetPayoutBatch"
+	AccountsService_ListPayoutBatches_FullMethodName          = "/accounts.AccountsService/ListPayoutBatches"
+	AccountsService_ListPayoutRows_FullMethodName             = "/accounts.AccountsService/ListPayoutRows"
+	AccountsService_ApprovePayoutBatch_FullMethodName         = "/accounts.AccountsService/ApprovePayoutBatch"
+	AccountsService_CancelPayoutBatch_FullMethodName          = "/accounts.AccountsService/CancelPayoutBatch"
+	AccountsService_ExportPayoutResults_FullMethodName        = "/accounts.AccountsService/ExportPayoutResults"
 )
 
 // AccountsServiceClient is the client API for AccountsService service.
@@ -35,10 +79,78 @@ type AccountsServiceClient interface {
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
 	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
+	// A user can hold several wallets; one of them is their default.
+	OpenWallet(ctx context.Context, in *OpenWalletRequest, opts ...grpc.CallOption) (*OpenWalletResponse, error)
+	ListAccountsForUser(ctx context.Context, in *ListAccountsForUserRequest, opts ...grpc.CallOption) (*ListAccountsForUserResponse, error)
+	SetDefaultWallet(ctx context.Context, in *SetDefaultWalletRequest, opts ...grpc.CallOption) (*SetDefaultWalletResponse, error)
+	// Profiles. An email change takes effect once the token sent to the new
+	// address is confirmed.
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmailChangeResponse, error)
+	ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmEmailChangeResponse, error)
+	// Notifications about money moving in and out of a user's wallets.
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferencesResponse, error)
+	SetNotificationPreferences(ctx context.Context, in *SetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferencesResponse, error)
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	// Partner webhooks. Endpoints belong to the API key that registered them
+	// (api_key_id); deliveries are signed, retried and kept as logs.
+	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	GetWebhookDelivery(ctx context.Context, in *GetWebhookDeliveryRequest, opts ...grpc.CallOption) (*GetWebhookDeliveryResponse, error)
+	ReplayWebhookDelivery(ctx context.Context, in *ReplayWebhookDeliveryRequest, opts ...grpc.CallOption) (*ReplayWebhookDeliveryResponse, error)
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error)
 	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
 	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	QuoteFee(ctx context.Context, in *QuoteFeeRequest, opts ...grpc.CallOption) (*QuoteFeeResponse, error)
+	// Recipients can be addressed by email or by a user-chosen handle.
+	LookupRecipient(ctx context.Context, in *LookupRecipientRequest, opts ...grpc.CallOption) (*LookupRecipientResponse, error)
+	SetHandle(ctx context.Context, in *SetHandleRequest, opts ...grpc.CallOption) (*SetHandleResponse, error)
+	// Scheduled and recurring transfers, executed by a background worker.
+	CreateScheduledTransfer(ctx context.Context, in *CreateScheduledTransferRequest, opts ...grpc.CallOption) (*CreateScheduledTransferResponse, error)
+	ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error)
+	PauseScheduledTransfer(ctx context.Context, in *ScheduledTransferActionRequest, opts ...grpc.CallOption) (*ScheduledTransferActionResponse, error)
+	ResumeScheduledTransfer(ctx context.Context, in *ScheduledTransferActionRequest, opts ...grpc.CallOption) (*ScheduledTransferActionResponse, error)
+	CancelScheduledTransfer(ctx context.Context, in *ScheduledTransferActionRequest, opts ...grpc.CallOption) (*ScheduledTransferActionResponse, error)
+	// Payment requests: one account asks another for money. The payer accepts
+	// or declines, the requester may cancel while it is pending.
+	CreatePaymentRequest(ctx context.Context, in *CreatePaymentRequestRequest, opts ...grpc.CallOption) (*CreatePaymentRequestResponse, error)
+	AcceptPaymentRequest(ctx context.Context, in *PaymentRequestActionRequest, opts ...grpc.CallOption) (*AcceptPaymentRequestResponse, error)
+	DeclinePaymentRequest(ctx context.Context, in *PaymentRequestActionRequest, opts ...grpc.CallOption) (*PaymentRequestActionResponse, error)
+	CancelPaymentRequest(ctx context.Context, in *PaymentRequestActionRequest, opts ...grpc.CallOption) (*PaymentRequestActionResponse, error)
+	ListPaymentRequests(ctx context.Context, in *ListPaymentRequestsRequest, opts ...grpc.CallOption) (*ListPaymentRequestsResponse, error)
 	GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatementChunk], error)
+	// Admin: system accounts by role (REFERRAL_POOL, INSTITUTION_MAIN,
+	// DISBURSEMENT, PSP, FEE_INCOME, FX_CLEARING, INTEREST_EXPENSE,
+	// INTEREST_PAYABLE). SetSystemAccount needs the service's admin key as
+	// x-admin-key metadata.
+	ListSystemAccounts(ctx context.Context, in *ListSystemAccountsRequest, opts ...grpc.CallOption) (*ListSystemAccountsResponse, error)
+	SetSystemAccount(ctx context.Context, in *SetSystemAccountRequest, opts ...grpc.CallOption) (*SetSystemAccountResponse, error)
+	// Admin: interest. Both are idempotent; re-running a date or period
+	// returns the recorded run with already_run set.
+	RunInterestAccrual(ctx context.Context, in *RunInterestAccrualRequest, opts ...grpc.CallOption) (*RunInterestAccrualResponse, error)
+	RunInterestPayout(ctx context.Context, in *RunInterestPayoutRequest, opts ...grpc.CallOption) (*RunInterestPayoutResponse, error)
+	// Admin: the audit log of every mutating call to this service. The
+	// service's admin key must be sent as x-admin-key metadata.
+	ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error)
+	// Admin: bulk payouts from the DISBURSEMENT account. An uploaded CSV is
+	// validated and waits for approval; approved batches are paid in chunks
+	// by a background worker, and each row reports its own outcome.
+	// Payouts only spend what the DISBURSEMENT pool holds; operators top it
+	// up from INSTITUTION_MAIN with FundDisbursementPool, which needs the
+	// service's admin key as x-admin-key metadata.
+	FundDisbursementPool(ctx context.Context, in *FundDisbursementPoolRequest, opts ...grpc.CallOption) (*FundDisbursementPoolResponse, error)
+	CreatePayoutBatch(ctx context.Context, in *CreatePayoutBatchRequest, opts ...grpc.CallOption) (*CreatePayoutBatchResponse, error)
+	GetPayoutBatch(ctx context.Context, in *GetPayoutBatchRequest, opts ...grpc.CallOption) (*GetPayoutBatchResponse, error)
+	ListPayoutBatches(ctx context.Context, in *ListPayoutBatchesRequest, opts ...grpc.CallOption) (*ListPayoutBatchesResponse, error)
+	ListPayoutRows(ctx context.Context, in *ListPayoutRowsRequest, opts ...grpc.CallOption) (*ListPayoutRowsResponse, error)
+	ApprovePayoutBatch(ctx context.Context, in *PayoutBatchActionRequest, opts ...grpc.CallOption) (*PayoutBatchActionResponse, error)
+	CancelPayoutBatch(ctx context.Context, in *PayoutBatchActionRequest, opts ...grpc.CallOption) (*PayoutBatchActionResponse, error)
+	ExportPayoutResults(ctx context.Context, in *ExportPayoutResultsRequest, opts ...grpc.CallOption) (*ExportPayoutResultsResponse, error)
 }
 
 type accountsServiceClient struct {
@@ -79,6 +191,166 @@ func (c *accountsServiceClient) GetBalance(ctx context.Context, in *GetBalanceRe
 	return out, nil
 }
 
+func (c *accountsServiceClient) OpenWallet(ctx context.Context, in *OpenWalletRequest, opts ...grpc.CallOption) (*OpenWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenWalletResponse)
+	err := c.cc.Invoke(ctx, AccountsService_OpenWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ListAccountsForUser(ctx context.Context, in *ListAccountsForUserRequest, opts ...grpc.CallOption) (*ListAccountsForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountsForUserResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ListAccountsForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) SetDefaultWallet(ctx context.Context, in *SetDefaultWalletRequest, opts ...grpc.CallOption) (*SetDefaultWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDefaultWalletResponse)
+	err := c.cc.Invoke(ctx, AccountsService_SetDefaultWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, AccountsService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, AccountsService_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AccountsService_RequestEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ConfirmEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, AccountsService_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) SetNotificationPreferences(ctx context.Context, in *SetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, AccountsService_SetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterWebhookResponse)
+	err := c.cc.Invoke(ctx, AccountsService_RegisterWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, AccountsService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) GetWebhookDelivery(ctx context.Context, in *GetWebhookDeliveryRequest, opts ...grpc.CallOption) (*GetWebhookDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, AccountsService_GetWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ReplayWebhookDelivery(ctx context.Context, in *ReplayWebhookDeliveryRequest, opts ...grpc.CallOption) (*ReplayWebhookDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayWebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ReplayWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountsServiceClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DepositResponse)
@@ -96,214 +368,1488 @@ func (c *accountsServiceClient) Withdraw(ctx context.Context, in *WithdrawReques
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *accountsServiceClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferResponse)
+	err := c.cc.Invoke(ctx, AccountsService_Transfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) QuoteFee(ctx context.Context, in *QuoteFeeRequest, opts ...grpc.CallOption) (*QuoteFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteFeeResponse)
+	err := c.cc.Invoke(ctx, AccountsService_QuoteFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) LookupRecipient(ctx context.Context, in *LookupRecipientRequest, opts ...grpc.CallOption) (*LookupRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupRecipientResponse)
+	err := c.cc.Invoke(ctx, AccountsService_LookupRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) SetHandle(ctx context.Context, in *SetHandleRequest, opts ...grpc.CallOption) (*SetHandleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetHandleResponse)
+	err := c.cc.Invoke(ctx, AccountsService_SetHandle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) CreateScheduledTransfer(ctx context.Context, in *CreateScheduledTransferRequest, opts ...grpc.CallOption) (*CreateScheduledTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, AccountsService_CreateScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledTransfersResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ListScheduledTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) PauseScheduledTransfer(ctx context.Context, in *ScheduledTransferActionRequest, opts ...grpc.CallOption) (*ScheduledTransferActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledTransferActionResponse)
+	err := c.cc.Invoke(ctx, AccountsService_PauseScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ResumeScheduledTransfer(ctx context.Context, in *ScheduledTransferActionRequest, opts ...grpc.CallOption) (*ScheduledTransferActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledTransferActionResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ResumeScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) CancelScheduledTransfer(ctx context.Context, in *ScheduledTransferActionRequest, opts ...grpc.CallOption) (*ScheduledTransferActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledTransferActionResponse)
+	err := c.cc.Invoke(ctx, AccountsService_CancelScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) CreatePaymentRequest(ctx context.Context, in *CreatePaymentRequestRequest, opts ...grpc.CallOption) (*CreatePaymentRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePaymentRequestResponse)
+	err := c.cc.Invoke(ctx, AccountsService_CreatePaymentRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) AcceptPaymentRequest(ctx context.Context, in *PaymentRequestActionRequest, opts ...grpc.CallOption) (*AcceptPaymentRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptPaymentRequestResponse)
+	err := c.cc.Invoke(ctx, AccountsService_AcceptPaymentRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) DeclinePaymentRequest(ctx context.Context, in *PaymentRequestActionRequest, opts ...grpc.CallOption) (*PaymentRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentRequestActionResponse)
+	err := c.cc.Invoke(ctx, AccountsService_DeclinePaymentRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) CancelPaymentRequest(ctx context.Context, in *PaymentRequestActionRequest, opts ...grpc.CallOption) (*PaymentRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentRequestActionResponse)
+	err := c.cc.Invoke(ctx, AccountsService_CancelPaymentRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ListPaymentRequests(ctx context.Context, in *ListPaymentRequestsRequest, opts ...grpc.CallOption) (*ListPaymentRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPaymentRequestsResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ListPaymentRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionHistoryResponse)
+	err := c.cc.Invoke(ctx, AccountsService_GetTransactionHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionResponse)
+	err := c.cc.Invoke(ctx, AccountsService_GetTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StatementChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AccountsService_ServiceDesc.Streams[0], AccountsService_GenerateStatement_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GenerateStatementRequest, StatementChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AccountsService_GenerateStatementClient = grpc.ServerStreamingClient[StatementChunk]
+
+func (c *accountsServiceClient) ListSystemAccounts(ctx context.Context, in *ListSystemAccountsRequest, opts ...grpc.CallOption) (*ListSystemAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSystemAccountsResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ListSystemAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) SetSystemAccount(ctx context.Context, in *SetSystemAccountRequest, opts ...grpc.CallOption) (*SetSystemAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSystemAccountResponse)
+	err := c.cc.Invoke(ctx, AccountsService_SetSystemAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) RunInterestAccrual(ctx context.Context, in *RunInterestAccrualRequest, opts ...grpc.CallOption) (*RunInterestAccrualResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunInterestAccrualResponse)
+	err := c.cc.Invoke(ctx, AccountsService_RunInterestAccrual_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) RunInterestPayout(ctx context.Context, in *RunInterestPayoutRequest, opts ...grpc.CallOption) (*RunInterestPayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunInterestPayoutResponse)
+	err := c.cc.Invoke(ctx, AccountsService_RunInterestPayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) FundDisbursementPool(ctx context.Context, in *FundDisbursementPoolRequest, opts ...grpc.CallOption) (*FundDisbursementPoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FundDisbursementPoolResponse)
+	err := c.cc.Invoke(ctx, AccountsService_FundDisbursementPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) CreatePayoutBatch(ctx context.Context, in *CreatePayoutBatchRequest, opts ...grpc.CallOption) (*CreatePayoutBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePayoutBatchResponse)
+	err := c.cc.Invoke(ctx, AccountsService_CreatePayoutBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) GetPayoutBatch(ctx context.Context, in *GetPayoutBatchRequest, opts ...grpc.CallOption) (*GetPayoutBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPayoutBatchResponse)
+	err := c.cc.Invoke(ctx, AccountsService_GetPayoutBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ListPayoutBatches(ctx context.Context, in *ListPayoutBatchesRequest, opts ...grpc.CallOption) (*ListPayoutBatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPayoutBatchesResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ListPayoutBatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ListPayoutRows(ctx context.Context, in *ListPayoutRowsRequest, opts ...grpc.CallOption) (*ListPayoutRowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPayoutRowsResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ListPayoutRows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ApprovePayoutBatch(ctx context.Context, in *PayoutBatchActionRequest, opts ...grpc.CallOption) (*PayoutBatchActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayoutBatchActionResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ApprovePayoutBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) CancelPayoutBatch(ctx context.Context, in *PayoutBatchActionRequest, opts ...grpc.CallOption) (*PayoutBatchActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayoutBatchActionResponse)
+	err := c.cc.Invoke(ctx, AccountsService_CancelPayoutBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceClient) ExportPayoutResults(ctx context.Context, in *ExportPayoutResultsRequest, opts ...grpc.CallOption) (*ExportPayoutResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportPayoutResultsResponse)
+	err := c.cc.Invoke(ctx, AccountsService_ExportPayoutResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountsServiceServer is the server API for AccountsService service.
+// All implementations must embed UnimplementedAccountsServiceServer
+// for forward compatibility.
+type AccountsServiceServer interface {
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
+	// A user can hold several wallets; one of them is their default.
+	OpenWallet(context.Context, *OpenWalletRequest) (*OpenWalletResponse, error)
+	ListAccountsForUser(context.Context, *ListAccountsForUserRequest) (*ListAccountsForUserResponse, error)
+	SetDefaultWallet(context.Context, *SetDefaultWalletRequest) (*SetDefaultWalletResponse, error)
+	// Profiles. An email change takes effect once the token sent to the new
+	// address is confirmed.
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	RequestEmailChange(context.Context, *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error)
+	ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error)
+	// Notifications about money moving in and out of a user's wallets.
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferencesResponse, error)
+	SetNotificationPreferences(context.Context, *SetNotificationPreferencesRequest) (*NotificationPreferencesResponse, error)
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	// Partner webhooks. Endpoints belong to the API key that registered them
+	// (api_key_id); deliveries are signed, retried and kept as logs.
+	RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	GetWebhookDelivery(context.Context, *GetWebhookDeliveryRequest) (*GetWebhookDeliveryResponse, error)
+	ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryRequest) (*ReplayWebhookDeliveryResponse, error)
+	Deposit(context.Context, *DepositRequest) (*DepositResponse, error)
+	Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error)
+	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	QuoteFee(context.Context, *QuoteFeeRequest) (*QuoteFeeResponse, error)
+	// Recipients can be addressed by email or by a user-chosen handle.
+	LookupRecipient(context.Context, *LookupRecipientRequest) (*LookupRecipientResponse, error)
+	SetHandle(context.Context, *SetHandleRequest) (*SetHandleResponse, error)
+	// Scheduled and recurring transfers, executed by a background worker.
+	CreateScheduledTransfer(context.Context, *CreateScheduledTransferRequest) (*CreateScheduledTransferResponse, error)
+	ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error)
+	PauseScheduledTransfer(context.Context, *ScheduledTransferActionRequest) (*ScheduledTransferActionResponse, error)
+	ResumeScheduledTransfer(context.Context, *ScheduledTransferActionRequest) (*ScheduledTransferActionResponse, error)
+	CancelScheduledTransfer(context.Context, *ScheduledTransferActionRequest) (*ScheduledTransferActionResponse, error)
+	// Payment requests: one account asks another for money. The payer accepts
+	// or declines, the requester may cancel while it is pending.
+	CreatePaymentRequest(context.Context, *CreatePaymentRequestRequest) (*CreatePaymentRequestResponse, error)
+	AcceptPaymentRequest(context.Context, *PaymentRequestActionRequest) (*AcceptPaymentRequestResponse, error)
+	DeclinePaymentRequest(context.Context, *PaymentRequestActionRequest) (*PaymentRequestActionResponse, error)
+	CancelPaymentRequest(context.Context, *PaymentRequestActionRequest) (*PaymentRequestActionResponse, error)
+	ListPaymentRequests(context.Context, *ListPaymentRequestsRequest) (*ListPaymentRequestsResponse, error)
+	GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	GenerateStatement(*GenerateStatementRequest, grpc.ServerStreamingServer[StatementChunk]) error
+	// Admin: system accounts by role (REFERRAL_POOL, INSTITUTION_MAIN,
+	// DISBURSEMENT, PSP, FEE_INCOME, FX_CLEARING, INTEREST_EXPENSE,
+	// INTEREST_PAYABLE). SetSystemAccount needs the service's admin key as
+	// x-admin-key metadata.
+	ListSystemAccounts(context.Context, *ListSystemAccountsRequest) (*ListSystemAccountsResponse, error)
+	SetSystemAccount(context.Context, *SetSystemAccountRequest) (*SetSystemAccountResponse, error)
+	// Admin: interest. Both are idempotent; re-running a date or period
+	// returns the recorded run with already_run set.
+	RunInterestAccrual(context.Context, *RunInterestAccrualRequest) (*RunInterestAccrualResponse, error)
+	RunInterestPayout(context.Context, *RunInterestPayoutRequest) (*RunInterestPayoutResponse, error)
+	// Admin: the audit log of every mutating call to this service. The
+	// service's admin key must be sent as x-admin-key metadata.
+	ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
+	// Admin: bulk payouts from the DISBURSEMENT account. An uploaded CSV is
+	// validated and waits for approval; approved batches are paid in chunks
+	// by a background worker, and each row reports its own outcome.
+	// Payouts only spend what the DISBURSEMENT pool holds; operators top it
+	// up from INSTITUTION_MAIN with FundDisbursementPool, which needs the
+	// service's admin key as x-admin-key metadata.
+	FundDisbursementPool(context.Context, *FundDisbursementPoolRequest) (*FundDisbursementPoolResponse, error)
+	CreatePayoutBatch(context.Context, *CreatePayoutBatchRequest) (*CreatePayoutBatchResponse, error)
+	GetPayoutBatch(context.Context, *GetPayoutBatchRequest) (*GetPayoutBatchResponse, error)
+	ListPayoutBatches(context.Context, *ListPayoutBatchesRequest) (*ListPayoutBatchesResponse, error)
+	ListPayoutRows(context.Context, *ListPayoutRowsRequest) (*ListPayoutRowsResponse, error)
+	ApprovePayoutBatch(context.Context, *PayoutBatchActionRequest) (*PayoutBatchActionResponse, error)
+	CancelPayoutBatch(context.Context, *PayoutBatchActionRequest) (*PayoutBatchActionResponse, error)
+	ExportPayoutResults(context.Context, *ExportPayoutResultsRequest) (*ExportPayoutResultsResponse, error)
+	mustEmbedUnimplementedAccountsServiceServer()
+}
+
+// UnimplementedAccountsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAccountsServiceServer struct{}
+
+func (UnimplementedAccountsServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedAccountsServiceServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedAccountsServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedAccountsServiceServer) OpenWallet(context.Context, *OpenWalletRequest) (*OpenWalletResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OpenWallet not implemented")
+}
+func (UnimplementedAccountsServiceServer) ListAccountsForUser(context.Context, *ListAccountsForUserRequest) (*ListAccountsForUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAccountsForUser not implemented")
+}
+func (UnimplementedAccountsServiceServer) SetDefaultWallet(context.Context, *SetDefaultWalletRequest) (*SetDefaultWalletResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDefaultWallet not implemented")
+}
+func (UnimplementedAccountsServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedAccountsServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedAccountsServiceServer) RequestEmailChange(context.Context, *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestEmailChange not implemented")
+}
+func (UnimplementedAccountsServiceServer) ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmEmailChange not implemented")
+}
+func (UnimplementedAccountsServiceServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedAccountsServiceServer) SetNotificationPreferences(context.Context, *SetNotificationPreferencesRequest) (*NotificationPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetNotificationPreferences not implemented")
+}
+func (UnimplementedAccountsServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedAccountsServiceServer) RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterWebhook not implemented")
+}
+func (UnimplementedAccountsServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedAccountsServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedAccountsServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedAccountsServiceServer) GetWebhookDelivery(context.Context, *GetWebhookDeliveryRequest) (*GetWebhookDeliveryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWebhookDelivery not implemented")
+}
+func (UnimplementedAccountsServiceServer) ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryRequest) (*ReplayWebhookDeliveryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayWebhookDelivery not implemented")
+}
+func (UnimplementedAccountsServiceServer) Deposit(context.Context, *DepositRequest) (*DepositResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Deposit not implemented")
+}
+func (UnimplementedAccountsServiceServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (UnimplementedAccountsServiceServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedAccountsServiceServer) QuoteFee(context.Context, *QuoteFeeRequest) (*QuoteFeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuoteFee not implemented")
+}
+func (UnimplementedAccountsServiceServer) LookupRecipient(context.Context, *LookupRecipientRequest) (*LookupRecipientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LookupRecipient not implemented")
+}
+func (UnimplementedAccountsServiceServer) SetHandle(context.Context, *SetHandleRequest) (*SetHandleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetHandle not implemented")
+}
+func (UnimplementedAccountsServiceServer) CreateScheduledTransfer(context.Context, *CreateScheduledTransferRequest) (*CreateScheduledTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateScheduledTransfer not implemented")
+}
+func (UnimplementedAccountsServiceServer) ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledTransfers not implemented")
+}
+func (UnimplementedAccountsServiceServer) PauseScheduledTransfer(context.Context, *ScheduledTransferActionRequest) (*ScheduledTransferActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseScheduledTransfer not implemented")
+}
+func (UnimplementedAccountsServiceServer) ResumeScheduledTransfer(context.Context, *ScheduledTransferActionRequest) (*ScheduledTransferActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeScheduledTransfer not implemented")
+}
+func (UnimplementedAccountsServiceServer) CancelScheduledTransfer(context.Context, *ScheduledTransferActionRequest) (*ScheduledTransferActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledTransfer not implemented")
+}
+func (UnimplementedAccountsServiceServer) CreatePaymentRequest(context.Context, *CreatePaymentRequestRequest) (*CreatePaymentRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePaymentRequest not implemented")
+}
+func (UnimplementedAccountsServiceServer) AcceptPaymentRequest(context.Context, *PaymentRequestActionRequest) (*AcceptPaymentRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptPaymentRequest not implemented")
+}
+func (UnimplementedAccountsServiceServer) DeclinePaymentRequest(context.Context, *PaymentRequestActionRequest) (*PaymentRequestActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclinePaymentRequest not implemented")
+}
+func (UnimplementedAccountsServiceServer) CancelPaymentRequest(context.Context, *PaymentRequestActionRequest) (*PaymentRequestActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelPaymentRequest not implemented")
+}
+func (UnimplementedAccountsServiceServer) ListPaymentRequests(context.Context, *ListPaymentRequestsRequest) (*ListPaymentRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPaymentRequests not implemented")
+}
+func (UnimplementedAccountsServiceServer) GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransactionHistory not implemented")
+}
+func (UnimplementedAccountsServiceServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedAccountsServiceServer) GenerateStatement(*GenerateStatementRequest, grpc.ServerStreamingServer[StatementChunk]) error {
+	return status.Error(codes.Unimplemented, "method GenerateStatement not implemented")
+}
+func (UnimplementedAccountsServiceServer) ListSystemAccounts(context.Context, *ListSystemAccountsRequest) (*ListSystemAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSystemAccounts not implemented")
+}
+func (UnimplementedAccountsServiceServer) SetSystemAccount(context.Context, *SetSystemAccountRequest) (*SetSystemAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSystemAccount not implemented")
+}
+func (UnimplementedAccountsServiceServer) RunInterestAccrual(context.Context, *RunInterestAccrualRequest) (*RunInterestAccrualResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunInterestAccrual not implemented")
+}
+func (UnimplementedAccountsServiceServer) RunInterestPayout(context.Context, *RunInterestPayoutRequest) (*RunInterestPayoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunInterestPayout not implemented")
+}
+func (UnimplementedAccountsServiceServer) ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (UnimplementedAccountsServiceServer) FundDisbursementPool(context.Context, *FundDisbursementPoolRequest) (*FundDisbursementPoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FundDisbursementPool not implemented")
+}
+func (UnimplementedAccountsServiceServer) CreatePayoutBatch(context.Context, *CreatePayoutBatchRequest) (*CreatePayoutBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePayoutBatch not implemented")
+}
+func (UnimplementedAccountsServiceServer) GetPayoutBatch(context.Context, *GetPayoutBatchRequest) (*GetPayoutBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPayoutBatch not implemented")
+}
+func (UnimplementedAccountsServiceServer) ListPayoutBatches(context.Context, *ListPayoutBatchesRequest) (*ListPayoutBatchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPayoutBatches not implemented")
+}
+func (UnimplementedAccountsServiceServer) ListPayoutRows(context.Context, *ListPayoutRowsRequest) (*ListPayoutRowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPayoutRows not implemented")
+}
+func (UnimplementedAccountsServiceServer) ApprovePayoutBatch(context.Context, *PayoutBatchActionRequest) (*PayoutBatchActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApprovePayoutBatch not implemented")
+}
+func (UnimplementedAccountsServiceServer) CancelPayoutBatch(context.Context, *PayoutBatchActionRequest) (*PayoutBatchActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelPayoutBatch not implemented")
+}
+func (UnimplementedAccountsServiceServer) ExportPayoutResults(context.Context, *ExportPayoutResultsRequest) (*ExportPayoutResultsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportPayoutResults not implemented")
+}
+func (UnimplementedAccountsServiceServer) mustEmbedUnimplementedAccountsServiceServer() {}
+func (UnimplementedAccountsServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeAccountsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountsServiceServer will
+// result in compilation errors.
+type UnsafeAccountsServiceServer interface {
+	mustEmbedUnimplementedAccountsServiceServer()
+}
+
+func RegisterAccountsServiceServer(s grpc.ServiceRegistrar, srv AccountsServiceServer) {
+	// If the following call panics, it indicates UnimplementedAccountsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AccountsService_ServiceDesc, srv)
+}
+
+func _AccountsService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_OpenWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).OpenWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_OpenWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).OpenWallet(ctx, req.(*OpenWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_ListAccountsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).ListAccountsForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_ListAccountsForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).ListAccountsForUser(ctx, req.(*ListAccountsForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_SetDefaultWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).SetDefaultWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_SetDefaultWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).SetDefaultWallet(ctx, req.(*SetDefaultWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_RequestEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).RequestEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_RequestEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).RequestEmailChange(ctx, req.(*RequestEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_ConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).ConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_ConfirmEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).ConfirmEmailChange(ctx, req.(*ConfirmEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_SetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).SetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_SetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).SetNotificationPreferences(ctx, req.(*SetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_RegisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).RegisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_RegisterWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).RegisterWebhook(ctx, req.(*RegisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_GetWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).GetWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_GetWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).GetWebhookDelivery(ctx, req.(*GetWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_ReplayWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).ReplayWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_ReplayWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).ReplayWebhookDelivery(ctx, req.(*ReplayWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_Deposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).Deposit(ctx, req.(*DepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_Withdraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).Withdraw(ctx, req.(*WithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_QuoteFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).QuoteFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_QuoteFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).QuoteFee(ctx, req.(*QuoteFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_LookupRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).LookupRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_LookupRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).LookupRecipient(ctx, req.(*LookupRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_SetHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).SetHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_SetHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).SetHandle(ctx, req.(*SetHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_CreateScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).CreateScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_CreateScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).CreateScheduledTransfer(ctx, req.(*CreateScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_ListScheduledTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).ListScheduledTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_ListScheduledTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).ListScheduledTransfers(ctx, req.(*ListScheduledTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_PauseScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledTransferActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).PauseScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_PauseScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).PauseScheduledTransfer(ctx, req.(*ScheduledTransferActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_ResumeScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledTransferActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).ResumeScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_ResumeScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).ResumeScheduledTransfer(ctx, req.(*ScheduledTransferActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_CancelScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledTransferActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).CancelScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_CancelScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).CancelScheduledTransfer(ctx, req.(*ScheduledTransferActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_CreatePaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).CreatePaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_CreatePaymentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).CreatePaymentRequest(ctx, req.(*CreatePaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_AcceptPaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequestActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).AcceptPaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_AcceptPaymentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).AcceptPaymentRequest(ctx, req.(*PaymentRequestActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_DeclinePaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequestActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).DeclinePaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_DeclinePaymentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).DeclinePaymentRequest(ctx, req.(*PaymentRequestActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_CancelPaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequestActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).CancelPaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_CancelPaymentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).CancelPaymentRequest(ctx, req.(*PaymentRequestActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_ListPaymentRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).ListPaymentRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_ListPaymentRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).ListPaymentRequests(ctx, req.(*ListPaymentRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).GetTransactionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_GetTransactionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).GetTransactionHistory(ctx, req.(*GetTransactionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsService_GenerateStatement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateStatementRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountsServiceServer).GenerateStatement(m, &grpc.GenericServerStream[GenerateStatementRequest, StatementChunk]{ServerStream: stream})
 }
 
-func (c *accountsServiceClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TransferResponse)
-	err := c.cc.Invoke(ctx, AccountsService_Transfer_FullMethodName, in, out, cOpts...)
-	if err != nil {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AccountsService_GenerateStatementServer = grpc.ServerStreamingServer[StatementChunk]
+
+func _AccountsService_ListSystemAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSystemAccountsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).ListSystemAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_ListSystemAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).ListSystemAccounts(ctx, req.(*ListSystemAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *accountsServiceClient) GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetTransactionHistoryResponse)
-	err := c.cc.Invoke(ctx, AccountsService_GetTransactionHistory_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _AccountsService_SetSystemAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSystemAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).SetSystemAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_SetSystemAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).SetSystemAccount(ctx, req.(*SetSystemAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// AccountsServiceServer is the server API for AccountsService service.
-// All implementations must embed UnimplementedAccountsServiceServer
-// for forward compatibility.
-type AccountsServiceServer interface {
-	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
-	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
-	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
-	Deposit(context.Context, *DepositRequest) (*DepositResponse, error)
-	Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error)
-	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
-	GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error)
-	mustEmbedUnimplementedAccountsServiceServer()
+func _AccountsService_RunInterestAccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunInterestAccrualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).RunInterestAccrual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_RunInterestAccrual_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).RunInterestAccrual(ctx, req.(*RunInterestAccrualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedAccountsServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedAccountsServiceServer struct{}
-
-func (UnimplementedAccountsServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateAccount not implemented")
-}
-func (UnimplementedAccountsServiceServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAccount not implemented")
-}
-func (UnimplementedAccountsServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetBalance not implemented")
-}
-func (UnimplementedAccountsServiceServer) Deposit(context.Context, *DepositRequest) (*DepositResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Deposit not implemented")
-}
-func (UnimplementedAccountsServiceServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Withdraw not implemented")
-}
-func (UnimplementedAccountsServiceServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Transfer not implemented")
-}
-func (UnimplementedAccountsServiceServer) GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTransactionHistory not implemented")
+func _AccountsService_RunInterestPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunInterestPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).RunInterestPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_RunInterestPayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).RunInterestPayout(ctx, req.(*RunInterestPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAccountsServiceServer) mustEmbedUnimplementedAccountsServiceServer() {}
-func (UnimplementedAccountsServiceServer) testEmbeddedByValue()                         {}
 
-// UnsafeAccountsServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AccountsServiceServer will
-// result in compilation errors.
-type UnsafeAccountsServiceServer interface {
-	mustEmbedUnimplementedAccountsServiceServer()
+func _AccountsService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).ListAuditLog(ctx, req.(*ListAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAccountsServiceServer(s grpc.ServiceRegistrar, srv AccountsServiceServer) {
-	// If the following call panics, it indicates UnimplementedAccountsServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _AccountsService_FundDisbursementPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundDisbursementPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&AccountsService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(AccountsServiceServer).FundDisbursementPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountsService_FundDisbursementPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceServer).FundDisbursementPool(ctx, req.(*FundDisbursementPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountsService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
+func _AccountsService_CreatePayoutBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayoutBatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountsServiceServer).CreateAccount(ctx, in)
+		return srv.(AccountsServiceServer).CreatePayoutBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountsService_CreateAccount_FullMethodName,
+		FullMethod: AccountsService_CreatePayoutBatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+		return srv.(AccountsServiceServer).CreatePayoutBatch(ctx, req.(*CreatePayoutBatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountsService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountRequest)
+func _AccountsService_GetPayoutBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoutBatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountsServiceServer).GetAccount(ctx, in)
+		return srv.(AccountsServiceServer).GetPayoutBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountsService_GetAccount_FullMethodName,
+		FullMethod: AccountsService_GetPayoutBatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+		return srv.(AccountsServiceServer).GetPayoutBatch(ctx, req.(*GetPayoutBatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountsService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBalanceRequest)
+func _AccountsService_ListPayoutBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPayoutBatchesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountsServiceServer).GetBalance(ctx, in)
+		return srv.(AccountsServiceServer).ListPayoutBatches(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountsService_GetBalance_FullMethodName,
+		FullMethod: AccountsService_ListPayoutBatches_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+		return srv.(AccountsServiceServer).ListPayoutBatches(ctx, req.(*ListPayoutBatchesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountsService_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DepositRequest)
+func _AccountsService_ListPayoutRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPayoutRowsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountsServiceServer).Deposit(ctx, in)
+		return srv.(AccountsServiceServer).ListPayoutRows(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountsService_Deposit_FullMethodName,
+		FullMethod: AccountsService_ListPayoutRows_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServiceServer).Deposit(ctx, req.(*DepositRequest))
+		return srv.(AccountsServiceServer).ListPayoutRows(ctx, req.(*ListPayoutRowsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountsService_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawRequest)
+func _AccountsService_ApprovePayoutBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutBatchActionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountsServiceServer).Withdraw(ctx, in)
+		return srv.(AccountsServiceServer).ApprovePayoutBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountsService_Withdraw_FullMethodName,
+		FullMethod: AccountsService_ApprovePayoutBatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServiceServer).Withdraw(ctx, req.(*WithdrawRequest))
+		return srv.(AccountsServiceServer).ApprovePayoutBatch(ctx, req.(*PayoutBatchActionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountsService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferRequest)
+func _AccountsService_CancelPayoutBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutBatchActionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountsServiceServer).Transfer(ctx, in)
+		return srv.(AccountsServiceServer).CancelPayoutBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountsService_Transfer_FullMethodName,
+		FullMethod: AccountsService_CancelPayoutBatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServiceServer).Transfer(ctx, req.(*TransferRequest))
+		return srv.(AccountsServiceServer).CancelPayoutBatch(ctx, req.(*PayoutBatchActionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountsService_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionHistoryRequest)
+func _AccountsService_ExportPayoutResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPayoutResultsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountsServiceServer).GetTransactionHistory(ctx, in)
+		return srv.(AccountsServiceServer).ExportPayoutResults(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountsService_GetTransactionHistory_FullMethodName,
+		FullMethod: AccountsService_ExportPayoutResults_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServiceServer).GetTransactionHistory(ctx, req.(*GetTransactionHistoryRequest))
+		return srv.(AccountsServiceServer).ExportPayoutResults(ctx, req.(*ExportPayoutResultsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -327,6 +1873,70 @@ var AccountsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBalance",
 			Handler:    _AccountsService_GetBalance_Handler,
 		},
+		{
+			MethodName: "OpenWallet",
+			Handler:    _AccountsService_OpenWallet_Handler,
+		},
+		{
+			MethodName: "ListAccountsForUser",
+			Handler:    _AccountsService_ListAccountsForUser_Handler,
+		},
+		{
+			MethodName: "SetDefaultWallet",
+			Handler:    _AccountsService_SetDefaultWallet_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _AccountsService_GetUser_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _AccountsService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "RequestEmailChange",
+			Handler:    _AccountsService_RequestEmailChange_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailChange",
+			Handler:    _AccountsService_ConfirmEmailChange_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _AccountsService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "SetNotificationPreferences",
+			Handler:    _AccountsService_SetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _AccountsService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "RegisterWebhook",
+			Handler:    _AccountsService_RegisterWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _AccountsService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _AccountsService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _AccountsService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "GetWebhookDelivery",
+			Handler:    _AccountsService_GetWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "ReplayWebhookDelivery",
+			Handler:    _AccountsService_ReplayWebhookDelivery_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _AccountsService_Deposit_Handler,
@@ -339,11 +1949,125 @@ var AccountsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Transfer",
 			Handler:    _AccountsService_Transfer_Handler,
 		},
+		{
+			MethodName: "QuoteFee",
+			Handler:    _AccountsService_QuoteFee_Handler,
+		},
+		{
+			MethodName: "LookupRecipient",
+			Handler:    _AccountsService_LookupRecipient_Handler,
+		},
+		{
+			MethodName: "SetHandle",
+			Handler:    _AccountsService_SetHandle_Handler,
+		},
+		{
+			MethodName: "CreateScheduledTransfer",
+			Handler:    _AccountsService_CreateScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransfers",
+			Handler:    _AccountsService_ListScheduledTransfers_Handler,
+		},
+		{
+			MethodName: "PauseScheduledTransfer",
+			Handler:    _AccountsService_PauseScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ResumeScheduledTransfer",
+			Handler:    _AccountsService_ResumeScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransfer",
+			Handler:    _AccountsService_CancelScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "CreatePaymentRequest",
+			Handler:    _AccountsService_CreatePaymentRequest_Handler,
+		},
+		{
+			MethodName: "AcceptPaymentRequest",
+			Handler:    _AccountsService_AcceptPaymentRequest_Handler,
+		},
+		{
+			MethodName: "DeclinePaymentRequest",
+			Handler:    _AccountsService_DeclinePaymentRequest_Handler,
+		},
+		{
+			MethodName: "CancelPaymentRequest",
+			Handler:    _AccountsService_CancelPaymentRequest_Handler,
+		},
+		{
+			MethodName: "ListPaymentRequests",
+			Handler:    _AccountsService_ListPaymentRequests_Handler,
+		},
 		{
 			MethodName: "GetTransactionHistory",
 			Handler:    _AccountsService_GetTransactionHistory_Handler,
 		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _AccountsService_GetTransaction_Handler,
+		},
+		{
+			MethodName: "ListSystemAccounts",
+			Handler:    _AccountsService_ListSystemAccounts_Handler,
+		},
+		{
+			MethodName: "SetSystemAccount",
+			Handler:    _AccountsService_SetSystemAccount_Handler,
+		},
+		{
+			MethodName: "RunInterestAccrual",
+			Handler:    _AccountsService_RunInterestAccrual_Handler,
+		},
+		{
+			MethodName: "RunInterestPayout",
+			Handler:    _AccountsService_RunInterestPayout_Handler,
+		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _AccountsService_ListAuditLog_Handler,
+		},
+		{
+			MethodName: "FundDisbursementPool",
+			Handler:    _AccountsService_FundDisbursementPool_Handler,
+		},
+		{
+			MethodName: "CreatePayoutBatch",
+			Handler:    _AccountsService_CreatePayoutBatch_Handler,
+		},
+		{
+			MethodName: "GetPayoutBatch",
+			Handler:    _AccountsService_GetPayoutBatch_Handler,
+		},
+		{
+			MethodName: "ListPayoutBatches",
+			Handler:    _AccountsService_ListPayoutBatches_Handler,
+		},
+		{
+			MethodName: "ListPayoutRows",
+			Handler:    _AccountsService_ListPayoutRows_Handler,
+		},
+		{
+			MethodName: "ApprovePayoutBatch",
+			Handler:    _AccountsService_ApprovePayoutBatch_Handler,
+		},
+		{
+			MethodName: "CancelPayoutBatch",
+			Handler:    _AccountsService_CancelPayoutBatch_Handler,
+		},
+		{
+			MethodName: "ExportPayoutResults",
+			Handler:    _AccountsService_ExportPayoutResults_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GenerateStatement",
+			Handler:       _AccountsService_GenerateStatement_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "accounts/accounts.proto",
 }
@@ -22,10 +22,15 @@ const (
 )
 
 type CreateTransactionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReferenceId   string                 `protobuf:"bytes,1,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Entries       []*Entry               `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A reference is posted at most once; posting it again fails with
+	// DUPLICATE_REFERENCE.
+	ReferenceId string   `protobuf:"bytes,1,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Entries     []*Entry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Optional YYYY-MM-DD day the entries count towards; today (UTC) when
+	// empty. It may be back-dated into an open period but not in the future.
+	EffectiveDate string `protobuf:"bytes,4,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +86,13 @@ func (x *CreateTransactionRequest) GetEntries() []*Entry {
 	return nil
 }
 
+func (x *CreateTransactionRequest) GetEffectiveDate() string {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return ""
+}
+
 type Entry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
@@ -193,16 +205,225 @@ func (x *CreateTransactionResponse) GetTransactionId() string {
 	return ""
 }
 
-type GetBalanceRequest struct {
+type CreateTransactionBatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ATOMIC (default) posts every transaction or none; BEST_EFFORT posts
+	// those that can be.
+	Mode          string                      `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Transactions  []*CreateTransactionRequest `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransactionBatchRequest) Reset() {
+	*x = CreateTransactionBatchRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransactionBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransactionBatchRequest) ProtoMessage() {}
+
+func (x *CreateTransactionBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransactionBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransactionBatchRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTransactionBatchRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *CreateTransactionBatchRequest) GetTransactions() []*CreateTransactionRequest {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type BatchItemResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // position in the batch, across every chunk of a stream
+	ReferenceId   string                 `protobuf:"bytes,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	TransactionId string                 `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"` // error reason when not posted, e.g. BATCH_ABORTED
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	mi := &file_subledger_subledger_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchItemResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchItemResult) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CreateTransactionBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Posted        int32                  `protobuf:"varint,2,opt,name=posted,proto3" json:"posted,omitempty"`
+	Rejected      int32                  `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Results       []*BatchItemResult     `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransactionBatchResponse) Reset() {
+	*x = CreateTransactionBatchResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransactionBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransactionBatchResponse) ProtoMessage() {}
+
+func (x *CreateTransactionBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransactionBatchResponse.ProtoReflect.Descriptor instead.
+func (*CreateTransactionBatchResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTransactionBatchResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *CreateTransactionBatchResponse) GetPosted() int32 {
+	if x != nil {
+		return x.Posted
+	}
+	return 0
+}
+
+func (x *CreateTransactionBatchResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *CreateTransactionBatchResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetBalanceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Optional RFC 3339 time; when set the balance excludes entries posted at
+	// or after it.
+	AsOf          string `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetBalanceRequest) Reset() {
 	*x = GetBalanceRequest{}
-	mi := &file_subledger_subledger_proto_msgTypes[3]
+	mi := &file_subledger_subledger_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -214,7 +435,7 @@ func (x *GetBalanceRequest) String() string {
 func (*GetBalanceRequest) ProtoMessage() {}
 
 func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subledger_subledger_proto_msgTypes[3]
+	mi := &file_subledger_subledger_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,7 +448,7 @@ func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
 func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_subledger_subledger_proto_rawDescGZIP(), []int{3}
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetBalanceRequest) GetAccountId() string {
@@ -237,6 +458,13 @@ func (x *GetBalanceRequest) GetAccountId() string {
 	return ""
 }
 
+func (x *GetBalanceRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
 type GetBalanceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
@@ -249,7 +477,7 @@ type GetBalanceResponse struct {
 
 func (x *GetBalanceResponse) Reset() {
 	*x = GetBalanceResponse{}
-	mi := &file_subledger_subledger_proto_msgTypes[4]
+	mi := &file_subledger_subledger_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -261,7 +489,7 @@ func (x *GetBalanceResponse) String() string {
 func (*GetBalanceResponse) ProtoMessage() {}
 
 func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subledger_subledger_proto_msgTypes[4]
+	mi := &file_subledger_subledger_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,7 +502,7 @@ func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
 func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_subledger_subledger_proto_rawDescGZIP(), []int{4}
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetBalanceResponse) GetAccountId() string {
@@ -305,37 +533,2808 @@ func (x *GetBalanceResponse) GetUpdatedAt() string {
 	return ""
 }
 
-var File_subledger_subledger_proto protoreflect.FileDescriptor
+type GetBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountIds    []string               `protobuf:"bytes,1,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	AsOf          string                 `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // optional, as in GetBalanceRequest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_subledger_subledger_proto_rawDesc = "" +
-	"\n" +
-	"\x19subledger/subledger.proto\x12\tsubledger\"\x8b\x01\n" +
-	"\x18CreateTransactionRequest\x12!\n" +
-	"\freference_id\x18\x01 \x01(\tR\vreferenceId\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12*\n" +
-	"\aentries\x18\x03 \x03(\v2\x10.subledger.EntryR\aentries\"\\\n" +
-	"\x05Entry\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1c\n" +
-	"\tdirection\x18\x03 \x01(\tR\tdirection\"\\\n" +
-	"\x19CreateTransactionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
-	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\"2\n" +
-	"\x11GetBalanceRequest\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\"\x86\x01\n" +
-	"\x12GetBalanceResponse\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1a\n" +
-	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1d\n" +
+func (x *GetBalancesRequest) Reset() {
+	*x = GetBalancesRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalancesRequest) ProtoMessage() {}
+
+func (x *GetBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBalancesRequest) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *GetBalancesRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type AccountBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountBalance) Reset() {
+	*x = AccountBalance{}
+	mi := &file_subledger_subledger_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalance) ProtoMessage() {}
+
+func (x *AccountBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalance.ProtoReflect.Descriptor instead.
+func (*AccountBalance) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AccountBalance) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type GetBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*AccountBalance      `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"` // in the order of account_ids
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalancesResponse) Reset() {
+	*x = GetBalancesResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalancesResponse) ProtoMessage() {}
+
+func (x *GetBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetBalancesResponse) GetBalances() []*AccountBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type GetTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTransactionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type LedgerEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Direction     string                 `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	TransactionId string                 `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,6,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Accounts on the opposite side of the same transaction.
+	CounterpartyAccountIds []string `protobuf:"bytes,9,rep,name=counterparty_account_ids,json=counterpartyAccountIds,proto3" json:"counterparty_account_ids,omitempty"`
+	EffectiveDate          string   `protobuf:"bytes,10,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"` // YYYY-MM-DD
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_subledger_subledger_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LedgerEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetCounterpartyAccountIds() []string {
+	if x != nil {
+		return x.CounterpartyAccountIds
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetEffectiveDate() string {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return ""
+}
+
+type GetTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Entries       []*LedgerEntry         `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Set when this transaction reverses another one.
+	ReversesTransactionId    string   `protobuf:"bytes,6,opt,name=reverses_transaction_id,json=reversesTransactionId,proto3" json:"reverses_transaction_id,omitempty"`
+	ReversedByTransactionIds []string `protobuf:"bytes,7,rep,name=reversed_by_transaction_ids,json=reversedByTransactionIds,proto3" json:"reversed_by_transaction_ids,omitempty"`
+	EffectiveDate            string   `protobuf:"bytes,8,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"` // YYYY-MM-DD
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponse) ProtoMessage() {}
+
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTransactionResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *GetTransactionResponse) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *GetTransactionResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetTransactionResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetTransactionResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetTransactionResponse) GetReversesTransactionId() string {
+	if x != nil {
+		return x.ReversesTransactionId
+	}
+	return ""
+}
+
+func (x *GetTransactionResponse) GetReversedByTransactionIds() []string {
+	if x != nil {
+		return x.ReversedByTransactionIds
+	}
+	return nil
+}
+
+func (x *GetTransactionResponse) GetEffectiveDate() string {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return ""
+}
+
+// ListEntriesRequest pages by page number (page defaults to 1) unless a
+// cursor is given or use_cursor is set, in which case it pages by keyset
+// cursor on (created_at, id). Start a cursor listing with use_cursor and an
+// empty cursor, then pass back next_cursor until has_more is false.
+// At least one of account_id, transaction_id, reference_id, from or to is
+// required.
+type ListEntriesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountId       string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Page            int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor          string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	From            string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`           // RFC 3339, inclusive
+	To              string                 `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`               // RFC 3339, exclusive
+	Direction       string                 `protobuf:"bytes,7,opt,name=direction,proto3" json:"direction,omitempty"` // DEBIT or CREDIT
+	MinAmount       string                 `protobuf:"bytes,8,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount       string                 `protobuf:"bytes,9,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	ReferencePrefix string                 `protobuf:"bytes,10,opt,name=reference_prefix,json=referencePrefix,proto3" json:"reference_prefix,omitempty"`
+	Search          string                 `protobuf:"bytes,11,opt,name=search,proto3" json:"search,omitempty"`        // case-insensitive match on description
+	Ascending       bool                   `protobuf:"varint,12,opt,name=ascending,proto3" json:"ascending,omitempty"` // oldest first; newest first by default
+	TransactionId   string                 `protobuf:"bytes,13,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ReferenceId     string                 `protobuf:"bytes,14,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"` // exact match; see reference_prefix
+	UseCursor       bool                   `protobuf:"varint,15,opt,name=use_cursor,json=useCursor,proto3" json:"use_cursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListEntriesRequest) Reset() {
+	*x = ListEntriesRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntriesRequest) ProtoMessage() {}
+
+func (x *ListEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListEntriesRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEntriesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetMaxAmount() string {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetReferencePrefix() string {
+	if x != nil {
+		return x.ReferencePrefix
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *ListEntriesRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetUseCursor() bool {
+	if x != nil {
+		return x.UseCursor
+	}
+	return false
+}
+
+// ExportEntriesRequest takes the same filters as ListEntriesRequest. Entries
+// are streamed oldest first unless descending is set.
+type ExportEntriesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountId       string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	TransactionId   string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ReferenceId     string                 `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	From            string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To              string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Direction       string                 `protobuf:"bytes,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	MinAmount       string                 `protobuf:"bytes,7,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount       string                 `protobuf:"bytes,8,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	ReferencePrefix string                 `protobuf:"bytes,9,opt,name=reference_prefix,json=referencePrefix,proto3" json:"reference_prefix,omitempty"`
+	Search          string                 `protobuf:"bytes,10,opt,name=search,proto3" json:"search,omitempty"`
+	Descending      bool                   `protobuf:"varint,11,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExportEntriesRequest) Reset() {
+	*x = ExportEntriesRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEntriesRequest) ProtoMessage() {}
+
+func (x *ExportEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ExportEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportEntriesRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ExportEntriesRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ExportEntriesRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *ExportEntriesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ExportEntriesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ExportEntriesRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ExportEntriesRequest) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
+}
+
+func (x *ExportEntriesRequest) GetMaxAmount() string {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return ""
+}
+
+func (x *ExportEntriesRequest) GetReferencePrefix() string {
+	if x != nil {
+		return x.ReferencePrefix
+	}
+	return ""
+}
+
+func (x *ExportEntriesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ExportEntriesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type ListEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LedgerEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // page mode only
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntriesResponse) Reset() {
+	*x = ListEntriesResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntriesResponse) ProtoMessage() {}
+
+func (x *ListEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListEntriesResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListEntriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListEntriesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListEntriesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// LedgerAccount is an entry in the chart of accounts. Only listed accounts
+// can be posted to. The balance of an account grows with postings in its
+// normal_balance direction: DEBIT for ASSET and EXPENSE, CREDIT for
+// LIABILITY, EQUITY and REVENUE.
+type LedgerAccount struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountId       string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Class           string                 `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"`                                      // ASSET, LIABILITY, EQUITY, REVENUE or EXPENSE
+	NormalBalance   string                 `protobuf:"bytes,5,opt,name=normal_balance,json=normalBalance,proto3" json:"normal_balance,omitempty"` // DEBIT or CREDIT, derived from class
+	ParentAccountId string                 `protobuf:"bytes,6,opt,name=parent_account_id,json=parentAccountId,proto3" json:"parent_account_id,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LedgerAccount) Reset() {
+	*x = LedgerAccount{}
+	mi := &file_subledger_subledger_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerAccount) ProtoMessage() {}
+
+func (x *LedgerAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerAccount.ProtoReflect.Descriptor instead.
+func (*LedgerAccount) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LedgerAccount) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *LedgerAccount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LedgerAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LedgerAccount) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *LedgerAccount) GetNormalBalance() string {
+	if x != nil {
+		return x.NormalBalance
+	}
+	return ""
+}
+
+func (x *LedgerAccount) GetParentAccountId() string {
+	if x != nil {
+		return x.ParentAccountId
+	}
+	return ""
+}
+
+func (x *LedgerAccount) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateLedgerAccountRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountId       string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // optional, unique when set
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Class           string                 `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"`
+	ParentAccountId string                 `protobuf:"bytes,5,opt,name=parent_account_id,json=parentAccountId,proto3" json:"parent_account_id,omitempty"` // optional, must be of the same class
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateLedgerAccountRequest) Reset() {
+	*x = CreateLedgerAccountRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLedgerAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLedgerAccountRequest) ProtoMessage() {}
+
+func (x *CreateLedgerAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLedgerAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateLedgerAccountRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateLedgerAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreateLedgerAccountRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateLedgerAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateLedgerAccountRequest) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *CreateLedgerAccountRequest) GetParentAccountId() string {
+	if x != nil {
+		return x.ParentAccountId
+	}
+	return ""
+}
+
+type CreateLedgerAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *LedgerAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLedgerAccountResponse) Reset() {
+	*x = CreateLedgerAccountResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLedgerAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLedgerAccountResponse) ProtoMessage() {}
+
+func (x *CreateLedgerAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLedgerAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateLedgerAccountResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateLedgerAccountResponse) GetAccount() *LedgerAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type GetLedgerAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLedgerAccountRequest) Reset() {
+	*x = GetLedgerAccountRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerAccountRequest) ProtoMessage() {}
+
+func (x *GetLedgerAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerAccountRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetLedgerAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type GetLedgerAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *LedgerAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLedgerAccountResponse) Reset() {
+	*x = GetLedgerAccountResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerAccountResponse) ProtoMessage() {}
+
+func (x *GetLedgerAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerAccountResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetLedgerAccountResponse) GetAccount() *LedgerAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type ListLedgerAccountsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Class           string                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	ParentAccountId string                 `protobuf:"bytes,2,opt,name=parent_account_id,json=parentAccountId,proto3" json:"parent_account_id,omitempty"`
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListLedgerAccountsRequest) Reset() {
+	*x = ListLedgerAccountsRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLedgerAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLedgerAccountsRequest) ProtoMessage() {}
+
+func (x *ListLedgerAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLedgerAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListLedgerAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListLedgerAccountsRequest) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *ListLedgerAccountsRequest) GetParentAccountId() string {
+	if x != nil {
+		return x.ParentAccountId
+	}
+	return ""
+}
+
+func (x *ListLedgerAccountsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLedgerAccountsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListLedgerAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*LedgerAccount       `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLedgerAccountsResponse) Reset() {
+	*x = ListLedgerAccountsResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLedgerAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLedgerAccountsResponse) ProtoMessage() {}
+
+func (x *ListLedgerAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLedgerAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListLedgerAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListLedgerAccountsResponse) GetAccounts() []*LedgerAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ListLedgerAccountsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type VerifyLedgerIntegrityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyLedgerIntegrityRequest) Reset() {
+	*x = VerifyLedgerIntegrityRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyLedgerIntegrityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyLedgerIntegrityRequest) ProtoMessage() {}
+
+func (x *VerifyLedgerIntegrityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyLedgerIntegrityRequest.ProtoReflect.Descriptor instead.
+func (*VerifyLedgerIntegrityRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{24}
+}
+
+// ChainBreak is the first point at which the ledger no longer matches its
+// hash chain. expected and actual are the hashes or signatures compared.
+type ChainBreak struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           int64                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// PREV_HASH_MISMATCH, CONTENT_MISMATCH, CHECKPOINT_MISMATCH,
+	// CHECKPOINT_MISSING or UNCHAINED_TRANSACTION
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Expected      string `protobuf:"bytes,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual        string `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainBreak) Reset() {
+	*x = ChainBreak{}
+	mi := &file_subledger_subledger_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainBreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainBreak) ProtoMessage() {}
+
+func (x *ChainBreak) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainBreak.ProtoReflect.Descriptor instead.
+func (*ChainBreak) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChainBreak) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ChainBreak) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ChainBreak) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ChainBreak) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *ChainBreak) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+type VerifyLedgerIntegrityResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Intact             bool                   `protobuf:"varint,1,opt,name=intact,proto3" json:"intact,omitempty"`
+	LinksChecked       int64                  `protobuf:"varint,2,opt,name=links_checked,json=linksChecked,proto3" json:"links_checked,omitempty"`
+	CheckpointsChecked int32                  `protobuf:"varint,3,opt,name=checkpoints_checked,json=checkpointsChecked,proto3" json:"checkpoints_checked,omitempty"`
+	HeadSeq            int64                  `protobuf:"varint,4,opt,name=head_seq,json=headSeq,proto3" json:"head_seq,omitempty"`
+	HeadHash           string                 `protobuf:"bytes,5,opt,name=head_hash,json=headHash,proto3" json:"head_hash,omitempty"`
+	FirstBreak         *ChainBreak            `protobuf:"bytes,6,opt,name=first_break,json=firstBreak,proto3" json:"first_break,omitempty"` // unset when intact
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *VerifyLedgerIntegrityResponse) Reset() {
+	*x = VerifyLedgerIntegrityResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyLedgerIntegrityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyLedgerIntegrityResponse) ProtoMessage() {}
+
+func (x *VerifyLedgerIntegrityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyLedgerIntegrityResponse.ProtoReflect.Descriptor instead.
+func (*VerifyLedgerIntegrityResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VerifyLedgerIntegrityResponse) GetIntact() bool {
+	if x != nil {
+		return x.Intact
+	}
+	return false
+}
+
+func (x *VerifyLedgerIntegrityResponse) GetLinksChecked() int64 {
+	if x != nil {
+		return x.LinksChecked
+	}
+	return 0
+}
+
+func (x *VerifyLedgerIntegrityResponse) GetCheckpointsChecked() int32 {
+	if x != nil {
+		return x.CheckpointsChecked
+	}
+	return 0
+}
+
+func (x *VerifyLedgerIntegrityResponse) GetHeadSeq() int64 {
+	if x != nil {
+		return x.HeadSeq
+	}
+	return 0
+}
+
+func (x *VerifyLedgerIntegrityResponse) GetHeadHash() string {
+	if x != nil {
+		return x.HeadHash
+	}
+	return ""
+}
+
+func (x *VerifyLedgerIntegrityResponse) GetFirstBreak() *ChainBreak {
+	if x != nil {
+		return x.FirstBreak
+	}
+	return nil
+}
+
+// AccountingPeriod is a calendar month of the ledger. ends_on is the first
+// day of the next month.
+type AccountingPeriod struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Period   string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`                     // YYYY-MM
+	StartsOn string                 `protobuf:"bytes,2,opt,name=starts_on,json=startsOn,proto3" json:"starts_on,omitempty"` // YYYY-MM-DD
+	EndsOn   string                 `protobuf:"bytes,3,opt,name=ends_on,json=endsOn,proto3" json:"ends_on,omitempty"`       // YYYY-MM-DD
+	Status   string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                     // OPEN or CLOSED
+	ClosedAt string                 `protobuf:"bytes,5,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	// Head of the ledger hash chain when the period was closed.
+	ChainSeq      int64 `protobuf:"varint,6,opt,name=chain_seq,json=chainSeq,proto3" json:"chain_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountingPeriod) Reset() {
+	*x = AccountingPeriod{}
+	mi := &file_subledger_subledger_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountingPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountingPeriod) ProtoMessage() {}
+
+func (x *AccountingPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountingPeriod.ProtoReflect.Descriptor instead.
+func (*AccountingPeriod) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AccountingPeriod) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetStartsOn() string {
+	if x != nil {
+		return x.StartsOn
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetEndsOn() string {
+	if x != nil {
+		return x.EndsOn
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetChainSeq() int64 {
+	if x != nil {
+		return x.ChainSeq
+	}
+	return 0
+}
+
+type ClosingBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosingBalance) Reset() {
+	*x = ClosingBalance{}
+	mi := &file_subledger_subledger_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosingBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosingBalance) ProtoMessage() {}
+
+func (x *ClosingBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosingBalance.ProtoReflect.Descriptor instead.
+func (*ClosingBalance) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ClosingBalance) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ClosingBalance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type ClosePeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // YYYY-MM
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosePeriodRequest) Reset() {
+	*x = ClosePeriodRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosePeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePeriodRequest) ProtoMessage() {}
+
+func (x *ClosePeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePeriodRequest.ProtoReflect.Descriptor instead.
+func (*ClosePeriodRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ClosePeriodRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type ClosePeriodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        *AccountingPeriod      `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Balances      []*ClosingBalance      `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosePeriodResponse) Reset() {
+	*x = ClosePeriodResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosePeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePeriodResponse) ProtoMessage() {}
+
+func (x *ClosePeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePeriodResponse.ProtoReflect.Descriptor instead.
+func (*ClosePeriodResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ClosePeriodResponse) GetPeriod() *AccountingPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *ClosePeriodResponse) GetBalances() []*ClosingBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type ListAccountingPeriodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // OPEN or CLOSED; all periods when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountingPeriodsRequest) Reset() {
+	*x = ListAccountingPeriodsRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountingPeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountingPeriodsRequest) ProtoMessage() {}
+
+func (x *ListAccountingPeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountingPeriodsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountingPeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListAccountingPeriodsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListAccountingPeriodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Periods       []*AccountingPeriod    `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountingPeriodsResponse) Reset() {
+	*x = ListAccountingPeriodsResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountingPeriodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountingPeriodsResponse) ProtoMessage() {}
+
+func (x *ListAccountingPeriodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountingPeriodsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountingPeriodsResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListAccountingPeriodsResponse) GetPeriods() []*AccountingPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type GetClosingBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // YYYY-MM
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClosingBalancesRequest) Reset() {
+	*x = GetClosingBalancesRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClosingBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClosingBalancesRequest) ProtoMessage() {}
+
+func (x *GetClosingBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClosingBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetClosingBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetClosingBalancesRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type GetClosingBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        *AccountingPeriod      `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Balances      []*ClosingBalance      `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClosingBalancesResponse) Reset() {
+	*x = GetClosingBalancesResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClosingBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClosingBalancesResponse) ProtoMessage() {}
+
+func (x *GetClosingBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClosingBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetClosingBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetClosingBalancesResponse) GetPeriod() *AccountingPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *GetClosingBalancesResponse) GetBalances() []*ClosingBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+// AuditEntry is one mutating call as recorded in the append-only audit
+// log. request, before and after are JSON with secrets redacted.
+type AuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Service       string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`                        // method name, e.g. Deposit
+	ActorType     string                 `protobuf:"bytes,5,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"` // API_KEY, SERVICE or UNKNOWN
+	ActorId       string                 `protobuf:"bytes,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ClaimedUserId string                 `protobuf:"bytes,7,opt,name=claimed_user_id,json=claimedUserId,proto3" json:"claimed_user_id,omitempty"` // X-User-ID as sent by the partner, unverified
+	Target        string                 `protobuf:"bytes,8,opt,name=target,proto3" json:"target,omitempty"`                                      // e.g. account_id=1001
+	Request       string                 `protobuf:"bytes,9,opt,name=request,proto3" json:"request,omitempty"`
+	Before        string                 `protobuf:"bytes,10,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,11,opt,name=after,proto3" json:"after,omitempty"`
+	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"` // gRPC status code, OK on success
+	Error         string                 `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	RequestId     string                 `protobuf:"bytes,14,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SourceIp      string                 `protobuf:"bytes,15,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`    // address the gateway was called from
+	ClaimedIp     string                 `protobuf:"bytes,16,opt,name=claimed_ip,json=claimedIp,proto3" json:"claimed_ip,omitempty"` // address X-Forwarded-For claims, unverified
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_subledger_subledger_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetClaimedUserId() string {
+	if x != nil {
+		return x.ClaimedUserId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetClaimedIp() string {
+	if x != nil {
+		return x.ClaimedIp
+	}
+	return ""
+}
+
+type ListAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	From          string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"` // RFC 3339, inclusive
+	To            string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`     // RFC 3339, exclusive
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogRequest) Reset() {
+	*x = ListAuditLogRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogRequest) ProtoMessage() {}
+
+func (x *ListAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListAuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogResponse) Reset() {
+	*x = ListAuditLogResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogResponse) ProtoMessage() {}
+
+func (x *ListAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListAuditLogResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListAuditLogResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListAuditLogResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// A journal has one entry per line, with the columns (or JSON keys)
+// reference_id, effective_date, description, account_id, direction and
+// amount. Entries sharing a reference_id, and transaction_id if given, make
+// up one transaction.
+type ImportJournalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // csv or jsonl
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJournalRequest) Reset() {
+	*x = ImportJournalRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJournalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJournalRequest) ProtoMessage() {}
+
+func (x *ImportJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJournalRequest.ProtoReflect.Descriptor instead.
+func (*ImportJournalRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportJournalRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportJournalRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportJournalRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// JournalIssue is a problem with a line of the journal, or with the
+// transaction starting on it.
+type JournalIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // e.g. UNBALANCED_TRANSACTION, UNKNOWN_LEDGER_ACCOUNT
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JournalIssue) Reset() {
+	*x = JournalIssue{}
+	mi := &file_subledger_subledger_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalIssue) ProtoMessage() {}
+
+func (x *JournalIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalIssue.ProtoReflect.Descriptor instead.
+func (*JournalIssue) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *JournalIssue) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *JournalIssue) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *JournalIssue) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *JournalIssue) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportedTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferenceId   string                 `protobuf:"bytes,1,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedTransaction) Reset() {
+	*x = ImportedTransaction{}
+	mi := &file_subledger_subledger_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedTransaction) ProtoMessage() {}
+
+func (x *ImportedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedTransaction.ProtoReflect.Descriptor instead.
+func (*ImportedTransaction) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ImportedTransaction) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *ImportedTransaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type ImportJournalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Lines         int32                  `protobuf:"varint,2,opt,name=lines,proto3" json:"lines,omitempty"`
+	Transactions  int32                  `protobuf:"varint,3,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	Issues        []*JournalIssue        `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
+	Posted        []*ImportedTransaction `protobuf:"bytes,5,rep,name=posted,proto3" json:"posted,omitempty"` // empty unless posted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJournalResponse) Reset() {
+	*x = ImportJournalResponse{}
+	mi := &file_subledger_subledger_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJournalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJournalResponse) ProtoMessage() {}
+
+func (x *ImportJournalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJournalResponse.ProtoReflect.Descriptor instead.
+func (*ImportJournalResponse) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ImportJournalResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportJournalResponse) GetLines() int32 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+func (x *ImportJournalResponse) GetTransactions() int32 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+func (x *ImportJournalResponse) GetIssues() []*JournalIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ImportJournalResponse) GetPosted() []*ImportedTransaction {
+	if x != nil {
+		return x.Posted
+	}
+	return nil
+}
+
+type ExportJournalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // YYYY-MM
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`     // entries (default) or balances
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // csv (default) or jsonl
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportJournalRequest) Reset() {
+	*x = ExportJournalRequest{}
+	mi := &file_subledger_subledger_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportJournalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJournalRequest) ProtoMessage() {}
+
+func (x *ExportJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJournalRequest.ProtoReflect.Descriptor instead.
+func (*ExportJournalRequest) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExportJournalRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ExportJournalRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ExportJournalRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// JournalChunk carries the exported journal. content_type and filename are
+// only set on the first chunk.
+type JournalChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JournalChunk) Reset() {
+	*x = JournalChunk{}
+	mi := &file_subledger_subledger_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalChunk) ProtoMessage() {}
+
+func (x *JournalChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_subledger_subledger_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalChunk.ProtoReflect.Descriptor instead.
+func (*JournalChunk) Descriptor() ([]byte, []int) {
+	return file_subledger_subledger_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *JournalChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *JournalChunk) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *JournalChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_subledger_subledger_proto protoreflect.FileDescriptor
+
+const file_subledger_subledger_proto_rawDesc = "" +
+	"\n" +
+	"\x19subledger/subledger.proto\x12\tsubledger\"\xb2\x01\n" +
+	"\x18CreateTransactionRequest\x12!\n" +
+	"\freference_id\x18\x01 \x01(\tR\vreferenceId\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12*\n" +
+	"\aentries\x18\x03 \x03(\v2\x10.subledger.EntryR\aentries\x12%\n" +
+	"\x0eeffective_date\x18\x04 \x01(\tR\reffectiveDate\"\\\n" +
+	"\x05Entry\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\"\\\n" +
+	"\x19CreateTransactionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\"|\n" +
+	"\x1dCreateTransactionBatchRequest\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12G\n" +
+	"\ftransactions\x18\x02 \x03(\v2#.subledger.CreateTransactionRequestR\ftransactions\"\xb9\x01\n" +
+	"\x0fBatchItemResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12!\n" +
+	"\freference_id\x18\x02 \x01(\tR\vreferenceId\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12%\n" +
+	"\x0etransaction_id\x18\x04 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\x9e\x01\n" +
+	"\x1eCreateTransactionBatchResponse\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x16\n" +
+	"\x06posted\x18\x02 \x01(\x05R\x06posted\x12\x1a\n" +
+	"\brejected\x18\x03 \x01(\x05R\brejected\x124\n" +
+	"\aresults\x18\x04 \x03(\v2\x1a.subledger.BatchItemResultR\aresults\"G\n" +
+	"\x11GetBalanceRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x13\n" +
+	"\x05as_of\x18\x02 \x01(\tR\x04asOf\"\x86\x01\n" +
+	"\x12GetBalanceResponse\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"J\n" +
+	"\x12GetBalancesRequest\x12\x1f\n" +
+	"\vaccount_ids\x18\x01 \x03(\tR\n" +
+	"accountIds\x12\x13\n" +
+	"\x05as_of\x18\x02 \x01(\tR\x04asOf\"G\n" +
+	"\x0eAccountBalance\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"L\n" +
+	"\x13GetBalancesResponse\x125\n" +
+	"\bbalances\x18\x01 \x03(\v2\x19.subledger.AccountBalanceR\bbalances\">\n" +
+	"\x15GetTransactionRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\"\xde\x02\n" +
+	"\vLedgerEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1c\n" +
+	"\tdirection\x18\x04 \x01(\tR\tdirection\x12%\n" +
+	"\x0etransaction_id\x18\x05 \x01(\tR\rtransactionId\x12!\n" +
+	"\freference_id\x18\x06 \x01(\tR\vreferenceId\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x128\n" +
+	"\x18counterparty_account_ids\x18\t \x03(\tR\x16counterpartyAccountIds\x12%\n" +
+	"\x0eeffective_date\x18\n" +
+	" \x01(\tR\reffectiveDate\"\xf3\x02\n" +
+	"\x16GetTransactionResponse\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12!\n" +
+	"\freference_id\x18\x02 \x01(\tR\vreferenceId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x120\n" +
+	"\aentries\x18\x05 \x03(\v2\x16.subledger.LedgerEntryR\aentries\x126\n" +
+	"\x17reverses_transaction_id\x18\x06 \x01(\tR\x15reversesTransactionId\x12=\n" +
+	"\x1breversed_by_transaction_ids\x18\a \x03(\tR\x18reversedByTransactionIds\x12%\n" +
+	"\x0eeffective_date\x18\b \x01(\tR\reffectiveDate\"\xc6\x03\n" +
+	"\x12ListEntriesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\tR\x02to\x12\x1c\n" +
+	"\tdirection\x18\a \x01(\tR\tdirection\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\b \x01(\tR\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\t \x01(\tR\tmaxAmount\x12)\n" +
+	"\x10reference_prefix\x18\n" +
+	" \x01(\tR\x0freferencePrefix\x12\x16\n" +
+	"\x06search\x18\v \x01(\tR\x06search\x12\x1c\n" +
+	"\tascending\x18\f \x01(\bR\tascending\x12%\n" +
+	"\x0etransaction_id\x18\r \x01(\tR\rtransactionId\x12!\n" +
+	"\freference_id\x18\x0e \x01(\tR\vreferenceId\x12\x1d\n" +
+	"\n" +
+	"use_cursor\x18\x0f \x01(\bR\tuseCursor\"\xe2\x02\n" +
+	"\x14ExportEntriesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12!\n" +
+	"\freference_id\x18\x03 \x01(\tR\vreferenceId\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12\x1c\n" +
+	"\tdirection\x18\x06 \x01(\tR\tdirection\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\a \x01(\tR\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\b \x01(\tR\tmaxAmount\x12)\n" +
+	"\x10reference_prefix\x18\t \x01(\tR\x0freferencePrefix\x12\x16\n" +
+	"\x06search\x18\n" +
+	" \x01(\tR\x06search\x12\x1e\n" +
+	"\n" +
+	"descending\x18\v \x01(\bR\n" +
+	"descending\"\xa4\x01\n" +
+	"\x13ListEntriesResponse\x120\n" +
+	"\aentries\x18\x01 \x03(\v2\x16.subledger.LedgerEntryR\aentries\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"\xde\x01\n" +
+	"\rLedgerAccount\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05class\x18\x04 \x01(\tR\x05class\x12%\n" +
+	"\x0enormal_balance\x18\x05 \x01(\tR\rnormalBalance\x12*\n" +
+	"\x11parent_account_id\x18\x06 \x01(\tR\x0fparentAccountId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\xa5\x01\n" +
+	"\x1aCreateLedgerAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05class\x18\x04 \x01(\tR\x05class\x12*\n" +
+	"\x11parent_account_id\x18\x05 \x01(\tR\x0fparentAccountId\"Q\n" +
+	"\x1bCreateLedgerAccountResponse\x122\n" +
+	"\aaccount\x18\x01 \x01(\v2\x18.subledger.LedgerAccountR\aaccount\"8\n" +
+	"\x17GetLedgerAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"N\n" +
+	"\x18GetLedgerAccountResponse\x122\n" +
+	"\aaccount\x18\x01 \x01(\v2\x18.subledger.LedgerAccountR\aaccount\"\x8e\x01\n" +
+	"\x19ListLedgerAccountsRequest\x12\x14\n" +
+	"\x05class\x18\x01 \x01(\tR\x05class\x12*\n" +
+	"\x11parent_account_id\x18\x02 \x01(\tR\x0fparentAccountId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"s\n" +
+	"\x1aListLedgerAccountsResponse\x124\n" +
+	"\baccounts\x18\x01 \x03(\v2\x18.subledger.LedgerAccountR\baccounts\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x1e\n" +
+	"\x1cVerifyLedgerIntegrityRequest\"\x91\x01\n" +
+	"\n" +
+	"ChainBreak\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bexpected\x18\x04 \x01(\tR\bexpected\x12\x16\n" +
+	"\x06actual\x18\x05 \x01(\tR\x06actual\"\xfd\x01\n" +
+	"\x1dVerifyLedgerIntegrityResponse\x12\x16\n" +
+	"\x06intact\x18\x01 \x01(\bR\x06intact\x12#\n" +
+	"\rlinks_checked\x18\x02 \x01(\x03R\flinksChecked\x12/\n" +
+	"\x13checkpoints_checked\x18\x03 \x01(\x05R\x12checkpointsChecked\x12\x19\n" +
+	"\bhead_seq\x18\x04 \x01(\x03R\aheadSeq\x12\x1b\n" +
+	"\thead_hash\x18\x05 \x01(\tR\bheadHash\x126\n" +
+	"\vfirst_break\x18\x06 \x01(\v2\x15.subledger.ChainBreakR\n" +
+	"firstBreak\"\xb2\x01\n" +
+	"\x10AccountingPeriod\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x1b\n" +
+	"\tstarts_on\x18\x02 \x01(\tR\bstartsOn\x12\x17\n" +
+	"\aends_on\x18\x03 \x01(\tR\x06endsOn\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1b\n" +
+	"\tclosed_at\x18\x05 \x01(\tR\bclosedAt\x12\x1b\n" +
+	"\tchain_seq\x18\x06 \x01(\x03R\bchainSeq\"G\n" +
+	"\x0eClosingBalance\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\",\n" +
+	"\x12ClosePeriodRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\"\x81\x01\n" +
+	"\x13ClosePeriodResponse\x123\n" +
+	"\x06period\x18\x01 \x01(\v2\x1b.subledger.AccountingPeriodR\x06period\x125\n" +
+	"\bbalances\x18\x02 \x03(\v2\x19.subledger.ClosingBalanceR\bbalances\"6\n" +
+	"\x1cListAccountingPeriodsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"V\n" +
+	"\x1dListAccountingPeriodsResponse\x125\n" +
+	"\aperiods\x18\x01 \x03(\v2\x1b.subledger.AccountingPeriodR\aperiods\"3\n" +
+	"\x19GetClosingBalancesRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\"\x88\x01\n" +
+	"\x1aGetClosingBalancesResponse\x123\n" +
+	"\x06period\x18\x01 \x01(\v2\x1b.subledger.AccountingPeriodR\x06period\x125\n" +
+	"\bbalances\x18\x02 \x03(\v2\x19.subledger.ClosingBalanceR\bbalances\"\xba\x03\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\voccurred_at\x18\x02 \x01(\tR\n" +
+	"occurredAt\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x05 \x01(\tR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\tR\aactorId\x12&\n" +
+	"\x0fclaimed_user_id\x18\a \x01(\tR\rclaimedUserId\x12\x16\n" +
+	"\x06target\x18\b \x01(\tR\x06target\x12\x18\n" +
+	"\arequest\x18\t \x01(\tR\arequest\x12\x16\n" +
+	"\x06before\x18\n" +
+	" \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\v \x01(\tR\x05after\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\r \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x0e \x01(\tR\trequestId\x12\x1b\n" +
+	"\tsource_ip\x18\x0f \x01(\tR\bsourceIp\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x04 \x01(\tR\tupdatedAt2\xbd\x01\n" +
+	"claimed_ip\x18\x10 \x01(\tR\tclaimedIp\"\xb5\x01\n" +
+	"\x13ListAuditLogRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\"\x99\x01\n" +
+	"\x14ListAuditLogResponse\x12/\n" +
+	"\aentries\x18\x01 \x03(\v2\x15.subledger.AuditEntryR\aentries\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"[\n" +
+	"\x14ImportJournalRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"s\n" +
+	"\fJournalIssue\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12!\n" +
+	"\freference_id\x18\x02 \x01(\tR\vreferenceId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"_\n" +
+	"\x13ImportedTransaction\x12!\n" +
+	"\freference_id\x18\x01 \x01(\tR\vreferenceId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\"\xd3\x01\n" +
+	"\x15ImportJournalResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05lines\x18\x02 \x01(\x05R\x05lines\x12\"\n" +
+	"\ftransactions\x18\x03 \x01(\x05R\ftransactions\x12/\n" +
+	"\x06issues\x18\x04 \x03(\v2\x17.subledger.JournalIssueR\x06issues\x126\n" +
+	"\x06posted\x18\x05 \x03(\v2\x1e.subledger.ImportedTransactionR\x06posted\"Z\n" +
+	"\x14ExportJournalRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\"a\n" +
+	"\fJournalChunk\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data2\x80\r\n" +
 	"\x10SubledgerService\x12^\n" +
-	"\x11CreateTransaction\x12#.subledger.CreateTransactionRequest\x1a$.subledger.CreateTransactionResponse\x12I\n" +
+	"\x11CreateTransaction\x12#.subledger.CreateTransactionRequest\x1a$.subledger.CreateTransactionResponse\x12m\n" +
+	"\x16CreateTransactionBatch\x12(.subledger.CreateTransactionBatchRequest\x1a).subledger.CreateTransactionBatchResponse\x12p\n" +
+	"\x17CreateTransactionStream\x12(.subledger.CreateTransactionBatchRequest\x1a).subledger.CreateTransactionBatchResponse(\x01\x12I\n" +
 	"\n" +
-	"GetBalance\x12\x1c.subledger.GetBalanceRequest\x1a\x1d.subledger.GetBalanceResponseB=Z;wasin.com/github.com/ChotongW/grit_demo_wallet/pb/subledgerb\x06proto3"
+	"GetBalance\x12\x1c.subledger.GetBalanceRequest\x1a\x1d.subledger.GetBalanceResponse\x12L\n" +
+	"\vGetBalances\x12\x1d.subledger.GetBalancesRequest\x1a\x1e.subledger.GetBalancesResponse\x12U\n" +
+	"\x0eGetTransaction\x12 .subledger.GetTransactionRequest\x1a!.subledger.GetTransactionResponse\x12L\n" +
+	"\vListEntries\x12\x1d.subledger.ListEntriesRequest\x1a\x1e.subledger.ListEntriesResponse\x12J\n" +
+	"\rExportEntries\x12\x1f.subledger.ExportEntriesRequest\x1a\x16.subledger.LedgerEntry0\x01\x12d\n" +
+	"\x13CreateLedgerAccount\x12%.subledger.CreateLedgerAccountRequest\x1a&.subledger.CreateLedgerAccountResponse\x12[\n" +
+	"\x10GetLedgerAccount\x12\".subledger.GetLedgerAccountRequest\x1a#.subledger.GetLedgerAccountResponse\x12a\n" +
+	"\x12ListLedgerAccounts\x12$.subledger.ListLedgerAccountsRequest\x1a%.subledger.ListLedgerAccountsResponse\x12j\n" +
+	"\x15VerifyLedgerIntegrity\x12'.subledger.VerifyLedgerIntegrityRequest\x1a(.subledger.VerifyLedgerIntegrityResponse\x12L\n" +
+	"\vClosePeriod\x12\x1d.subledger.ClosePeriodRequest\x1a\x1e.subledger.ClosePeriodResponse\x12j\n" +
+	"\x15ListAccountingPeriods\x12'.subledger.ListAccountingPeriodsRequest\x1a(.subledger.ListAccountingPeriodsResponse\x12a\n" +
+	"\x12GetClosingBalances\x12$.subledger.GetClosingBalancesRequest\x1a%.subledger.GetClosingBalancesResponse\x12O\n" +
+	"\fListAuditLog\x12\x1e.subledger.ListAuditLogRequest\x1a\x1f.subledger.ListAuditLogResponse\x12T\n" +
+	"\rImportJournal\x12\x1f.subledger.ImportJournalRequest\x1a .subledger.ImportJournalResponse(\x01\x12K\n" +
+	"\rExportJournal\x12\x1f.subledger.ExportJournalRequest\x1a\x17.subledger.JournalChunk0\x01B=Z;wasin.com/github.com/ChotongW/grit_demo_wallet/pb/subledgerb\x06proto3"
 
 var (
 	file_subledger_subledger_proto_rawDescOnce sync.Once
@@ -349,25 +3348,113 @@ func file_subledger_subledger_proto_rawDescGZIP() []byte {
 	return file_subledger_subledger_proto_rawDescData
 }
 
-var file_subledger_subledger_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_subledger_subledger_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_subledger_subledger_proto_goTypes = []any{
-	(*CreateTransactionRequest)(nil),  // 0: subledger.CreateTransactionRequest
-	(*Entry)(nil),                     // 1: subledger.Entry
-	(*CreateTransactionResponse)(nil), // 2: subledger.CreateTransactionResponse
-	(*GetBalanceRequest)(nil),         // 3: subledger.GetBalanceRequest
-	(*GetBalanceResponse)(nil),        // 4: subledger.GetBalanceResponse
+	(*CreateTransactionRequest)(nil),       // 0: subledger.CreateTransactionRequest
+	(*Entry)(nil),                          // 1: subledger.Entry
+	(*CreateTransactionResponse)(nil),      // 2: subledger.CreateTransactionResponse
+	(*CreateTransactionBatchRequest)(nil),  // 3: subledger.CreateTransactionBatchRequest
+	(*BatchItemResult)(nil),                // 4: subledger.BatchItemResult
+	(*CreateTransactionBatchResponse)(nil), // 5: subledger.CreateTransactionBatchResponse
+	(*GetBalanceRequest)(nil),              // 6: subledger.GetBalanceRequest
+	(*GetBalanceResponse)(nil),             // 7: subledger.GetBalanceResponse
+	(*GetBalancesRequest)(nil),             // 8: subledger.GetBalancesRequest
+	(*AccountBalance)(nil),                 // 9: subledger.AccountBalance
+	(*GetBalancesResponse)(nil),            // 10: subledger.GetBalancesResponse
+	(*GetTransactionRequest)(nil),          // 11: subledger.GetTransactionRequest
+	(*LedgerEntry)(nil),                    // 12: subledger.LedgerEntry
+	(*GetTransactionResponse)(nil),         // 13: subledger.GetTransactionResponse
+	(*ListEntriesRequest)(nil),             // 14: subledger.ListEntriesRequest
+	(*ExportEntriesRequest)(nil),           // 15: subledger.ExportEntriesRequest
+	(*ListEntriesResponse)(nil),            // 16: subledger.ListEntriesResponse
+	(*LedgerAccount)(nil),                  // 17: subledger.LedgerAccount
+	(*CreateLedgerAccountRequest)(nil),     // 18: subledger.CreateLedgerAccountRequest
+	(*CreateLedgerAccountResponse)(nil),    // 19: subledger.CreateLedgerAccountResponse
+	(*GetLedgerAccountRequest)(nil),        // 20: subledger.GetLedgerAccountRequest
+	(*GetLedgerAccountResponse)(nil),       // 21: subledger.GetLedgerAccountResponse
+	(*ListLedgerAccountsRequest)(nil),      // 22: subledger.ListLedgerAccountsRequest
+	(*ListLedgerAccountsResponse)(nil),     // 23: subledger.ListLedgerAccountsResponse
+	(*VerifyLedgerIntegrityRequest)(nil),   // 24: subledger.VerifyLedgerIntegrityRequest
+	(*ChainBreak)(nil),                     // 25: subledger.ChainBreak
+	(*VerifyLedgerIntegrityResponse)(nil),  // 26: subledger.VerifyLedgerIntegrityResponse
+	(*AccountingPeriod)(nil),               // 27: subledger.AccountingPeriod
+	(*ClosingBalance)(nil),                 // 28: subledger.ClosingBalance
+	(*ClosePeriodRequest)(nil),             // 29: subledger.ClosePeriodRequest
+	(*ClosePeriodResponse)(nil),            // 30: subledger.ClosePeriodResponse
+	(*ListAccountingPeriodsRequest)(nil),   // 31: subledger.ListAccountingPeriodsRequest
+	(*ListAccountingPeriodsResponse)(nil),  // 32: subledger.ListAccountingPeriodsResponse
+	(*GetClosingBalancesRequest)(nil),      // 33: subledger.GetClosingBalancesRequest
+	(*GetClosingBalancesResponse)(nil),     // 34: subledger.GetClosingBalancesResponse
+	(*AuditEntry)(nil),                     // 35: subledger.AuditEntry
+	(*ListAuditLogRequest)(nil),            // 36: subledger.ListAuditLogRequest
+	(*ListAuditLogResponse)(nil),           // 37: subledger.ListAuditLogResponse
+	(*ImportJournalRequest)(nil),           // 38: subledger.ImportJournalRequest
+	(*JournalIssue)(nil),                   // 39: subledger.JournalIssue
+	(*ImportedTransaction)(nil),            // 40: subledger.ImportedTransaction
+	(*ImportJournalResponse)(nil),          // 41: subledger.ImportJournalResponse
+	(*ExportJournalRequest)(nil),           // 42: subledger.ExportJournalRequest
+	(*JournalChunk)(nil),                   // 43: subledger.JournalChunk
 }
 var file_subledger_subledger_proto_depIdxs = []int32{
-	1, // 0: subledger.CreateTransactionRequest.entries:type_name -> subledger.Entry
-	0, // 1: subledger.SubledgerService.CreateTransaction:input_type -> subledger.CreateTransactionRequest
-	3, // 2: subledger.SubledgerService.GetBalance:input_type -> subledger.GetBalanceRequest
-	2, // 3: subledger.SubledgerService.CreateTransaction:output_type -> subledger.CreateTransactionResponse
-	4, // 4: subledger.SubledgerService.GetBalance:output_type -> subledger.GetBalanceResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: subledger.CreateTransactionRequest.entries:type_name -> subledger.Entry
+	0,  // 1: subledger.CreateTransactionBatchRequest.transactions:type_name -> subledger.CreateTransactionRequest
+	4,  // 2: subledger.CreateTransactionBatchResponse.results:type_name -> subledger.BatchItemResult
+	9,  // 3: subledger.GetBalancesResponse.balances:type_name -> subledger.AccountBalance
+	12, // 4: subledger.GetTransactionResponse.entries:type_name -> subledger.LedgerEntry
+	12, // 5: subledger.ListEntriesResponse.entries:type_name -> subledger.LedgerEntry
+	17, // 6: subledger.CreateLedgerAccountResponse.account:type_name -> subledger.LedgerAccount
+	17, // 7: subledger.GetLedgerAccountResponse.account:type_name -> subledger.LedgerAccount
+	17, // 8: subledger.ListLedgerAccountsResponse.accounts:type_name -> subledger.LedgerAccount
+	25, // 9: subledger.VerifyLedgerIntegrityResponse.first_break:type_name -> subledger.ChainBreak
+	27, // 10: subledger.ClosePeriodResponse.period:type_name -> subledger.AccountingPeriod
+	28, // 11: subledger.ClosePeriodResponse.balances:type_name -> subledger.ClosingBalance
+	27, // 12: subledger.ListAccountingPeriodsResponse.periods:type_name -> subledger.AccountingPeriod
+	27, // 13: subledger.GetClosingBalancesResponse.period:type_name -> subledger.AccountingPeriod
+	28, // 14: subledger.GetClosingBalancesResponse.balances:type_name -> subledger.ClosingBalance
+	35, // 15: subledger.ListAuditLogResponse.entries:type_name -> subledger.AuditEntry
+	39, // 16: subledger.ImportJournalResponse.issues:type_name -> subledger.JournalIssue
+	40, // 17: subledger.ImportJournalResponse.posted:type_name -> subledger.ImportedTransaction
+	0,  // 18: subledger.SubledgerService.CreateTransaction:input_type -> subledger.CreateTransactionRequest
+	3,  // 19: subledger.SubledgerService.CreateTransactionBatch:input_type -> subledger.CreateTransactionBatchRequest
+	3,  // 20: subledger.SubledgerService.CreateTransactionStream:input_type -> subledger.CreateTransactionBatchRequest
+	6,  // 21: subledger.SubledgerService.GetBalance:input_type -> subledger.GetBalanceRequest
+	8,  // 22: subledger.SubledgerService.GetBalances:input_type -> subledger.GetBalancesRequest
+	11, // 23: subledger.SubledgerService.GetTransaction:input_type -> subledger.GetTransactionRequest
+	14, // 24: subledger.SubledgerService.ListEntries:input_type -> subledger.ListEntriesRequest
+	15, // 25: subledger.SubledgerService.ExportEntries:input_type -> subledger.ExportEntriesRequest
+	18, // 26: subledger.SubledgerService.CreateLedgerAccount:input_type -> subledger.CreateLedgerAccountRequest
+	20, // 27: subledger.SubledgerService.GetLedgerAccount:input_type -> subledger.GetLedgerAccountRequest
+	22, // 28: subledger.SubledgerService.ListLedgerAccounts:input_type -> subledger.ListLedgerAccountsRequest
+	24, // 29: subledger.SubledgerService.VerifyLedgerIntegrity:input_type -> subledger.VerifyLedgerIntegrityRequest
+	29, // 30: subledger.SubledgerService.ClosePeriod:input_type -> subledger.ClosePeriodRequest
+	31, // 31: subledger.SubledgerService.ListAccountingPeriods:input_type -> subledger.ListAccountingPeriodsRequest
+	33, // 32: subledger.SubledgerService.GetClosingBalances:input_type -> subledger.GetClosingBalancesRequest
+	36, // 33: subledger.SubledgerService.ListAuditLog:input_type -> subledger.ListAuditLogRequest
+	38, // 34: subledger.SubledgerService.ImportJournal:input_type -> subledger.ImportJournalRequest
+	42, // 35: subledger.SubledgerService.ExportJournal:input_type -> subledger.ExportJournalRequest
+	2,  // 36: subledger.SubledgerService.CreateTransaction:output_type -> subledger.CreateTransactionResponse
+	5,  // 37: subledger.SubledgerService.CreateTransactionBatch:output_type -> subledger.CreateTransactionBatchResponse
+	5,  // 38: subledger.SubledgerService.CreateTransactionStream:output_type -> subledger.CreateTransactionBatchResponse
+	7,  // 39: subledger.SubledgerService.GetBalance:output_type -> subledger.GetBalanceResponse
+	10, // 40: subledger.SubledgerService.GetBalances:output_type -> subledger.GetBalancesResponse
+	13, // 41: subledger.SubledgerService.GetTransaction:output_type -> subledger.GetTransactionResponse
+	16, // 42: subledger.SubledgerService.ListEntries:output_type -> subledger.ListEntriesResponse
+	12, // 43: subledger.SubledgerService.ExportEntries:output_type -> subledger.LedgerEntry
+	19, // 44: subledger.SubledgerService.CreateLedgerAccount:output_type -> subledger.CreateLedgerAccountResponse
+	21, // 45: subledger.SubledgerService.GetLedgerAccount:output_type -> subledger.GetLedgerAccountResponse
+	23, // 46: subledger.SubledgerService.ListLedgerAccounts:output_type -> subledger.ListLedgerAccountsResponse
+	26, // 47: subledger.SubledgerService.VerifyLedgerIntegrity:output_type -> subledger.VerifyLedgerIntegrityResponse
+	30, // 48: subledger.SubledgerService.ClosePeriod:output_type -> subledger.ClosePeriodResponse
+	32, // 49: subledger.SubledgerService.ListAccountingPeriods:output_type -> subledger.ListAccountingPeriodsResponse
+	34, // 50: subledger.SubledgerService.GetClosingBalances:output_type -> subledger.GetClosingBalancesResponse
+	37, // 51: subledger.SubledgerService.ListAuditLog:output_type -> subledger.ListAuditLogResponse
+	41, // 52: subledger.SubledgerService.ImportJournal:output_type -> subledger.ImportJournalResponse
+	43, // 53: subledger.SubledgerService.ExportJournal:output_type -> subledger.JournalChunk
+	36, // [36:54] is the sub-list for method output_type
+	18, // [18:36] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_subledger_subledger_proto_init() }
@@ -381,7 +3468,7 @@ func file_subledger_subledger_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_subledger_subledger_proto_rawDesc), len(file_subledger_subledger_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
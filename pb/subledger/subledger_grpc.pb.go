@@ -19,8 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SubledgerService_CreateTransaction_FullMethodName = "/subledger.SubledgerService/CreateTransaction"
-	SubledgerService_GetBalance_FullMethodName        = "/subledger.SubledgerService/GetBalance"
+	SubledgerService_CreateTransaction_FullMethodName       = "/subledger.SubledgerService/CreateTransaction"
+	SubledgerService_CreateTransactionBatch_FullMethodName  = "/subledger.SubledgerService/CreateTransactionBatch"
+	SubledgerService_CreateTransactionStream_FullMethodName = "/subledger.SubledgerService/CreateTransactionStream"
+	SubledgerService_GetBalance_FullMethodName              = "/subledger.SubledgerService/GetBalance"
+	SubledgerService_GetBalances_FullMethodName             = "/subledger.SubledgerService/GetBalances"
+	SubledgerService_GetTransaction_FullMethodName          = "/subledger.SubledgerService/GetTransaction"
+	SubledgerService_ListEntries_FullMethodName             = "/subledger.SubledgerService/ListEntries"
+	SubledgerService_ExportEntries_FullMethodName           = "/subledger.SubledgerService/ExportEntries"
+	SubledgerService_CreateLedgerAccount_FullMethodName     = "/subledger.SubledgerService/CreateLedgerAccount"
+	SubledgerService_GetLedgerAccount_FullMethodName        = "/subledger.SubledgerService/GetLedgerAccount"
+	SubledgerService_ListLedgerAccounts_FullMethodName      = "/subledger.SubledgerService/ListLedgerAccounts"
+	SubledgerService_VerifyLedgerIntegrity_FullMethodName   = "/subledger.SubledgerService/VerifyLedgerIntegrity"
+	SubledgerService_ClosePeriod_FullMethodName             = "/subledger.SubledgerService/ClosePeriod"
+	SubledgerService_ListAccountingPeriods_FullMethodName   = "/subledger.SubledgerService/ListAccountingPeriods"
+	SubledgerService_GetClosingBalances_FullMethodName      = "/subledger.SubledgerService/GetClosingBalances"
+	SubledgerService_ListAuditLog_FullMethodName            = "/subledger.SubledgerService/ListAuditLog"
+	SubledgerService_ImportJournal_FullMethodName           = "/subledger.SubledgerService/ImportJournal"
+	SubledgerService_ExportJournal_FullMethodName           = "/subledger.SubledgerService/ExportJournal"
 )
 
 // SubledgerServiceClient is the client API for SubledgerService service.
@@ -28,7 +44,46 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SubledgerServiceClient interface {
 	CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionResponse, error)
+	// CreateTransactionBatch posts many independent transactions in one
+	// database transaction and reports the outcome of each.
+	CreateTransactionBatch(ctx context.Context, in *CreateTransactionBatchRequest, opts ...grpc.CallOption) (*CreateTransactionBatchResponse, error)
+	// CreateTransactionStream takes a batch too large for one message as a
+	// stream of chunks; the mode of the first chunk applies. BEST_EFFORT
+	// chunks are posted as they arrive, ATOMIC ones once the stream ends.
+	CreateTransactionStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CreateTransactionBatchRequest, CreateTransactionBatchResponse], error)
 	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
+	// GetBalances returns the balance of each of up to 1000 accounts in one
+	// call, zero for an account never posted to.
+	GetBalances(ctx context.Context, in *GetBalancesRequest, opts ...grpc.CallOption) (*GetBalancesResponse, error)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
+	// ExportEntries streams every entry matching the filter, for bulk reads
+	// that would otherwise need many ListEntries round trips.
+	ExportEntries(ctx context.Context, in *ExportEntriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LedgerEntry], error)
+	CreateLedgerAccount(ctx context.Context, in *CreateLedgerAccountRequest, opts ...grpc.CallOption) (*CreateLedgerAccountResponse, error)
+	GetLedgerAccount(ctx context.Context, in *GetLedgerAccountRequest, opts ...grpc.CallOption) (*GetLedgerAccountResponse, error)
+	ListLedgerAccounts(ctx context.Context, in *ListLedgerAccountsRequest, opts ...grpc.CallOption) (*ListLedgerAccountsResponse, error)
+	// Admin: walks the hash chain over posted transactions and reports the
+	// first link that no longer matches its entries or a signed checkpoint.
+	VerifyLedgerIntegrity(ctx context.Context, in *VerifyLedgerIntegrityRequest, opts ...grpc.CallOption) (*VerifyLedgerIntegrityResponse, error)
+	// Admin: closes an accounting period once it has ended and snapshots the
+	// closing balance of every account. Periods close in order, and nothing
+	// can be posted effective in a closed period.
+	ClosePeriod(ctx context.Context, in *ClosePeriodRequest, opts ...grpc.CallOption) (*ClosePeriodResponse, error)
+	ListAccountingPeriods(ctx context.Context, in *ListAccountingPeriodsRequest, opts ...grpc.CallOption) (*ListAccountingPeriodsResponse, error)
+	// GetClosingBalances returns the balances a closed period closed with.
+	GetClosingBalances(ctx context.Context, in *GetClosingBalancesRequest, opts ...grpc.CallOption) (*GetClosingBalancesResponse, error)
+	// Admin: the audit log of every mutating call to this service. The
+	// service's admin key must be sent as x-admin-key metadata.
+	ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error)
+	// Admin: imports balanced transactions from a CSV or JSON Lines journal
+	// sent in chunks; the format and dry_run of the first chunk apply. They
+	// are posted all together, and only if no line has an issue. A dry run
+	// just reports the issues.
+	ImportJournal(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportJournalRequest, ImportJournalResponse], error)
+	// ExportJournal streams the entries effective in a period, or the balance
+	// of every account over it, as CSV or JSON Lines.
+	ExportJournal(ctx context.Context, in *ExportJournalRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JournalChunk], error)
 }
 
 type subledgerServiceClient struct {
@@ -49,6 +104,29 @@ func (c *subledgerServiceClient) CreateTransaction(ctx context.Context, in *Crea
 	return out, nil
 }
 
+func (c *subledgerServiceClient) CreateTransactionBatch(ctx context.Context, in *CreateTransactionBatchRequest, opts ...grpc.CallOption) (*CreateTransactionBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTransactionBatchResponse)
+	err := c.cc.Invoke(ctx, SubledgerService_CreateTransactionBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subledgerServiceClient) CreateTransactionStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CreateTransactionBatchRequest, CreateTransactionBatchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SubledgerService_ServiceDesc.Streams[0], SubledgerService_CreateTransactionStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CreateTransactionBatchRequest, CreateTransactionBatchResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SubledgerService_CreateTransactionStreamClient = grpc.ClientStreamingClient[CreateTransactionBatchRequest, CreateTransactionBatchResponse]
+
 func (c *subledgerServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBalanceResponse)
@@ -59,12 +137,212 @@ func (c *subledgerServiceClient) GetBalance(ctx context.Context, in *GetBalanceR
 	return out, nil
 }
 
+func (c *subledgerServiceClient) GetBalances(ctx context.Context, in *GetBalancesRequest, opts ...grpc.CallOption) (*GetBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalancesResponse)
+	err := c.cc.Invoke(ctx, SubledgerService_GetBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subledgerServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionResponse)
+	err := c.cc.Invoke(ctx, SubledgerService_GetTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subledgerServiceClient) ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEntriesResponse)
+	err := c.cc.Invoke(ctx, SubledgerService_ListEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subledgerServiceClient) ExportEntries(ctx context.Context, in *ExportEntriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LedgerEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SubledgerService_ServiceDesc.Streams[1], SubledgerService_ExportEntries_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportEntriesRequest, LedgerEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SubledgerService_ExportEntriesClient = grpc.ServerStreamingClient[LedgerEntry]
+
+func (c *subledgerServiceClient) CreateLedgerAccount(ctx context.Context, in *CreateLedgerAccountRequest, opts ...grpc.CallOption) (*CreateLedgerAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLedgerAccountResponse)
+	err := c.cc.Invoke(ctx, SubledgerService_CreateLedgerAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subledgerServiceClient) GetLedgerAccount(ctx context.Context, in *GetLedgerAccountRequest, opts ...grpc.CallOption) (*GetLedgerAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLedgerAccountResponse)
+	err := c.cc.Invoke(ctx, SubledgerService_GetLedgerAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subledgerServiceClient) ListLedgerAccounts(ctx context.Context, in *ListLedgerAccountsRequest, opts ...grpc.CallOption) (*ListLedgerAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLedgerAccountsResponse)
+	err := c.cc.Invoke(ctx, SubledgerService_ListLedgerAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subledgerServiceClient) VerifyLedgerIntegrity(ctx context.Context, in *VerifyLedgerIntegrityRequest, opts ...grpc.CallOption) (*VerifyLedgerIntegrityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyLedgerIntegrityResponse)
+	err := c.cc.Invoke(ctx, SubledgerService_VerifyLedgerIntegrity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subledgerServiceClient) ClosePeriod(ctx context.Context, in *ClosePeriodRequest, opts ...grpc.CallOption) (*ClosePeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClosePeriodResponse)
+	err := c.cc.Invoke(ctx, SubledgerService_ClosePeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subledgerServiceClient) ListAccountingPeriods(ctx context.Context, in *ListAccountingPeriodsRequest, opts ...grpc.CallOption) (*ListAccountingPeriodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountingPeriodsResponse)
+	err := c.cc.Invoke(ctx, SubledgerService_ListAccountingPeriods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subledgerServiceClient) GetClosingBalances(ctx context.Context, in *GetClosingBalancesRequest, opts ...grpc.CallOption) (*GetClosingBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClosingBalancesResponse)
+	err := c.cc.Invoke(ctx, SubledgerService_GetClosingBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subledgerServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogResponse)
+	err := c.cc.Invoke(ctx, SubledgerService_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subledgerServiceClient) ImportJournal(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportJournalRequest, ImportJournalResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SubledgerService_ServiceDesc.Streams[2], SubledgerService_ImportJournal_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportJournalRequest, ImportJournalResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SubledgerService_ImportJournalClient = grpc.ClientStreamingClient[ImportJournalRequest, ImportJournalResponse]
+
+func (c *subledgerServiceClient) ExportJournal(ctx context.Context, in *ExportJournalRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JournalChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SubledgerService_ServiceDesc.Streams[3], SubledgerService_ExportJournal_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportJournalRequest, JournalChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SubledgerService_ExportJournalClient = grpc.ServerStreamingClient[JournalChunk]
+
 // SubledgerServiceServer is the server API for SubledgerService service.
 // All implementations must embed UnimplementedSubledgerServiceServer
 // for forward compatibility.
 type SubledgerServiceServer interface {
 	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error)
+	// CreateTransactionBatch posts many independent transactions in one
+	// database transaction and reports the outcome of each.
+	CreateTransactionBatch(context.Context, *CreateTransactionBatchRequest) (*CreateTransactionBatchResponse, error)
+	// CreateTransactionStream takes a batch too large for one message as a
+	// stream of chunks; the mode of the first chunk applies. BEST_EFFORT
+	// chunks are posted as they arrive, ATOMIC ones once the stream ends.
+	CreateTransactionStream(grpc.ClientStreamingServer[CreateTransactionBatchRequest, CreateTransactionBatchResponse]) error
 	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
+	// GetBalances returns the balance of each of up to 1000 accounts in one
+	// call, zero for an account never posted to.
+	GetBalances(context.Context, *GetBalancesRequest) (*GetBalancesResponse, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
+	// ExportEntries streams every entry matching the filter, for bulk reads
+	// that would otherwise need many ListEntries round trips.
+	ExportEntries(*ExportEntriesRequest, grpc.ServerStreamingServer[LedgerEntry]) error
+	CreateLedgerAccount(context.Context, *CreateLedgerAccountRequest) (*CreateLedgerAccountResponse, error)
+	GetLedgerAccount(context.Context, *GetLedgerAccountRequest) (*GetLedgerAccountResponse, error)
+	ListLedgerAccounts(context.Context, *ListLedgerAccountsRequest) (*ListLedgerAccountsResponse, error)
+	// Admin: walks the hash chain over posted transactions and reports the
+	// first link that no longer matches its entries or a signed checkpoint.
+	VerifyLedgerIntegrity(context.Context, *VerifyLedgerIntegrityRequest) (*VerifyLedgerIntegrityResponse, error)
+	// Admin: closes an accounting period once it has ended and snapshots the
+	// closing balance of every account. Periods close in order, and nothing
+	// can be posted effective in a closed period.
+	ClosePeriod(context.Context, *ClosePeriodRequest) (*ClosePeriodResponse, error)
+	ListAccountingPeriods(context.Context, *ListAccountingPeriodsRequest) (*ListAccountingPeriodsResponse, error)
+	// GetClosingBalances returns the balances a closed period closed with.
+	GetClosingBalances(context.Context, *GetClosingBalancesRequest) (*GetClosingBalancesResponse, error)
+	// Admin: the audit log of every mutating call to this service. The
+	// service's admin key must be sent as x-admin-key metadata.
+	ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
+	// Admin: imports balanced transactions from a CSV or JSON Lines journal
+	// sent in chunks; the format and dry_run of the first chunk apply. They
+	// are posted all together, and only if no line has an issue. A dry run
+	// just reports the issues.
+	ImportJournal(grpc.ClientStreamingServer[ImportJournalRequest, ImportJournalResponse]) error
+	// ExportJournal streams the entries effective in a period, or the balance
+	// of every account over it, as CSV or JSON Lines.
+	ExportJournal(*ExportJournalRequest, grpc.ServerStreamingServer[JournalChunk]) error
 	mustEmbedUnimplementedSubledgerServiceServer()
 }
 
@@ -78,9 +356,57 @@ type UnimplementedSubledgerServiceServer struct{}
 func (UnimplementedSubledgerServiceServer) CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateTransaction not implemented")
 }
+func (UnimplementedSubledgerServiceServer) CreateTransactionBatch(context.Context, *CreateTransactionBatchRequest) (*CreateTransactionBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTransactionBatch not implemented")
+}
+func (UnimplementedSubledgerServiceServer) CreateTransactionStream(grpc.ClientStreamingServer[CreateTransactionBatchRequest, CreateTransactionBatchResponse]) error {
+	return status.Error(codes.Unimplemented, "method CreateTransactionStream not implemented")
+}
 func (UnimplementedSubledgerServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetBalance not implemented")
 }
+func (UnimplementedSubledgerServiceServer) GetBalances(context.Context, *GetBalancesRequest) (*GetBalancesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBalances not implemented")
+}
+func (UnimplementedSubledgerServiceServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedSubledgerServiceServer) ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEntries not implemented")
+}
+func (UnimplementedSubledgerServiceServer) ExportEntries(*ExportEntriesRequest, grpc.ServerStreamingServer[LedgerEntry]) error {
+	return status.Error(codes.Unimplemented, "method ExportEntries not implemented")
+}
+func (UnimplementedSubledgerServiceServer) CreateLedgerAccount(context.Context, *CreateLedgerAccountRequest) (*CreateLedgerAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateLedgerAccount not implemented")
+}
+func (UnimplementedSubledgerServiceServer) GetLedgerAccount(context.Context, *GetLedgerAccountRequest) (*GetLedgerAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLedgerAccount not implemented")
+}
+func (UnimplementedSubledgerServiceServer) ListLedgerAccounts(context.Context, *ListLedgerAccountsRequest) (*ListLedgerAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLedgerAccounts not implemented")
+}
+func (UnimplementedSubledgerServiceServer) VerifyLedgerIntegrity(context.Context, *VerifyLedgerIntegrityRequest) (*VerifyLedgerIntegrityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyLedgerIntegrity not implemented")
+}
+func (UnimplementedSubledgerServiceServer) ClosePeriod(context.Context, *ClosePeriodRequest) (*ClosePeriodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClosePeriod not implemented")
+}
+func (UnimplementedSubledgerServiceServer) ListAccountingPeriods(context.Context, *ListAccountingPeriodsRequest) (*ListAccountingPeriodsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAccountingPeriods not implemented")
+}
+func (UnimplementedSubledgerServiceServer) GetClosingBalances(context.Context, *GetClosingBalancesRequest) (*GetClosingBalancesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClosingBalances not implemented")
+}
+func (UnimplementedSubledgerServiceServer) ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (UnimplementedSubledgerServiceServer) ImportJournal(grpc.ClientStreamingServer[ImportJournalRequest, ImportJournalResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportJournal not implemented")
+}
+func (UnimplementedSubledgerServiceServer) ExportJournal(*ExportJournalRequest, grpc.ServerStreamingServer[JournalChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportJournal not implemented")
+}
 func (UnimplementedSubledgerServiceServer) mustEmbedUnimplementedSubledgerServiceServer() {}
 func (UnimplementedSubledgerServiceServer) testEmbeddedByValue()                          {}
 
@@ -120,6 +446,31 @@ func _SubledgerService_CreateTransaction_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubledgerService_CreateTransactionBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubledgerServiceServer).CreateTransactionBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubledgerService_CreateTransactionBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubledgerServiceServer).CreateTransactionBatch(ctx, req.(*CreateTransactionBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubledgerService_CreateTransactionStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SubledgerServiceServer).CreateTransactionStream(&grpc.GenericServerStream[CreateTransactionBatchRequest, CreateTransactionBatchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SubledgerService_CreateTransactionStreamServer = grpc.ClientStreamingServer[CreateTransactionBatchRequest, CreateTransactionBatchResponse]
+
 func _SubledgerService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBalanceRequest)
 	if err := dec(in); err != nil {
@@ -138,6 +489,233 @@ func _SubledgerService_GetBalance_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubledgerService_GetBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubledgerServiceServer).GetBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubledgerService_GetBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubledgerServiceServer).GetBalances(ctx, req.(*GetBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubledgerService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubledgerServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubledgerService_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubledgerServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubledgerService_ListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubledgerServiceServer).ListEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubledgerService_ListEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubledgerServiceServer).ListEntries(ctx, req.(*ListEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubledgerService_ExportEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportEntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SubledgerServiceServer).ExportEntries(m, &grpc.GenericServerStream[ExportEntriesRequest, LedgerEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SubledgerService_ExportEntriesServer = grpc.ServerStreamingServer[LedgerEntry]
+
+func _SubledgerService_CreateLedgerAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLedgerAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubledgerServiceServer).CreateLedgerAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubledgerService_CreateLedgerAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubledgerServiceServer).CreateLedgerAccount(ctx, req.(*CreateLedgerAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubledgerService_GetLedgerAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubledgerServiceServer).GetLedgerAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubledgerService_GetLedgerAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubledgerServiceServer).GetLedgerAccount(ctx, req.(*GetLedgerAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubledgerService_ListLedgerAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLedgerAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubledgerServiceServer).ListLedgerAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubledgerService_ListLedgerAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubledgerServiceServer).ListLedgerAccounts(ctx, req.(*ListLedgerAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubledgerService_VerifyLedgerIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLedgerIntegrityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubledgerServiceServer).VerifyLedgerIntegrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubledgerService_VerifyLedgerIntegrity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubledgerServiceServer).VerifyLedgerIntegrity(ctx, req.(*VerifyLedgerIntegrityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubledgerService_ClosePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubledgerServiceServer).ClosePeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubledgerService_ClosePeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubledgerServiceServer).ClosePeriod(ctx, req.(*ClosePeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubledgerService_ListAccountingPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountingPeriodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubledgerServiceServer).ListAccountingPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubledgerService_ListAccountingPeriods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubledgerServiceServer).ListAccountingPeriods(ctx, req.(*ListAccountingPeriodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubledgerService_GetClosingBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClosingBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubledgerServiceServer).GetClosingBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubledgerService_GetClosingBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubledgerServiceServer).GetClosingBalances(ctx, req.(*GetClosingBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubledgerService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubledgerServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubledgerService_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubledgerServiceServer).ListAuditLog(ctx, req.(*ListAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubledgerService_ImportJournal_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SubledgerServiceServer).ImportJournal(&grpc.GenericServerStream[ImportJournalRequest, ImportJournalResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SubledgerService_ImportJournalServer = grpc.ClientStreamingServer[ImportJournalRequest, ImportJournalResponse]
+
+func _SubledgerService_ExportJournal_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportJournalRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SubledgerServiceServer).ExportJournal(m, &grpc.GenericServerStream[ExportJournalRequest, JournalChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SubledgerService_ExportJournalServer = grpc.ServerStreamingServer[JournalChunk]
+
 // SubledgerService_ServiceDesc is the grpc.ServiceDesc for SubledgerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -149,11 +727,80 @@ var SubledgerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTransaction",
 			Handler:    _SubledgerService_CreateTransaction_Handler,
 		},
+		{
+			MethodName: "CreateTransactionBatch",
+			Handler:    _SubledgerService_CreateTransactionBatch_Handler,
+		},
 		{
 			MethodName: "GetBalance",
 			Handler:    _SubledgerService_GetBalance_Handler,
 		},
+		{
+			MethodName: "GetBalances",
+			Handler:    _SubledgerService_GetBalances_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _SubledgerService_GetTransaction_Handler,
+		},
+		{
+			MethodName: "ListEntries",
+			Handler:    _SubledgerService_ListEntries_Handler,
+		},
+		{
+			MethodName: "CreateLedgerAccount",
+			Handler:    _SubledgerService_CreateLedgerAccount_Handler,
+		},
+		{
+			MethodName: "GetLedgerAccount",
+			Handler:    _SubledgerService_GetLedgerAccount_Handler,
+		},
+		{
+			MethodName: "ListLedgerAccounts",
+			Handler:    _SubledgerService_ListLedgerAccounts_Handler,
+		},
+		{
+			MethodName: "VerifyLedgerIntegrity",
+			Handler:    _SubledgerService_VerifyLedgerIntegrity_Handler,
+		},
+		{
+			MethodName: "ClosePeriod",
+			Handler:    _SubledgerService_ClosePeriod_Handler,
+		},
+		{
+			MethodName: "ListAccountingPeriods",
+			Handler:    _SubledgerService_ListAccountingPeriods_Handler,
+		},
+		{
+			MethodName: "GetClosingBalances",
+			Handler:    _SubledgerService_GetClosingBalances_Handler,
+		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _SubledgerService_ListAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CreateTransactionStream",
+			Handler:       _SubledgerService_CreateTransactionStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportEntries",
+			Handler:       _SubledgerService_ExportEntries_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportJournal",
+			Handler:       _SubledgerService_ImportJournal_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportJournal",
+			Handler:       _SubledgerService_ExportJournal_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "subledger/subledger.proto",
 }
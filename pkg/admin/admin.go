@@ -0,0 +1,36 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/ChotongW/grit_demo_wallet/pkg/apperror"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the metadata an operator calling an admin RPC sends the
+// service's admin key in.
+const MetadataKey = "x-admin-key"
+
+const ReasonRequired = "ADMIN_REQUIRED"
+
+var ErrRequired = apperror.New(apperror.KindPermissionDenied, ReasonRequired, "admin key required")
+
+// Require fails unless the call carries key in its x-admin-key metadata.
+// With an empty key no call passes.
+func Require(ctx context.Context, key string) error {
+	if key == "" {
+		return ErrRequired
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ErrRequired
+	}
+	for _, given := range md.Get(MetadataKey) {
+		if subtle.ConstantTimeCompare([]byte(given), []byte(key)) == 1 {
+			return nil
+		}
+	}
+	return ErrRequired
+}
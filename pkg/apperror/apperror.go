@@ -0,0 +1,137 @@
+package apperror
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ChotongW/grit_demo_wallet/pkg/rpcerror"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Kind classifies a domain error independently of the transport.
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindInvalidArgument
+	KindNotFound
+	KindAlreadyExists
+	KindFailedPrecondition
+	KindUnavailable
+	KindPermissionDenied
+)
+
+func (k Kind) Code() codes.Code {
+	switch k {
+	case KindInvalidArgument:
+		return codes.InvalidArgument
+	case KindNotFound:
+		return codes.NotFound
+	case KindAlreadyExists:
+		return codes.AlreadyExists
+	case KindFailedPrecondition:
+		return codes.FailedPrecondition
+	case KindUnavailable:
+		return codes.Unavailable
+	case KindPermissionDenied:
+		return codes.PermissionDenied
+	default:
+		return codes.Internal
+	}
+}
+
+// Error is a domain error with a kind, a stable reason code and, for
+// validation errors, the request field it refers to. Services declare their
+// sentinels as *Error values and wrap them with fmt.Errorf("%w: ...").
+type Error struct {
+	Kind    Kind
+	Reason  string
+	Field   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func New(kind Kind, reason, message string) *Error {
+	return &Error{Kind: kind, Reason: reason, Message: message}
+}
+
+func Invalid(reason, field, message string) *Error {
+	return &Error{Kind: KindInvalidArgument, Reason: reason, Field: field, Message: message}
+}
+
+const ReasonInternal = "INTERNAL"
+
+// KindOf reports the kind of the first *Error in err's chain, or
+// KindInternal if there is none.
+func KindOf(err error) Kind {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Kind
+	}
+	return KindInternal
+}
+
+// ReasonOf reports the reason code of the first *Error in err's chain, or
+// ReasonInternal if there is none.
+func ReasonOf(err error) string {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Reason
+	}
+	return ReasonInternal
+}
+
+// internalMessage is all a caller is told of an internal error; what went
+// wrong stays in the service's own log.
+const internalMessage = "internal error"
+
+// ToStatus converts err into a gRPC status error carrying ErrorInfo (and a
+// BadRequest field violation where applicable). Errors that already carry a
+// gRPC status, e.g. from a downstream service, keep their code and details.
+// Anything unclassified is reported as Internal, except for cancellations,
+// deadlines and database connection failures. Internal and database errors
+// are sent with a fixed message, so callers must log err themselves.
+func ToStatus(domain string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		if appErr.Kind == KindInternal {
+			return rpcerror.New(codes.Internal, domain, appErr.Reason, internalMessage)
+		}
+		var violations []rpcerror.FieldViolation
+		if appErr.Field != "" {
+			violations = append(violations, rpcerror.FieldViolation{
+				Field:       appErr.Field,
+				Description: err.Error(),
+			})
+		}
+		return rpcerror.New(appErr.Kind.Code(), domain, appErr.Reason, err.Error(), violations...)
+	}
+
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return st.Err()
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return rpcerror.New(codes.Canceled, domain, "CANCELED", err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return rpcerror.New(codes.DeadlineExceeded, domain, "DEADLINE_EXCEEDED", err.Error())
+	}
+
+	var connErr *pgconn.ConnectError
+	if errors.As(err, &connErr) {
+		return rpcerror.New(codes.Unavailable, domain, "DATABASE_UNAVAILABLE", "database unavailable")
+	}
+
+	return rpcerror.New(codes.Internal, domain, ReasonInternal, internalMessage)
+}
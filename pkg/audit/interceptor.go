@@ -0,0 +1,363 @@
+package audit
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/ChotongW/grit_demo_wallet/pkg/requestid"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// readOnlyPrefixes start the names of the methods that change nothing and
+// are not audited.
+var readOnlyPrefixes = []string{"Get", "List", "Lookup", "Quote", "Generate", "Export", "Verify"}
+
+// redactedFields are replaced with "[REDACTED]" wherever they appear in a
+// logged request, response or snapshot.
+var redactedFields = map[string]bool{
+	"secret":     true,
+	"token":      true,
+	"push_token": true,
+	"password":   true,
+}
+
+// ignoredTargetFields are ids in a request that do not name what the call
+// acts on.
+var ignoredTargetFields = map[string]bool{
+	"api_key_id":          true,
+	"reference_id":        true,
+	"referrer_account_id": true,
+}
+
+// Snapshot returns the state a call is about to change, given its request.
+type Snapshot func(ctx context.Context, req any) (any, error)
+
+// Recorder audits the calls of one service.
+type Recorder struct {
+	service   string
+	key       []byte
+	store     Store
+	snapshots map[string]Snapshot
+	logger    *logrus.Entry
+}
+
+// NewRecorder audits the mutating calls of service into store, trusting
+// the callers that come signed with key. snapshots are keyed by method
+// name, e.g. "Deposit"; calls without one are logged without a before
+// state.
+func NewRecorder(service string, key []byte, store Store, snapshots map[string]Snapshot, logger *logrus.Logger) *Recorder {
+	return &Recorder{
+		service:   service,
+		key:       key,
+		store:     store,
+		snapshots: snapshots,
+		logger: logger.WithFields(logrus.Fields{
+			"package": "audit",
+		}),
+	}
+}
+
+// IsMutating reports whether the method named method changes state.
+func IsMutating(method string) bool {
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// UnaryServerInterceptor puts the caller of every call in its context and
+// writes an entry for every mutating one, whether it succeeded or not. It
+// must run after requestid.UnaryServerInterceptor. A call is never failed
+// because it could not be audited.
+func (r *Recorder) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		caller := callerFromMetadata(ctx, r.key)
+		ctx = ToContext(ctx, caller)
+
+		action := methodName(info.FullMethod)
+		if !IsMutating(action) {
+			return handler(ctx, req)
+		}
+
+		var before any
+		if snapshot, ok := r.snapshots[action]; ok {
+			state, err := snapshot(ctx, req)
+			if err != nil {
+				r.logger.Debugf("no before state for %s: %v", action, err)
+			} else {
+				before = state
+			}
+		}
+
+		resp, err := handler(ctx, req)
+
+		entry := r.newEntry(ctx, action, caller, err)
+		entry.Target = target(req, resp, err)
+		entry.Request = r.encode(action, req)
+		entry.Before = r.encode(action, before)
+		if err == nil {
+			entry.After = r.encode(action, resp)
+		}
+		r.append(ctx, entry)
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor does for streaming calls what
+// UnaryServerInterceptor does for unary ones, except that the messages of
+// a stream are not logged: only who made the call and how it ended.
+func (r *Recorder) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		caller := callerFromMetadata(ss.Context(), r.key)
+		wrapped := &callerServerStream{
+			ServerStream: ss,
+			ctx:          ToContext(ss.Context(), caller),
+		}
+
+		action := methodName(info.FullMethod)
+		if !IsMutating(action) {
+			return handler(srv, wrapped)
+		}
+
+		err := handler(srv, wrapped)
+		r.append(wrapped.ctx, r.newEntry(wrapped.ctx, action, caller, err))
+		return err
+	}
+}
+
+type callerServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *callerServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+func (r *Recorder) newEntry(ctx context.Context, action string, caller Caller, err error) *Entry {
+	entry := &Entry{
+		ID:            uuid.New().String(),
+		OccurredAt:    time.Now().UTC(),
+		Service:       r.service,
+		Action:        action,
+		ActorType:     caller.ActorType,
+		ActorID:       caller.ActorID,
+		ClaimedUserID: caller.ClaimedUserID,
+		Status:        status.Code(err).String(),
+		RequestID:     requestid.FromContext(ctx),
+		SourceIP:      caller.SourceIP,
+		ClaimedIP:     caller.ClaimedIP,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	return entry
+}
+
+// append writes entry even if the call's context has been cancelled.
+func (r *Recorder) append(ctx context.Context, entry *Entry) {
+	if err := r.store.Append(context.WithoutCancel(ctx), entry); err != nil {
+		r.logger.Errorf("failed to audit %s (request %s): %v", entry.Action, entry.RequestID, err)
+	}
+}
+
+// UnaryClientInterceptor passes the caller in the context on to the called
+// service, signed with key. Calls made without one, such as by background
+// workers, are made as service.
+func UnaryClientInterceptor(service string, key []byte) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		caller, ok := FromContext(ctx)
+		if !ok {
+			caller = Caller{ActorType: ActorService, ActorID: service}
+		}
+
+		pairs := []string{actorTypeKey, caller.ActorType, actorIDKey, caller.ActorID}
+		if caller.ClaimedUserID != "" {
+			pairs = append(pairs, userIDKey, caller.ClaimedUserID)
+		}
+		if caller.SourceIP != "" {
+			pairs = append(pairs, sourceIPKey, caller.SourceIP)
+		}
+		if caller.ClaimedIP != "" {
+			pairs = append(pairs, claimedIPKey, caller.ClaimedIP)
+		}
+		if len(key) > 0 {
+			pairs = append(pairs, signatureKey, sign(key, caller))
+		}
+
+		return invoker(metadata.AppendToOutgoingContext(ctx, pairs...), method, req, reply, cc, opts...)
+	}
+}
+
+// callerFromMetadata returns the caller passed on by the client if it is
+// signed with key. Otherwise the caller is UNKNOWN and nothing it claims
+// is kept. Without a source IP the address of the connection is used.
+func callerFromMetadata(ctx context.Context, key []byte) Caller {
+	caller := Caller{ActorType: ActorUnknown}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(key) > 0 {
+		get := func(name string) string {
+			if values := md.Get(name); len(values) > 0 {
+				return values[0]
+			}
+			return ""
+		}
+		claimed := Caller{
+			ActorType:     get(actorTypeKey),
+			ActorID:       get(actorIDKey),
+			ClaimedUserID: get(userIDKey),
+			SourceIP:      get(sourceIPKey),
+			ClaimedIP:     get(claimedIPKey),
+		}
+		signature := get(signatureKey)
+		if claimed.ActorType != "" && hmac.Equal([]byte(signature), []byte(sign(key, claimed))) {
+			caller = claimed
+		}
+	}
+
+	if caller.SourceIP == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			host, _, err := net.SplitHostPort(p.Addr.String())
+			if err != nil {
+				host = p.Addr.String()
+			}
+			caller.SourceIP = host
+		}
+	}
+
+	return caller
+}
+
+// target names what a call acted on: the first id in its request, or in
+// its response for calls that create something.
+func target(req, resp any, err error) string {
+	if t := idField(req, "", 0); t != "" {
+		return t
+	}
+	if err != nil {
+		return ""
+	}
+	return idField(resp, "", 1)
+}
+
+func idField(v any, prefix string, depth int) string {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return ""
+	}
+	msg := m.ProtoReflect()
+	if !msg.IsValid() {
+		return ""
+	}
+
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := string(fd.Name())
+		isID := strings.HasSuffix(name, "_id") || (prefix != "" && name == "id")
+		if fd.Kind() != protoreflect.StringKind || fd.IsList() || !isID || ignoredTargetFields[name] {
+			continue
+		}
+		if value := msg.Get(fd).String(); value != "" {
+			return prefix + name + "=" + value
+		}
+	}
+
+	if depth == 0 {
+		return ""
+	}
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || !msg.Has(fd) {
+			continue
+		}
+		if t := idField(msg.Get(fd).Message().Interface(), string(fd.Name())+".", depth-1); t != "" {
+			return t
+		}
+	}
+	return ""
+}
+
+// encode returns v as JSON with redactedFields masked, or nil if v is nil
+// or cannot be encoded.
+func (r *Recorder) encode(action string, v any) json.RawMessage {
+	if v == nil {
+		return nil
+	}
+
+	var raw []byte
+	var err error
+	if m, ok := v.(proto.Message); ok {
+		raw, err = protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	} else {
+		raw, err = json.Marshal(v)
+	}
+	if err != nil {
+		r.logger.Warnf("failed to encode %s for the audit log: %v", action, err)
+		return nil
+	}
+
+	var doc any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return raw
+	}
+	redact(doc)
+	if raw, err = json.Marshal(doc); err != nil {
+		return nil
+	}
+	return raw
+}
+
+func redact(v any) {
+	switch doc := v.(type) {
+	case map[string]any:
+		for key, value := range doc {
+			if redactedFields[key] {
+				doc[key] = "[REDACTED]"
+				continue
+			}
+			redact(value)
+		}
+	case []any:
+		for _, value := range doc {
+			redact(value)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresStore keeps the log in the audit_log table of the service's
+// schema, which rejects updates and deletes.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+func (s *PostgresStore) Append(ctx context.Context, e *Entry) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO audit_log (
+			id, occurred_at, service, action, actor_type, actor_id, claimed_user_id, target,
+			request, before, after, status, error, request_id, source_ip, claimed_ip
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8, $9, $10, $11, $12, NULLIF($13, ''), $14, $15, $16)
+	`, e.ID, e.OccurredAt, e.Service, e.Action, e.ActorType, e.ActorID, e.ClaimedUserID, e.Target,
+		nullJSON(e.Request), nullJSON(e.Before), nullJSON(e.After), e.Status, e.Error, e.RequestID, e.SourceIP, e.ClaimedIP)
+	if err != nil {
+		return fmt.Errorf("failed to append audit entry: %w", err)
+	}
+	return nil
+}
+
+// List returns a page of the entries matching filter, newest first, and
+// the total number.
+func (s *PostgresStore) List(ctx context.Context, filter Filter, page, pageSize int) ([]Entry, int, error) {
+	var conditions []string
+	var args []any
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.Action != "" {
+		add("action = $%d", filter.Action)
+	}
+	if filter.ActorID != "" {
+		add("actor_id = $%d", filter.ActorID)
+	}
+	if filter.Target != "" {
+		add("target = $%d", filter.Target)
+	}
+	if filter.From != nil {
+		add("occurred_at >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		add("occurred_at < $%d", *filter.To)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	if err := s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM audit_log `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit entries: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, occurred_at, service, action, actor_type, actor_id, COALESCE(claimed_user_id, ''), target,
+		       request, before, after, status, COALESCE(error, ''), request_id, source_ip, claimed_ip
+		FROM audit_log
+		%s
+		ORDER BY occurred_at DESC, id
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
+	rows, err := s.pool.Query(ctx, query, append(args, pageSize, (page-1)*pageSize)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list audit entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []Entry
+	for rows.Next() {
+		var e Entry
+		err := rows.Scan(
+			&e.ID, &e.OccurredAt, &e.Service, &e.Action, &e.ActorType, &e.ActorID, &e.ClaimedUserID, &e.Target,
+			&e.Request, &e.Before, &e.After, &e.Status, &e.Error, &e.RequestID, &e.SourceIP, &e.ClaimedIP,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan audit entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read audit entries: %w", err)
+	}
+
+	return entries, total, nil
+}
+
+func nullJSON(raw []byte) any {
+	if len(raw) == 0 {
+		return nil
+	}
+	return raw
+}
@@ -0,0 +1,423 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+)
+
+// Migration is one versioned schema change, loaded from a pair of files
+// named <version>_<name>.up.sql and <version>_<name>.down.sql.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	AppliedAt *time.Time
+}
+
+// Migrator applies one service's migration set. Several sets can share a
+// database: applied versions are tracked per set in schema_migrations and a
+// per-set advisory lock keeps concurrent replicas from migrating at once.
+type Migrator struct {
+	pool         *pgxpool.Pool
+	set          string
+	migrations   []Migration
+	legacyTables []string
+	logger       *logrus.Entry
+}
+
+var migrationFileRe = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+func NewMigrator(pool *pgxpool.Pool, set string, fsys fs.FS, logger *logrus.Logger) (*Migrator, error) {
+	migrations, err := LoadMigrations(fsys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s migrations: %w", set, err)
+	}
+
+	return &Migrator{
+		pool:       pool,
+		set:        set,
+		migrations: migrations,
+		logger: logger.WithFields(logrus.Fields{
+			"package":       "db/migrate",
+			"migration_set": set,
+		}),
+	}, nil
+}
+
+// LoadMigrations reads every migration file at the root of fsys and returns
+// them ordered by version.
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, file := range files {
+		match := migrationFileRe.FindStringSubmatch(path.Base(file))
+		if match == nil {
+			return nil, fmt.Errorf("unexpected migration file name %q", file)
+		}
+
+		version, _ := strconv.Atoi(match[1])
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has conflicting names %q and %q", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// AdoptLegacyTables names the tables the set kept in the public schema
+// before each service got a schema of its own. When the migrator runs
+// against another schema that has none of them yet, it first moves them
+// there, with their indexes, constraints and owned sequences, along with
+// the set's rows of public.schema_migrations. Nothing is moved once the
+// schema holds any of the tables, so the cut-over happens exactly once; a
+// deployment that already started against an empty schema has to drop it
+// (carrying over anything written there since) for its tables to be moved.
+func (m *Migrator) AdoptLegacyTables(tables ...string) {
+	m.legacyTables = tables
+}
+
+// withLock runs fn on a dedicated connection holding the set's advisory lock.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *pgxpool.Conn) error) error {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	lockKey := "schema_migrations:" + m.set
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock(hashtext($1))`, lockKey); err != nil {
+		return fmt.Errorf("failed to take migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock(hashtext($1))`, lockKey); err != nil {
+			m.logger.Errorf("failed to release migration lock: %v", err)
+		}
+	}()
+
+	if err := m.moveLegacyTables(ctx, conn); err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			set_name VARCHAR(50) NOT NULL,
+			version INT NOT NULL,
+			name VARCHAR(255) NOT NULL,
+			applied_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (set_name, version)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	return fn(conn)
+}
+
+func (m *Migrator) moveLegacyTables(ctx context.Context, conn *pgxpool.Conn) error {
+	if len(m.legacyTables) == 0 {
+		return nil
+	}
+
+	var schema string
+	if err := conn.QueryRow(ctx, `SELECT current_schema()`).Scan(&schema); err != nil {
+		return fmt.Errorf("failed to read current schema: %w", err)
+	}
+	if schema == "public" {
+		return nil
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var adopted int
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM information_schema.tables
+		WHERE table_schema = $1 AND table_name = ANY($2)
+	`, schema, m.legacyTables).Scan(&adopted)
+	if err != nil {
+		return fmt.Errorf("failed to look up tables in %s: %w", schema, err)
+	}
+	if adopted > 0 {
+		return nil
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT table_name FROM information_schema.tables
+		WHERE table_schema = 'public' AND table_name = ANY($1)
+		ORDER BY table_name
+	`, m.legacyTables)
+	if err != nil {
+		return fmt.Errorf("failed to look up tables in public: %w", err)
+	}
+	tables, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return fmt.Errorf("failed to look up tables in public: %w", err)
+	}
+	if len(tables) == 0 {
+		return nil
+	}
+
+	target := pgx.Identifier{schema}.Sanitize()
+	for _, table := range tables {
+		if _, err := tx.Exec(ctx, "ALTER TABLE "+pgx.Identifier{"public", table}.Sanitize()+" SET SCHEMA "+target); err != nil {
+			return fmt.Errorf("failed to move %s into %s: %w", table, schema, err)
+		}
+	}
+
+	// Deployments from before versioned migrations have no record to carry.
+	var hasHistory bool
+	if err := tx.QueryRow(ctx, `SELECT to_regclass('public.schema_migrations') IS NOT NULL`).Scan(&hasHistory); err != nil {
+		return fmt.Errorf("failed to look up public.schema_migrations: %w", err)
+	}
+	if hasHistory {
+		_, err := tx.Exec(ctx, `
+			CREATE TABLE IF NOT EXISTS schema_migrations (LIKE public.schema_migrations INCLUDING ALL)
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create schema_migrations: %w", err)
+		}
+		_, err = tx.Exec(ctx, `
+			WITH moved AS (
+				DELETE FROM public.schema_migrations WHERE set_name = $1 RETURNING *
+			)
+			INSERT INTO schema_migrations SELECT * FROM moved
+			ON CONFLICT DO NOTHING
+		`, m.set)
+		if err != nil {
+			return fmt.Errorf("failed to move schema_migrations rows: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit the move into %s: %w", schema, err)
+	}
+
+	m.logger.Infof("moved %s from public into schema %s", strings.Join(tables, ", "), schema)
+	return nil
+}
+
+func (m *Migrator) applied(ctx context.Context, conn *pgxpool.Conn) (map[int]time.Time, error) {
+	rows, err := conn.Query(ctx, `SELECT version, applied_at FROM schema_migrations WHERE set_name = $1`, m.set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var at time.Time
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, fmt.Errorf("failed to scan schema_migrations: %w", err)
+		}
+		applied[version] = at
+	}
+
+	return applied, rows.Err()
+}
+
+// Up applies every pending migration in version order, each in its own
+// transaction, and returns how many were applied.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	count := 0
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+
+			tx, err := conn.Begin(ctx)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.Exec(ctx, mig.Up); err != nil {
+				tx.Rollback(ctx)
+				return fmt.Errorf("migration %d_%s failed: %w", mig.Version, mig.Name, err)
+			}
+			if _, err := tx.Exec(ctx, `INSERT INTO schema_migrations (set_name, version, name) VALUES ($1, $2, $3)`,
+				m.set, mig.Version, mig.Name); err != nil {
+				tx.Rollback(ctx)
+				return fmt.Errorf("failed to record migration %d_%s: %w", mig.Version, mig.Name, err)
+			}
+			if err := tx.Commit(ctx); err != nil {
+				return fmt.Errorf("failed to commit migration %d_%s: %w", mig.Version, mig.Name, err)
+			}
+
+			m.logger.Infof("applied migration %d_%s", mig.Version, mig.Name)
+			count++
+		}
+		return nil
+	})
+
+	return count, err
+}
+
+// Down rolls back the most recently applied steps migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	count := 0
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && count < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := applied[mig.Version]; !ok {
+				continue
+			}
+
+			tx, err := conn.Begin(ctx)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.Exec(ctx, mig.Down); err != nil {
+				tx.Rollback(ctx)
+				return fmt.Errorf("rollback of %d_%s failed: %w", mig.Version, mig.Name, err)
+			}
+			if _, err := tx.Exec(ctx, `DELETE FROM schema_migrations WHERE set_name = $1 AND version = $2`,
+				m.set, mig.Version); err != nil {
+				tx.Rollback(ctx)
+				return fmt.Errorf("failed to unrecord migration %d_%s: %w", mig.Version, mig.Name, err)
+			}
+			if err := tx.Commit(ctx); err != nil {
+				return fmt.Errorf("failed to commit rollback of %d_%s: %w", mig.Version, mig.Name, err)
+			}
+
+			m.logger.Infof("rolled back migration %d_%s", mig.Version, mig.Name)
+			count++
+		}
+		return nil
+	})
+
+	return count, err
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var statuses []MigrationStatus
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			st := MigrationStatus{Version: mig.Version, Name: mig.Name}
+			if at, ok := applied[mig.Version]; ok {
+				st.AppliedAt = &at
+			}
+			statuses = append(statuses, st)
+		}
+		return nil
+	})
+
+	return statuses, err
+}
+
+// RunMigrateCommand implements the "migrate" subcommand shared by the
+// service binaries: migrate up | migrate down [steps] | migrate status.
+func RunMigrateCommand(ctx context.Context, m *Migrator, args []string) error {
+	action := "up"
+	if len(args) > 0 {
+		action = args[0]
+	}
+
+	switch action {
+	case "up":
+		n, err := m.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s: applied %d migration(s)\n", m.set, n)
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			parsed, err := strconv.Atoi(args[1])
+			if err != nil || parsed < 1 {
+				return fmt.Errorf("invalid step count %q", args[1])
+			}
+			steps = parsed
+		}
+		n, err := m.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s: rolled back %d migration(s)\n", m.set, n)
+
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, st := range statuses {
+			applied := "pending"
+			if st.AppliedAt != nil {
+				applied = st.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%s\t%04d_%s\t%s\n", m.set, st.Version, st.Name, applied)
+		}
+
+	default:
+		return fmt.Errorf("unknown migrate action %q (want up, down [steps] or status)", action)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package database_test
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	accountMigrations "github.com/ChotongW/grit_demo_wallet/internal/accounts/migrations"
+	subledgerMigrations "github.com/ChotongW/grit_demo_wallet/internal/subledger/migrations"
+	"github.com/ChotongW/grit_demo_wallet/pkg/database"
+)
+
+func file(content string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(content)}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_add_email.up.sql":         file("ALTER TABLE accounts ADD email TEXT;"),
+		"0002_add_email.down.sql":       file("ALTER TABLE accounts DROP email;"),
+		"0001_create_accounts.up.sql":   file("CREATE TABLE accounts (id TEXT);"),
+		"0001_create_accounts.down.sql": file("DROP TABLE accounts;"),
+		"10_create_audit.up.sql":        file("CREATE TABLE audit (id TEXT);"),
+		"10_create_audit.down.sql":      file("DROP TABLE audit;"),
+		"README.md":                     file("not a migration"),
+		"nested/0003_ignored.up.sql":    file("SELECT 1;"),
+	}
+
+	migrations, err := database.LoadMigrations(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []database.Migration{
+		{Version: 1, Name: "create_accounts", Up: "CREATE TABLE accounts (id TEXT);", Down: "DROP TABLE accounts;"},
+		{Version: 2, Name: "add_email", Up: "ALTER TABLE accounts ADD email TEXT;", Down: "ALTER TABLE accounts DROP email;"},
+		{Version: 10, Name: "create_audit", Up: "CREATE TABLE audit (id TEXT);", Down: "DROP TABLE audit;"},
+	}
+	if len(migrations) != len(want) {
+		t.Fatalf("loaded %d migrations, want %d: %+v", len(migrations), len(want), migrations)
+	}
+	for i := range want {
+		if migrations[i] != want[i] {
+			t.Errorf("migration %d = %+v, want %+v", i, migrations[i], want[i])
+		}
+	}
+}
+
+func TestLoadMigrationsFails(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+		want string
+	}{
+		{
+			name: "conflicting names",
+			fsys: fstest.MapFS{
+				"0001_create_accounts.up.sql":  file("CREATE TABLE accounts (id TEXT);"),
+				"0001_create_wallets.down.sql": file("DROP TABLE wallets;"),
+			},
+			want: "conflicting names",
+		},
+		{
+			name: "same version written two ways",
+			fsys: fstest.MapFS{
+				"0001_create_accounts.up.sql":   file("CREATE TABLE accounts (id TEXT);"),
+				"0001_create_accounts.down.sql": file("DROP TABLE accounts;"),
+				"1_create_wallets.up.sql":       file("CREATE TABLE wallets (id TEXT);"),
+			},
+			want: "conflicting names",
+		},
+		{
+			name: "missing down",
+			fsys: fstest.MapFS{
+				"0001_create_accounts.up.sql": file("CREATE TABLE accounts (id TEXT);"),
+			},
+			want: "needs both an up and a down file",
+		},
+		{
+			name: "missing up",
+			fsys: fstest.MapFS{
+				"0001_create_accounts.down.sql": file("DROP TABLE accounts;"),
+			},
+			want: "needs both an up and a down file",
+		},
+		{
+			name: "empty up",
+			fsys: fstest.MapFS{
+				"0001_create_accounts.up.sql":   file(""),
+				"0001_create_accounts.down.sql": file("DROP TABLE accounts;"),
+			},
+			want: "needs both an up and a down file",
+		},
+		{
+			name: "unexpected name",
+			fsys: fstest.MapFS{"0001_Create-Accounts.sql": file("SELECT 1;")},
+			want: "unexpected migration file name",
+		},
+		{
+			name: "no version",
+			fsys: fstest.MapFS{"create_accounts.up.sql": file("SELECT 1;")},
+			want: "unexpected migration file name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := database.LoadMigrations(tt.fsys)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadMigrations error = %v, want one containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// The shipped sets must load and be numbered from 1 without gaps.
+func TestShippedMigrations(t *testing.T) {
+	sets := map[string]fs.FS{
+		accountMigrations.Set:   accountMigrations.FS,
+		subledgerMigrations.Set: subledgerMigrations.FS,
+	}
+
+	for set, fsys := range sets {
+		t.Run(set, func(t *testing.T) {
+			migrations, err := database.LoadMigrations(fsys)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(migrations) == 0 {
+				t.Fatal("no migrations")
+			}
+			for i, m := range migrations {
+				if m.Version != i+1 {
+					t.Errorf("migration %d_%s, want version %d", m.Version, m.Name, i+1)
+				}
+			}
+		})
+	}
+}
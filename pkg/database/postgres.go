@@ -7,6 +7,7 @@ import (
 
 	"github.com/ChotongW/grit_demo_wallet/config"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 )
@@ -57,6 +58,17 @@ func New(config *config.DbConfig, logger *logrus.Logger) (pgdb *PostgresDb, err
 		return nil, fmt.Errorf("unable to ping the database: %w", err)
 	}
 
+	// Each service owns its schema. search_path is resolved per statement,
+	// so creating the schema after connecting is enough for migrations to
+	// land in it.
+	if config.DatabaseSchema != "" && config.DatabaseSchema != "public" {
+		createSchema := "CREATE SCHEMA IF NOT EXISTS " + pgx.Identifier{config.DatabaseSchema}.Sanitize()
+		if _, err := pool.Exec(context.Background(), createSchema); err != nil {
+			pool.Close()
+			return nil, fmt.Errorf("failed to create schema %s: %w", config.DatabaseSchema, err)
+		}
+	}
+
 	pgdb.Pool = pool
 	pgdb.logger.Info("Successfully connected to PostgreSQL")
 
@@ -0,0 +1,30 @@
+package mask
+
+import "strings"
+
+// Email hides all but the first character of the local part and of the
+// domain label, keeping the top-level domain: john.doe@example.com becomes
+// j*******@e******.com. Malformed input is masked entirely.
+func Email(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return strings.Repeat("*", len(email))
+	}
+
+	local, domain := email[:at], email[at+1:]
+
+	tld := ""
+	if dot := strings.LastIndex(domain, "."); dot > 0 {
+		domain, tld = domain[:dot], domain[dot:]
+	}
+
+	return keepFirst(local) + "@" + keepFirst(domain) + tld
+}
+
+func keepFirst(s string) string {
+	r := []rune(s)
+	if len(r) <= 1 {
+		return "*"
+	}
+	return string(r[0]) + strings.Repeat("*", len(r)-1)
+}
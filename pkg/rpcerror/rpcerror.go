@@ -0,0 +1,73 @@
+package rpcerror
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// New builds a gRPC status error carrying a google.rpc.ErrorInfo with the
+// given reason and, when violations are present, a google.rpc.BadRequest.
+func New(code codes.Code, domain, reason, message string, violations ...FieldViolation) error {
+	st := status.New(code, message)
+
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: domain,
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	if len(violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+
+		if withViolations, err := withDetails.WithDetails(badRequest); err == nil {
+			withDetails = withViolations
+		}
+	}
+
+	return withDetails.Err()
+}
+
+// InvalidField is a shorthand for an InvalidArgument status describing a
+// single bad request field.
+func InvalidField(domain, reason, field, description string) error {
+	return New(codes.InvalidArgument, domain, reason, description, FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+}
+
+// Details extracts the ErrorInfo and field violations attached to a status.
+func Details(st *status.Status) (*errdetails.ErrorInfo, []FieldViolation) {
+	var info *errdetails.ErrorInfo
+	var violations []FieldViolation
+
+	for _, d := range st.Details() {
+		switch detail := d.(type) {
+		case *errdetails.ErrorInfo:
+			info = detail
+		case *errdetails.BadRequest:
+			for _, v := range detail.GetFieldViolations() {
+				violations = append(violations, FieldViolation{
+					Field:       v.GetField(),
+					Description: v.GetDescription(),
+				})
+			}
+		}
+	}
+
+	return info, violations
+}